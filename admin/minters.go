@@ -0,0 +1,158 @@
+// Package admin manages privileged accounts of the system contracts.
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/one-click-platform/system-contracts/generated"
+	"github.com/one-click-platform/system-contracts/txmanager"
+)
+
+// MinterPlan lists the role changes needed to reach the desired minter set.
+type MinterPlan struct {
+	Grant  []common.Address
+	Revoke []common.Address
+}
+
+// Empty reports whether the on-chain minter set already matches.
+func (p MinterPlan) Empty() bool {
+	return len(p.Grant) == 0 && len(p.Revoke) == 0
+}
+
+// Minters returns all accounts holding MINTER_ROLE on the WERC721 contract.
+func Minters(token *generated.WERC721Caller, opts *bind.CallOpts) ([]common.Address, error) {
+	role, err := token.MINTERROLE(opts)
+	if err != nil {
+		return nil, err
+	}
+	return roleMembers(token, opts, role)
+}
+
+// roleMembers returns all accounts holding role on the WERC721 contract.
+func roleMembers(token *generated.WERC721Caller, opts *bind.CallOpts, role [32]byte) ([]common.Address, error) {
+	count, err := token.GetRoleMemberCount(opts, role)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]common.Address, 0, count.Int64())
+	for i := int64(0); i < count.Int64(); i++ {
+		member, err := token.GetRoleMember(opts, role, big.NewInt(i))
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+// PlanMinters computes the grants and revocations that turn current into desired.
+// The order of desired is kept for grants and the order of current for revocations.
+func PlanMinters(current, desired []common.Address) MinterPlan {
+	have := make(map[common.Address]bool, len(current))
+	for _, minter := range current {
+		have[minter] = true
+	}
+	want := make(map[common.Address]bool, len(desired))
+	for _, minter := range desired {
+		want[minter] = true
+	}
+
+	var plan MinterPlan
+	for _, minter := range desired {
+		if !have[minter] {
+			plan.Grant = append(plan.Grant, minter)
+			have[minter] = true
+		}
+	}
+	for _, minter := range current {
+		if !want[minter] {
+			plan.Revoke = append(plan.Revoke, minter)
+		}
+	}
+	return plan
+}
+
+// ReconcileMinters grants and revokes MINTER_ROLE so that the on-chain minter
+// set equals desired. The sender of txs must hold ADMIN_ROLE. It sends every
+// change, waits for them and returns the plan it executed together with the
+// receipts; on error the receipts confirmed so far are returned. Every
+// change is journaled by txs under a key of the token and minter until it
+// is mined, so a rerun after a failure follows the pending transactions
+// instead of sending them again.
+func ReconcileMinters(ctx context.Context, token *generated.WERC721, address common.Address, txs *txmanager.Manager, desired []common.Address) (MinterPlan, []*types.Receipt, error) {
+	callOpts := &bind.CallOpts{Context: ctx, From: txs.From()}
+
+	current, err := Minters(&token.WERC721Caller, callOpts)
+	if err != nil {
+		return MinterPlan{}, nil, err
+	}
+	role, err := token.MINTERROLE(callOpts)
+	if err != nil {
+		return MinterPlan{}, nil, err
+	}
+
+	plan := PlanMinters(current, desired)
+
+	var keys []string
+	for _, minter := range plan.Grant {
+		minter := minter
+		key := fmt.Sprintf("grant-minter:%s:%s", address.Hex(), minter.Hex())
+		if _, err := txs.Send(ctx, key, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return token.GrantRole(opts, role, minter)
+		}); err != nil {
+			return plan, nil, err
+		}
+		keys = append(keys, key)
+	}
+	for _, minter := range plan.Revoke {
+		minter := minter
+		key := fmt.Sprintf("revoke-minter:%s:%s", address.Hex(), minter.Hex())
+		if _, err := txs.Send(ctx, key, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return token.RevokeRole(opts, role, minter)
+		}); err != nil {
+			return plan, nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	var receipts []*types.Receipt
+	for _, key := range keys {
+		receipt, err := confirm(ctx, txs, key)
+		if err != nil {
+			return plan, receipts, err
+		}
+		receipts = append(receipts, receipt)
+	}
+	return plan, receipts, nil
+}
+
+// confirm waits for the transaction of key and forgets it once it is mined,
+// replaced or rejected, so that a later change of the same minter is sent
+// again.
+func confirm(ctx context.Context, txs *txmanager.Manager, key string) (*types.Receipt, error) {
+	receipt, err := txs.Wait(ctx, key)
+	switch {
+	case errors.Is(err, txmanager.ErrReplaced), errors.Is(err, txmanager.ErrRejected):
+		if err := txs.Forget(key); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s was not mined: %w", key, err)
+	case err != nil:
+		return nil, err
+	}
+	if err := txs.Forget(key); err != nil {
+		return receipt, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("%s reverted in %s", key, receipt.TxHash.Hex())
+	}
+	return receipt, nil
+}
@@ -0,0 +1,378 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/one-click-platform/system-contracts/generated"
+	"github.com/one-click-platform/system-contracts/manifest"
+	"github.com/one-click-platform/system-contracts/txmanager"
+)
+
+var (
+	ErrNotOwnable      = errors.New("contract has no two-step ownership")
+	ErrNotOwner        = errors.New("sender is not the owner")
+	ErrNotPendingOwner = errors.New("sender is not the pending owner")
+	ErrNotAdmin        = errors.New("sender is not an admin")
+	ErrNotOfferedAdmin = errors.New("sender is not offered the admin role")
+)
+
+// Ownable2Step is implemented by the bindings of the WETH and WERC721
+// contracts. A transfer only takes effect once the new owner accepts it.
+type Ownable2Step interface {
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	PendingOwner(opts *bind.CallOpts) (common.Address, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+	AcceptOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+}
+
+// Ownership lists the privileged accounts of a contract.
+type Ownership struct {
+	Contract     manifest.Entry
+	Owner        common.Address   // Owner of WETH and WERC721, admin of the Auction
+	PendingOwner common.Address   // Zero unless a transfer awaits acceptance
+	Admins       []common.Address // ADMIN_ROLE members of WERC721
+}
+
+// Owners reads the privileged accounts of every entry.
+func Owners(ctx context.Context, backend bind.ContractBackend, entries []manifest.Entry) ([]Ownership, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	owners := make([]Ownership, len(entries))
+	for i, entry := range entries {
+		owner, err := ownership(opts, backend, entry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read owners of %s: %w", entry.Name, err)
+		}
+		owners[i] = owner
+	}
+	return owners, nil
+}
+
+func ownership(opts *bind.CallOpts, backend bind.ContractBackend, entry manifest.Entry) (Ownership, error) {
+	o := Ownership{Contract: entry}
+	if entry.Type == manifest.TypeAuction {
+		auction, err := generated.NewAuctionCaller(entry.Address, backend)
+		if err != nil {
+			return o, err
+		}
+		o.Owner, err = auction.GetAdmin(opts)
+		return o, err
+	}
+
+	contract, err := bindOwnable(entry, backend)
+	if err != nil {
+		return o, err
+	}
+	if o.Owner, err = contract.Owner(opts); err != nil {
+		return o, err
+	}
+	if o.PendingOwner, err = contract.PendingOwner(opts); err != nil {
+		return o, err
+	}
+	if entry.Type == manifest.TypeWERC721 {
+		token, err := generated.NewWERC721Caller(entry.Address, backend)
+		if err != nil {
+			return o, err
+		}
+		if o.Admins, err = Admins(token, opts); err != nil {
+			return o, err
+		}
+	}
+	return o, nil
+}
+
+// Admins returns all accounts holding ADMIN_ROLE on the WERC721 contract.
+func Admins(token *generated.WERC721Caller, opts *bind.CallOpts) ([]common.Address, error) {
+	role, err := token.ADMINROLE(opts)
+	if err != nil {
+		return nil, err
+	}
+	return roleMembers(token, opts, role)
+}
+
+// Handover transfers the ownership of WETH and WERC721 contracts through a
+// transaction manager, checking the sender before each step and the owners
+// after it. On WERC721 the minting and the roles are held by ADMIN_ROLE
+// rather than the owner, so its admin role is handed over along with the
+// ownership, in two steps as well.
+type Handover struct {
+	backend bind.ContractBackend
+	txs     *txmanager.Manager
+}
+
+// NewHandover creates a handover sending from the sender of txs.
+func NewHandover(backend bind.ContractBackend, txs *txmanager.Manager) *Handover {
+	return &Handover{backend: backend, txs: txs}
+}
+
+// Transfer offers the ownership of entry to newOwner, who has to accept it
+// with Accept. On WERC721 the admin role of the sender is offered as well,
+// the sender loses it once newOwner accepts. The zero address cancels a
+// pending transfer. The sender must be the owner, and an admin of WERC721.
+// The receipt of the last transaction sent is returned.
+func (h *Handover) Transfer(ctx context.Context, entry manifest.Entry, newOwner common.Address) (*types.Receipt, error) {
+	contract, err := bindOwnable(entry, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	pending, err := contract.PendingOwner(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var receipt *types.Receipt
+	if entry.Type == manifest.TypeWERC721 && newOwner == (common.Address{}) && pending != (common.Address{}) {
+		// The offer is cancelled first, as it is looked up by the pending
+		// owner that the cancelled transfer clears.
+		if receipt, err = h.cancelAdminRole(ctx, entry, pending); err != nil {
+			return receipt, err
+		}
+	}
+	if receipt, err = h.transferOwnership(ctx, entry, contract, newOwner); err != nil {
+		return receipt, err
+	}
+	if entry.Type == manifest.TypeWERC721 && newOwner != (common.Address{}) {
+		return h.offerAdminRole(ctx, entry, newOwner)
+	}
+	return receipt, nil
+}
+
+// Accept takes over the ownership of entry, and on WERC721 the admin role
+// offered with it. The sender must be the pending owner.
+func (h *Handover) Accept(ctx context.Context, entry manifest.Entry) (*types.Receipt, error) {
+	contract, err := bindOwnable(entry, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := h.acceptOwnership(ctx, entry, contract)
+	if err != nil {
+		return receipt, err
+	}
+	if entry.Type == manifest.TypeWERC721 {
+		return h.acceptAdminRole(ctx, entry)
+	}
+	return receipt, nil
+}
+
+// transferOwnership offers the ownership of entry to newOwner.
+func (h *Handover) transferOwnership(ctx context.Context, entry manifest.Entry, contract Ownable2Step, newOwner common.Address) (*types.Receipt, error) {
+	key := fmt.Sprintf("transfer-ownership:%s:%s", entry.Name, newOwner.Hex())
+	receipt, err := h.run(ctx, key, func(opts *bind.CallOpts) error {
+		owner, err := contract.Owner(opts)
+		if err != nil {
+			return err
+		}
+		if owner != h.txs.From() {
+			return fmt.Errorf("%w of %s, %s is", ErrNotOwner, entry.Name, owner.Hex())
+		}
+		return nil
+	}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.TransferOwnership(opts, newOwner)
+	})
+	if err != nil {
+		return receipt, err
+	}
+
+	pending, err := contract.PendingOwner(&bind.CallOpts{Context: ctx, BlockNumber: receipt.BlockNumber})
+	if err != nil {
+		return receipt, err
+	}
+	if pending != newOwner {
+		return receipt, fmt.Errorf("pending owner of %s is %s after the transfer, expected %s", entry.Name, pending.Hex(), newOwner.Hex())
+	}
+	return receipt, h.txs.Forget(key)
+}
+
+// acceptOwnership takes over the ownership of entry.
+func (h *Handover) acceptOwnership(ctx context.Context, entry manifest.Entry, contract Ownable2Step) (*types.Receipt, error) {
+	key := fmt.Sprintf("accept-ownership:%s", entry.Name)
+	if _, ok := h.txs.Get(key); !ok && entry.Type == manifest.TypeWERC721 {
+		// A retry after the ownership was taken goes on with the admin role.
+		owner, err := contract.Owner(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return nil, err
+		}
+		if owner == h.txs.From() {
+			return nil, nil
+		}
+	}
+	receipt, err := h.run(ctx, key, func(opts *bind.CallOpts) error {
+		pending, err := contract.PendingOwner(opts)
+		if err != nil {
+			return err
+		}
+		if pending != h.txs.From() {
+			return fmt.Errorf("%w of %s, %s is", ErrNotPendingOwner, entry.Name, pending.Hex())
+		}
+		return nil
+	}, contract.AcceptOwnership)
+	if err != nil {
+		return receipt, err
+	}
+
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: receipt.BlockNumber}
+	owner, err := contract.Owner(opts)
+	if err != nil {
+		return receipt, err
+	}
+	pending, err := contract.PendingOwner(opts)
+	if err != nil {
+		return receipt, err
+	}
+	if owner != h.txs.From() || pending != (common.Address{}) {
+		return receipt, fmt.Errorf("%s is owned by %s with pending owner %s after the acceptance", entry.Name, owner.Hex(), pending.Hex())
+	}
+	return receipt, h.txs.Forget(key)
+}
+
+// offerAdminRole offers the admin role of the sender to newAdmin, the
+// sender loses it once newAdmin accepts.
+func (h *Handover) offerAdminRole(ctx context.Context, entry manifest.Entry, newAdmin common.Address) (*types.Receipt, error) {
+	token, err := generated.NewWERC721(entry.Address, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("offer-admin-role:%s:%s", entry.Name, newAdmin.Hex())
+	receipt, err := h.run(ctx, key, func(opts *bind.CallOpts) error {
+		return h.checkAdmin(opts, entry, token)
+	}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return token.TransferAdminRole(opts, newAdmin)
+	})
+	if err != nil {
+		return receipt, err
+	}
+
+	offer, err := token.GetAdminRoleOffer(&bind.CallOpts{Context: ctx, BlockNumber: receipt.BlockNumber}, newAdmin)
+	if err != nil {
+		return receipt, err
+	}
+	if offer.OfferedBy != h.txs.From() || !offer.Handover {
+		return receipt, fmt.Errorf("admin role of %s is not offered to %s after the transfer", entry.Name, newAdmin.Hex())
+	}
+	return receipt, h.txs.Forget(key)
+}
+
+// cancelAdminRole withdraws the admin role offered to account, if any.
+func (h *Handover) cancelAdminRole(ctx context.Context, entry manifest.Entry, account common.Address) (*types.Receipt, error) {
+	token, err := generated.NewWERC721(entry.Address, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("cancel-admin-role:%s:%s", entry.Name, account.Hex())
+	if _, ok := h.txs.Get(key); !ok {
+		offer, err := token.GetAdminRoleOffer(&bind.CallOpts{Context: ctx}, account)
+		if err != nil {
+			return nil, err
+		}
+		if offer.OfferedBy == (common.Address{}) {
+			return nil, nil
+		}
+	}
+	receipt, err := h.run(ctx, key, func(opts *bind.CallOpts) error {
+		return h.checkAdmin(opts, entry, token)
+	}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return token.CancelAdminRoleOffer(opts, account)
+	})
+	if err != nil {
+		return receipt, err
+	}
+	return receipt, h.txs.Forget(key)
+}
+
+// acceptAdminRole takes the admin role offered to the sender.
+func (h *Handover) acceptAdminRole(ctx context.Context, entry manifest.Entry) (*types.Receipt, error) {
+	token, err := generated.NewWERC721(entry.Address, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	role, err := token.ADMINROLE(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("accept-admin-role:%s", entry.Name)
+	receipt, err := h.run(ctx, key, func(opts *bind.CallOpts) error {
+		offer, err := token.GetAdminRoleOffer(opts, h.txs.From())
+		if err != nil {
+			return err
+		}
+		if offer.OfferedBy == (common.Address{}) {
+			return fmt.Errorf("%w of %s", ErrNotOfferedAdmin, entry.Name)
+		}
+		return nil
+	}, token.AcceptAdminRole)
+	if err != nil {
+		return receipt, err
+	}
+
+	isAdmin, err := token.HasRole(&bind.CallOpts{Context: ctx, BlockNumber: receipt.BlockNumber}, role, h.txs.From())
+	if err != nil {
+		return receipt, err
+	}
+	if !isAdmin {
+		return receipt, fmt.Errorf("%s is not an admin of %s after the acceptance", h.txs.From().Hex(), entry.Name)
+	}
+	return receipt, h.txs.Forget(key)
+}
+
+func (h *Handover) checkAdmin(opts *bind.CallOpts, entry manifest.Entry, token *generated.WERC721) error {
+	role, err := token.ADMINROLE(opts)
+	if err != nil {
+		return err
+	}
+	isAdmin, err := token.HasRole(opts, role, h.txs.From())
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return fmt.Errorf("%w of %s", ErrNotAdmin, entry.Name)
+	}
+	return nil
+}
+
+// run checks the sender and sends the transaction of key, then waits for
+// it. A journaled transaction is followed without checking, as the check
+// may no longer hold once it is mined. A transaction that reverted or was
+// replaced is forgotten, so that a retry checks and sends it again.
+func (h *Handover) run(ctx context.Context, key string, check func(opts *bind.CallOpts) error, send func(opts *bind.TransactOpts) (*types.Transaction, error)) (*types.Receipt, error) {
+	if _, ok := h.txs.Get(key); !ok {
+		if err := check(&bind.CallOpts{Context: ctx}); err != nil {
+			return nil, err
+		}
+		if _, err := h.txs.Send(ctx, key, send); err != nil {
+			return nil, err
+		}
+	}
+	receipt, err := h.txs.Wait(ctx, key)
+	if errors.Is(err, txmanager.ErrReplaced) {
+		if err := h.txs.Forget(key); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("transaction of %s was replaced: %w", key, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		if err := h.txs.Forget(key); err != nil {
+			return receipt, err
+		}
+		return receipt, fmt.Errorf("transaction %s reverted", receipt.TxHash.Hex())
+	}
+	return receipt, nil
+}
+
+func bindOwnable(entry manifest.Entry, backend bind.ContractBackend) (Ownable2Step, error) {
+	switch entry.Type {
+	case manifest.TypeWETH:
+		return generated.NewWETH(entry.Address, backend)
+	case manifest.TypeWERC721:
+		return generated.NewWERC721(entry.Address, backend)
+	}
+	return nil, fmt.Errorf("%w: %s is a %s", ErrNotOwnable, entry.Name, entry.Type)
+}
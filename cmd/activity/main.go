@@ -0,0 +1,81 @@
+// Command activity prints what transactions did on the system contracts.
+//
+// Usage:
+//
+//	activity --auction 0x... --weth 0x... --werc721 0x... <txHash>...
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/one-click-platform/system-contracts/events"
+)
+
+func main() {
+	var (
+		rpcURL      = flag.String("rpc", "http://localhost:8545", "RPC endpoint of the node")
+		auctionAddr = flag.String("auction", "", "address of the Auction contract, skipped if empty")
+		wethAddr    = flag.String("weth", "", "address of the WETH contract, skipped if empty")
+		werc721Addr = flag.String("werc721", "", "address of the WERC721 contract, skipped if empty")
+		asJSON      = flag.Bool("json", false, "print the entries as JSON lines")
+	)
+	flag.Parse()
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(false)))
+
+	var addresses events.Addresses
+	for _, contract := range []struct {
+		name string
+		flag string
+		addr *common.Address
+	}{
+		{"auction", *auctionAddr, &addresses.Auction},
+		{"weth", *wethAddr, &addresses.WETH},
+		{"werc721", *werc721Addr, &addresses.WERC721},
+	} {
+		if contract.flag == "" {
+			continue
+		}
+		if !common.IsHexAddress(contract.flag) {
+			log.Crit("Invalid contract address", "contract", contract.name, "address", contract.flag)
+		}
+		*contract.addr = common.HexToAddress(contract.flag)
+	}
+	decoder, err := events.NewDecoder(addresses)
+	if err != nil {
+		log.Crit("Failed to create decoder", "err", err)
+	}
+
+	ctx := context.Background()
+	client, err := ethclient.DialContext(ctx, *rpcURL)
+	if err != nil {
+		log.Crit("Failed to connect to node", "err", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	for _, arg := range flag.Args() {
+		hash := common.HexToHash(arg)
+		receipt, err := client.TransactionReceipt(ctx, hash)
+		if err != nil {
+			log.Crit("Failed to fetch receipt", "tx", hash, "err", err)
+		}
+		activity, err := decoder.Activity(receipt)
+		if err != nil {
+			log.Crit("Failed to decode receipt", "tx", hash, "err", err)
+		}
+		for _, entry := range activity {
+			if *asJSON {
+				encoder.Encode(entry)
+				continue
+			}
+			fmt.Printf("%d %s #%d %s.%s: %s\n", entry.Block, entry.TxHash.Hex(), entry.Index, entry.Contract, entry.Event, entry.Summary)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+// Command admin hands over the ownership of the WETH and WERC721 contracts
+// of a deployment manifest and reports the privileged accounts of all of
+// them. A handover takes two steps: the owner offers the ownership with
+// transfer, then the new owner takes it with accept. On WERC721 the admin
+// role, which holds the minting and the roles, is handed over along with
+// the ownership, and the previous admin loses it on accept. supply reports
+// the supply, cap and minter usage of the WETH contracts.
+//
+// Usage:
+//
+//	admin --manifest goerli.json owners
+//	admin --manifest goerli.json supply
+//	admin --manifest goerli.json --contract weth --to 0x... transfer
+//	admin --manifest goerli.json --contract weth accept
+//	admin --manifest goerli.json --contract weth cancel
+//
+// transfer and cancel are signed by the owner, accept by the new owner. On
+// WERC721 the owner also has to be an admin.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/one-click-platform/system-contracts/admin"
+	"github.com/one-click-platform/system-contracts/manifest"
+	"github.com/one-click-platform/system-contracts/signer"
+	"github.com/one-click-platform/system-contracts/treasury"
+	"github.com/one-click-platform/system-contracts/txmanager"
+)
+
+func main() {
+	var (
+		rpcURL       = flag.String("rpc", "http://localhost:8545", "RPC endpoint of the node")
+		manifestPath = flag.String("manifest", "deployment.json", "deployment manifest listing the contracts")
+		contracts    = flag.String("contracts", "", "comma separated names of the contracts to report, all if empty")
+		contract     = flag.String("contract", "", "name of the contract to hand over")
+		to           = flag.String("to", "", "new owner offered the contract on transfer")
+		journal      = flag.String("journal", "admin-journal.json", "file the sent transactions are journaled to")
+		signerConf   = signer.Flags(flag.CommandLine, "ADMIN_KEY")
+	)
+	flag.Parse()
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(false)))
+
+	if flag.NArg() != 1 {
+		log.Crit("Expected one of owners, supply, transfer, accept or cancel", "args", flag.Args())
+	}
+	action := flag.Arg(0)
+	switch action {
+	case "owners", "supply", "transfer", "accept", "cancel":
+	default:
+		log.Crit("Unknown action", "action", action)
+	}
+
+	deployment, err := manifest.Load(*manifestPath)
+	if err != nil {
+		log.Crit("Failed to load manifest", "err", err)
+	}
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, *rpcURL)
+	if err != nil {
+		log.Crit("Failed to connect to node", "err", err)
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		log.Crit("Failed to read chain id", "err", err)
+	}
+	if chainID.Uint64() != deployment.ChainID {
+		log.Crit("Node serves another chain than the manifest", "node", chainID, "manifest", deployment.ChainID)
+	}
+
+	if action == "owners" || action == "supply" {
+		var names []string
+		if *contracts != "" {
+			names = strings.Split(*contracts, ",")
+		}
+		entries, err := deployment.Select(names)
+		if err != nil {
+			log.Crit("Failed to select contracts", "err", err)
+		}
+		if action == "supply" {
+			printSupply(ctx, client, entries)
+			return
+		}
+		owners, err := admin.Owners(ctx, client, entries)
+		if err != nil {
+			log.Crit("Failed to read owners", "err", err)
+		}
+		printOwners(owners)
+		return
+	}
+
+	if *contract == "" {
+		log.Crit("A --contract is required")
+	}
+	entry, err := deployment.Entry(*contract)
+	if err != nil {
+		log.Crit("Unknown contract", "err", err)
+	}
+	var newOwner common.Address
+	if action == "transfer" {
+		if !common.IsHexAddress(*to) {
+			log.Crit("Invalid new owner address", "address", *to)
+		}
+		if newOwner = common.HexToAddress(*to); newOwner == (common.Address{}) {
+			log.Crit("Use cancel to withdraw a transfer")
+		}
+	}
+
+	opts, err := transactOpts(ctx, client, signerConf)
+	if err != nil {
+		log.Crit("Failed to load admin key", "err", err)
+	}
+	txs, err := txmanager.New(client, opts, txmanager.Config{JournalPath: *journal})
+	if err != nil {
+		log.Crit("Failed to open transaction journal", "err", err)
+	}
+	if err := txs.Resume(ctx); err != nil {
+		log.Crit("Failed to resume journaled transactions", "err", err)
+	}
+	handover := admin.NewHandover(client, txs)
+
+	switch action {
+	case "transfer", "cancel":
+		receipt, err := handover.Transfer(ctx, entry, newOwner)
+		if err != nil {
+			log.Crit("Failed to transfer ownership", "contract", entry.Name, "err", err)
+		}
+		if action == "cancel" {
+			log.Info("Cancelled ownership transfer", "contract", entry.Name, "tx", receipt.TxHash)
+		} else {
+			log.Info("Offered ownership, the new owner has to accept it", "contract", entry.Name, "to", newOwner, "tx", receipt.TxHash)
+		}
+	case "accept":
+		receipt, err := handover.Accept(ctx, entry)
+		if err != nil {
+			log.Crit("Failed to accept ownership", "contract", entry.Name, "err", err)
+		}
+		log.Info("Accepted ownership", "contract", entry.Name, "owner", opts.From, "tx", receipt.TxHash)
+	}
+
+	owners, err := admin.Owners(ctx, client, []manifest.Entry{entry})
+	if err != nil {
+		log.Crit("Failed to read owners", "err", err)
+	}
+	printOwners(owners)
+}
+
+// printOwners lists the owners, pending owners and admins of the contracts.
+func printOwners(owners []admin.Ownership) {
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "CONTRACT\tTYPE\tADDRESS\tOWNER\tPENDING OWNER\tADMINS")
+	for _, o := range owners {
+		pending := "-"
+		if o.PendingOwner != (common.Address{}) {
+			pending = o.PendingOwner.Hex()
+		}
+		admins := make([]string, len(o.Admins))
+		for i, a := range o.Admins {
+			admins[i] = a.Hex()
+		}
+		if len(admins) == 0 {
+			admins = []string{"-"}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", o.Contract.Name, o.Contract.Type, o.Contract.Address.Hex(), o.Owner.Hex(), pending, strings.Join(admins, ","))
+	}
+	tw.Flush()
+}
+
+// printSupply reports the supply of the WETH contracts among entries.
+func printSupply(ctx context.Context, client *ethclient.Client, entries []manifest.Entry) {
+	for _, entry := range entries {
+		if entry.Type != manifest.TypeWETH {
+			continue
+		}
+		report, err := treasury.Read(ctx, client, entry.Address)
+		if err != nil {
+			log.Crit("Failed to read supply", "contract", entry.Name, "err", err)
+		}
+		fmt.Printf("%s\n", entry.Name)
+		if err := report.Print(os.Stdout); err != nil {
+			log.Crit("Failed to print supply", "err", err)
+		}
+		fmt.Println()
+	}
+}
+
+// transactOpts signs with the configured signer, by default with the hex
+// encoded private key in ADMIN_KEY.
+func transactOpts(ctx context.Context, client *ethclient.Client, config *signer.Config) (*bind.TransactOpts, error) {
+	s, err := signer.Open(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return signer.TransactOpts(ctx, s, chainID), nil
+}
@@ -0,0 +1,237 @@
+// Command auctionproxy deploys the Auction contract behind a transparent
+// proxy and upgrades it, refusing upgrades whose storage layout is not
+// compatible with the deployed one. Bytecode and storage layouts are read
+// from the output of generate.sh.
+//
+// Usage:
+//
+//	auctionproxy [flags] deploy   deploy an implementation, a ProxyAdmin and the proxy
+//	auctionproxy [flags] upgrade  deploy the built Auction and point the proxy at it
+//	auctionproxy [flags] status   print the implementation and admin of the proxy
+//	auctionproxy [flags] check    compare the built storage layout with the deployed one
+//
+// The deployment, including the storage layout of its implementation, is
+// kept in the file given by --deployment.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/one-click-platform/system-contracts/generated"
+	"github.com/one-click-platform/system-contracts/signer"
+	"github.com/one-click-platform/system-contracts/storagelayout"
+	"github.com/one-click-platform/system-contracts/txmanager"
+	"github.com/one-click-platform/system-contracts/upgrade"
+)
+
+func main() {
+	var (
+		rpcURL         = flag.String("rpc", "http://localhost:8545", "RPC endpoint of the node")
+		build          = flag.String("build", "./build", "solc output directory of generate.sh")
+		deploymentPath = flag.String("deployment", "auction-deployment.json", "file the deployment is kept in")
+		forwarderAddr  = flag.String("forwarder", "", "trusted forwarder the proxy is initialized with, none if empty")
+		adminAddr      = flag.String("admin", "", "existing ProxyAdmin to use on deploy, a new one is deployed if empty")
+		oldLayout      = flag.String("old-layout", "", "storage layout to check against instead of the deployed one")
+		call           = flag.String("call", "", "hex calldata called on the proxy within the upgrade")
+		initAdmin      = flag.String("initialize-admin", "", "auction admin set within the upgrade, for proxies deployed without one")
+		force          = flag.Bool("force", false, "upgrade even if the storage layouts are incompatible")
+		journal        = flag.String("journal", "auctionproxy-journal.json", "file the sent transactions are journaled to")
+		signerConf     = signer.Flags(flag.CommandLine, "DEPLOYER_KEY")
+	)
+	flag.Parse()
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(false)))
+
+	if flag.NArg() != 1 {
+		log.Crit("Expected one of deploy, upgrade, status or check", "args", flag.Args())
+	}
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	switch flag.Arg(0) {
+	case "check":
+		deployed, err := deployedLayout(*deploymentPath, *oldLayout)
+		if err != nil {
+			log.Crit("Failed to load deployed storage layout", "err", err)
+		}
+		built, err := upgrade.Build(*build).Layout("Auction")
+		if err != nil {
+			log.Crit("Failed to load built storage layout", "err", err)
+		}
+		issues := storagelayout.Compare(deployed, built)
+		printIssues(issues)
+		if storagelayout.HasErrors(issues) {
+			os.Exit(1)
+		}
+		fmt.Println("storage layout is compatible")
+		return
+
+	case "status":
+		deployment, err := upgrade.LoadDeployment(*deploymentPath)
+		if err != nil {
+			log.Crit("Failed to load deployment", "err", err)
+		}
+		client, err := ethclient.DialContext(ctx, *rpcURL)
+		if err != nil {
+			log.Crit("Failed to connect to node", "err", err)
+		}
+		implementation, admin, err := upgrade.Status(ctx, client, deployment.Admin, deployment.Proxy)
+		if err != nil {
+			log.Crit("Failed to read proxy status", "err", err)
+		}
+		fmt.Printf("proxy:          %s\n", deployment.Proxy.Hex())
+		fmt.Printf("admin:          %s\n", admin.Hex())
+		fmt.Printf("implementation: %s\n", implementation.Hex())
+		if implementation != deployment.Implementation || admin != deployment.Admin {
+			log.Crit("Proxy differs from the deployment file", "implementation", deployment.Implementation, "admin", deployment.Admin)
+		}
+		return
+
+	case "deploy", "upgrade":
+	default:
+		log.Crit("Unknown action", "action", flag.Arg(0))
+	}
+
+	client, err := ethclient.DialContext(ctx, *rpcURL)
+	if err != nil {
+		log.Crit("Failed to connect to node", "err", err)
+	}
+	opts, err := transactOpts(ctx, client, signerConf)
+	if err != nil {
+		log.Crit("Failed to load deployer key", "err", err)
+	}
+	txs, err := txmanager.New(client, opts, txmanager.Config{JournalPath: *journal})
+	if err != nil {
+		log.Crit("Failed to open transaction journal", "err", err)
+	}
+	if err := txs.Resume(ctx); err != nil {
+		log.Crit("Failed to resume journaled transactions", "err", err)
+	}
+	deployer := upgrade.NewDeployer(client, txs, upgrade.Build(*build))
+
+	if flag.Arg(0) == "deploy" {
+		if _, err := os.Stat(*deploymentPath); err == nil {
+			log.Crit("Deployment file exists already", "path", *deploymentPath)
+		}
+		admin, err := address(*adminAddr)
+		if err != nil {
+			log.Crit("Invalid admin address", "err", err)
+		}
+		forwarder, err := address(*forwarderAddr)
+		if err != nil {
+			log.Crit("Invalid forwarder address", "err", err)
+		}
+		deployment, err := deployer.Deploy(ctx, admin, forwarder)
+		if err != nil {
+			log.Crit("Failed to deploy", "err", err)
+		}
+		if err := deployment.Save(*deploymentPath); err != nil {
+			log.Crit("Failed to save deployment", "err", err)
+		}
+		log.Info("Deployed proxied auction", "proxy", deployment.Proxy, "admin", deployment.Admin, "implementation", deployment.Implementation)
+		return
+	}
+
+	deployment, err := upgrade.LoadDeployment(*deploymentPath)
+	if err != nil {
+		log.Crit("Failed to load deployment", "err", err)
+	}
+	if *oldLayout != "" {
+		if deployment.Layout, err = storagelayout.Load(*oldLayout, "Auction"); err != nil {
+			log.Crit("Failed to load deployed storage layout", "err", err)
+		}
+	}
+	calldata, err := hexutil.Decode(orEmpty(*call))
+	if err != nil {
+		log.Crit("Invalid calldata", "err", err)
+	}
+	if *initAdmin != "" {
+		if len(calldata) != 0 {
+			log.Crit("Only one of --call and --initialize-admin can be given")
+		}
+		if !common.IsHexAddress(*initAdmin) {
+			log.Crit("Invalid auction admin address", "address", *initAdmin)
+		}
+		auctionABI, err := abi.JSON(strings.NewReader(generated.AuctionABI))
+		if err != nil {
+			log.Crit("Failed to parse auction ABI", "err", err)
+		}
+		if calldata, err = auctionABI.Pack("initializeAdmin", common.HexToAddress(*initAdmin)); err != nil {
+			log.Crit("Failed to pack initializeAdmin", "err", err)
+		}
+	}
+	issues, err := deployer.Upgrade(ctx, deployment, calldata, *force)
+	printIssues(issues)
+	if err != nil {
+		log.Crit("Failed to upgrade", "err", err)
+	}
+	if err := deployment.Save(*deploymentPath); err != nil {
+		log.Crit("Failed to save deployment", "err", err)
+	}
+	log.Info("Upgraded proxied auction", "proxy", deployment.Proxy, "implementation", deployment.Implementation)
+}
+
+// deployedLayout loads the layout at path, or the one of the deployment.
+func deployedLayout(deploymentPath, path string) (*storagelayout.Layout, error) {
+	if path != "" {
+		return storagelayout.Load(path, "Auction")
+	}
+	deployment, err := upgrade.LoadDeployment(deploymentPath)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.Layout == nil {
+		return nil, fmt.Errorf("%s holds no storage layout", deploymentPath)
+	}
+	return deployment.Layout, nil
+}
+
+func printIssues(issues []storagelayout.Issue) {
+	for _, issue := range issues {
+		fmt.Println(issue)
+	}
+}
+
+// address parses an optional address flag, empty meaning the zero address.
+func address(s string) (common.Address, error) {
+	if s == "" {
+		return common.Address{}, nil
+	}
+	if !common.IsHexAddress(s) {
+		return common.Address{}, fmt.Errorf("invalid address %q", s)
+	}
+	return common.HexToAddress(s), nil
+}
+
+func orEmpty(s string) string {
+	if s == "" {
+		return "0x"
+	}
+	return s
+}
+
+// transactOpts signs with the configured signer, by default with the hex
+// encoded private key in DEPLOYER_KEY.
+func transactOpts(ctx context.Context, client *ethclient.Client, config *signer.Config) (*bind.TransactOpts, error) {
+	s, err := signer.Open(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return signer.TransactOpts(ctx, s, chainID), nil
+}
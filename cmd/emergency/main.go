@@ -0,0 +1,180 @@
+// Command emergency pauses and unpauses the system contracts of a
+// deployment manifest. While paused, the Auction takes no new auctions and
+// bids, and WETH and WERC721 transfers are halted except out of the
+// accounts exempt from the pause, so that claims and refunds stay possible.
+//
+// Usage:
+//
+//	emergency --manifest goerli.json status
+//	emergency --manifest goerli.json --reason "..." pause
+//	emergency --manifest goerli.json --contracts weth --reason "..." unpause
+//
+// Actions are confirmed by typing the manifest name, unless --yes is given,
+// and are appended to the audit log.
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/one-click-platform/system-contracts/emergency"
+	"github.com/one-click-platform/system-contracts/manifest"
+	"github.com/one-click-platform/system-contracts/signer"
+	"github.com/one-click-platform/system-contracts/txmanager"
+)
+
+func main() {
+	var (
+		rpcURL       = flag.String("rpc", "http://localhost:8545", "RPC endpoint of the node")
+		manifestPath = flag.String("manifest", "deployment.json", "deployment manifest listing the contracts")
+		contracts    = flag.String("contracts", "", "comma separated names of the contracts to act on, all if empty")
+		reason       = flag.String("reason", "", "why the contracts are paused or unpaused, recorded in the audit log")
+		auditPath    = flag.String("audit-log", "emergency-audit.log", "file the actions are appended to")
+		yes          = flag.Bool("yes", false, "do not ask for confirmation")
+		signerConf   = signer.Flags(flag.CommandLine, "EMERGENCY_KEY")
+	)
+	flag.Parse()
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(false)))
+
+	if flag.NArg() != 1 {
+		log.Crit("Expected one of status, pause or unpause", "args", flag.Args())
+	}
+	action := emergency.Action(flag.Arg(0))
+	switch action {
+	case "status", emergency.ActionPause, emergency.ActionUnpause:
+	default:
+		log.Crit("Unknown action", "action", action)
+	}
+
+	deployment, err := manifest.Load(*manifestPath)
+	if err != nil {
+		log.Crit("Failed to load manifest", "err", err)
+	}
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, *rpcURL)
+	if err != nil {
+		log.Crit("Failed to connect to node", "err", err)
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		log.Crit("Failed to read chain id", "err", err)
+	}
+	if chainID.Uint64() != deployment.ChainID {
+		log.Crit("Node serves another chain than the manifest", "node", chainID, "manifest", deployment.ChainID)
+	}
+	var names []string
+	if *contracts != "" {
+		names = strings.Split(*contracts, ",")
+	}
+	targets, err := emergency.Targets(client, deployment, names)
+	if err != nil {
+		log.Crit("Failed to bind contracts", "err", err)
+	}
+
+	if action == "status" {
+		states, err := emergency.Inspect(ctx, targets, common.Address{})
+		if err != nil {
+			log.Crit("Failed to read contracts", "err", err)
+		}
+		printStates(states, false)
+		return
+	}
+
+	if *reason == "" {
+		log.Crit("A --reason is required")
+	}
+	s, err := signer.Open(ctx, signerConf)
+	if err != nil {
+		log.Crit("Failed to load operator key", "err", err)
+	}
+	opts := signer.TransactOpts(ctx, s, chainID)
+
+	states, err := emergency.Inspect(ctx, targets, opts.From)
+	if err != nil {
+		log.Crit("Failed to read contracts", "err", err)
+	}
+	printStates(states, true)
+	pending := emergency.Pending(states, action)
+	if len(pending) == 0 {
+		fmt.Printf("Nothing to %s\n", action)
+		return
+	}
+	if action == emergency.ActionPause {
+		for _, s := range states {
+			if !s.Guardian && !s.Paused {
+				log.Crit("Operator is not a guardian", "contract", s.Target.Name, "operator", opts.From)
+			}
+		}
+	}
+	if !*yes && !confirm(deployment, action, pending) {
+		log.Crit("Not confirmed, nothing sent")
+	}
+
+	audit, err := emergency.OpenAuditLog(*auditPath)
+	if err != nil {
+		log.Crit("Failed to open audit log", "err", err)
+	}
+	defer audit.Close()
+
+	// An emergency action is sent once and followed to the end, so there is
+	// no journal to resume from.
+	txs, err := txmanager.New(client, opts, txmanager.Config{})
+	if err != nil {
+		log.Crit("Failed to create transaction manager", "err", err)
+	}
+	operator, err := emergency.NewOperator(deployment, txs, audit, *reason)
+	if err != nil {
+		log.Crit("Failed to create operator", "err", err)
+	}
+	if err := operator.Apply(ctx, action, pending); err != nil {
+		log.Crit("Emergency action incomplete", "err", err)
+	}
+	log.Info("Emergency action done", "action", action, "contracts", len(pending))
+}
+
+// confirm asks the operator to type the manifest name.
+func confirm(deployment *manifest.Manifest, action emergency.Action, targets []emergency.Target) bool {
+	fmt.Printf("\nAbout to %s on %s (chain %d):\n", action, deployment.Name, deployment.ChainID)
+	for _, t := range targets {
+		fmt.Printf("  %s %s %s\n", t.Name, t.Type, t.Address.Hex())
+	}
+	fmt.Printf("Type %q to confirm: ", deployment.Name)
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return false
+	}
+	return strings.TrimSpace(line) == deployment.Name
+}
+
+// printStates lists the states, with whether the operator is a guardian
+// if guardian is set.
+func printStates(states []emergency.State, guardian bool) {
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	if guardian {
+		fmt.Fprintln(tw, "CONTRACT\tTYPE\tADDRESS\tPAUSED\tGUARDIAN")
+	} else {
+		fmt.Fprintln(tw, "CONTRACT\tTYPE\tADDRESS\tPAUSED")
+	}
+	for _, s := range states {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%t", s.Target.Name, s.Target.Type, s.Target.Address.Hex(), s.Paused)
+		if guardian {
+			fmt.Fprintf(tw, "\t%t", s.Guardian)
+		}
+		fmt.Fprintln(tw)
+	}
+	tw.Flush()
+}
@@ -0,0 +1,126 @@
+// Command keeper runs the auction keeper daemon, which settles finished
+// auctions on behalf of their winners and creators.
+package main
+
+import (
+	"context"
+	_ "expvar"
+	"flag"
+	"math/big"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/one-click-platform/system-contracts/keeper"
+	"github.com/one-click-platform/system-contracts/reader"
+	"github.com/one-click-platform/system-contracts/signer"
+	"github.com/one-click-platform/system-contracts/txmanager"
+)
+
+func main() {
+	var (
+		rpcURL      = flag.String("rpc", "http://localhost:8545", "RPC endpoint of the node")
+		auctionAddr = flag.String("auction", "", "address of the Auction contract")
+		interval    = flag.Duration("interval", keeper.DefaultInterval, "time between two scans")
+		gasLimit    = flag.Uint64("gas-limit", keeper.DefaultGasLimit, "gas limit of a settlement transaction")
+		maxGasPrice = flag.String("max-gas-price", "", "highest gas price in wei to settle at, unbounded if empty")
+		batchSize   = flag.Int("batch-size", reader.DefaultBatchSize, "auctions read per RPC batch")
+		dryRun      = flag.Bool("dry-run", false, "only log the settlements that would be sent")
+		backoff     = flag.Duration("retry-backoff", keeper.DefaultRetryBackoff, "wait before retrying a failed settlement, doubled on every failure")
+		maxAttempts = flag.Int("max-attempts", keeper.DefaultMaxAttempts, "failed settlements after which an auction is given up")
+		journal     = flag.String("journal", "keeper-journal.json", "file the sent transactions are journaled to")
+		confirms    = flag.Uint64("confirmations", 0, "blocks on top of a settlement before it is considered final")
+		metricsAddr = flag.String("metrics", "", "address to serve expvar metrics on, disabled if empty")
+		signerConf  = signer.Flags(flag.CommandLine, "KEEPER_KEY")
+	)
+	flag.Parse()
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(false)))
+
+	if !common.IsHexAddress(*auctionAddr) {
+		log.Crit("Invalid auction address", "address", *auctionAddr)
+	}
+	config := keeper.Config{
+		Interval:     *interval,
+		GasLimit:     *gasLimit,
+		DryRun:       *dryRun,
+		RetryBackoff: *backoff,
+		MaxAttempts:  *maxAttempts,
+	}
+	if *maxGasPrice != "" {
+		price, ok := new(big.Int).SetString(*maxGasPrice, 10)
+		if !ok {
+			log.Crit("Invalid max gas price", "price", *maxGasPrice)
+		}
+		config.MaxGasPrice = price
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	rpcClient, err := rpc.DialContext(ctx, *rpcURL)
+	if err != nil {
+		log.Crit("Failed to connect to node", "err", err)
+	}
+	client := ethclient.NewClient(rpcClient)
+
+	opts, err := transactOpts(ctx, client, signerConf)
+	if err != nil {
+		log.Crit("Failed to load keeper key", "err", err)
+	}
+
+	txs, err := txmanager.New(client, opts, txmanager.Config{
+		Confirmations: *confirms,
+		MaxGasPrice:   config.MaxGasPrice,
+		JournalPath:   *journal,
+	})
+	if err != nil {
+		log.Crit("Failed to open transaction journal", "err", err)
+	}
+	if err := txs.Resume(ctx); err != nil {
+		log.Crit("Failed to resume journaled transactions", "err", err)
+	}
+
+	address := common.HexToAddress(*auctionAddr)
+	r, err := reader.NewAuctionReader(rpcClient, address, *batchSize)
+	if err != nil {
+		log.Crit("Failed to create auction reader", "err", err)
+	}
+	k, err := keeper.New(client, r, address, txs, config)
+	if err != nil {
+		log.Crit("Failed to create keeper", "err", err)
+	}
+
+	if *metricsAddr != "" {
+		go func() {
+			if err := http.ListenAndServe(*metricsAddr, nil); err != nil {
+				log.Error("Metrics server stopped", "err", err)
+			}
+		}()
+	}
+
+	log.Info("Auction keeper started", "auction", address, "sender", opts.From, "dryRun", *dryRun)
+	if err := k.Run(ctx); err != nil && err != context.Canceled {
+		log.Crit("Auction keeper stopped", "err", err)
+	}
+}
+
+// transactOpts signs with the configured signer, by default with the hex
+// encoded private key in KEEPER_KEY.
+func transactOpts(ctx context.Context, client *ethclient.Client, config *signer.Config) (*bind.TransactOpts, error) {
+	s, err := signer.Open(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return signer.TransactOpts(ctx, s, chainID), nil
+}
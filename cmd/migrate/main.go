@@ -0,0 +1,159 @@
+// Command migrate moves the auctions of an Auction deployment to a new one.
+//
+// Usage:
+//
+//	migrate [flags] snapshot  read every auction of --old into --snapshot
+//	migrate [flags] plan      classify the snapshot into --plan and print the report
+//	migrate [flags] run       execute the due steps of the plan, --dry-run only lists them
+//
+// Auctions can not be cancelled, so run settles each auction once it
+// finished and has to be repeated until no step is waiting. Progress is
+// kept in --checkpoints, an interrupted run resumes where it stopped.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/one-click-platform/system-contracts/migration"
+	"github.com/one-click-platform/system-contracts/reader"
+	"github.com/one-click-platform/system-contracts/signer"
+	"github.com/one-click-platform/system-contracts/txmanager"
+)
+
+func main() {
+	var (
+		rpcURL       = flag.String("rpc", "http://localhost:8545", "RPC endpoint of the node")
+		oldAddr      = flag.String("old", "", "address of the Auction contract migrated from")
+		newAddr      = flag.String("new", "", "address of the Auction contract migrated to, auctions are not recreated if empty")
+		migratorAddr = flag.String("migrator", "", "account sending the migration, recreates its own auctions")
+		snapshotPath = flag.String("snapshot", "migration-snapshot.json", "file the snapshot is kept in")
+		planPath     = flag.String("plan", "migration-plan.json", "file the plan is kept in")
+		checkpoints  = flag.String("checkpoints", "migration-checkpoints.json", "file the progress is kept in")
+		journal      = flag.String("journal", "migration-journal.json", "file the sent transactions are journaled to")
+		batchSize    = flag.Int("batch-size", reader.DefaultBatchSize, "auctions read per RPC batch")
+		startDelay   = flag.Duration("start-delay", migration.DefaultStartDelay, "time between recreating an auction and its start")
+		increment    = flag.Duration("duration-increment", migration.DefaultDurationIncrement, "duration increment of recreated auctions that had none")
+		dryRun       = flag.Bool("dry-run", false, "only list the steps that are due")
+		signerConf   = signer.Flags(flag.CommandLine, "MIGRATOR_KEY")
+	)
+	flag.Parse()
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(false)))
+
+	if flag.NArg() != 1 {
+		log.Crit("Expected one of snapshot, plan or run", "args", flag.Args())
+	}
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	switch flag.Arg(0) {
+	case "snapshot":
+		if !common.IsHexAddress(*oldAddr) {
+			log.Crit("Invalid old auction address", "address", *oldAddr)
+		}
+		client, err := rpc.DialContext(ctx, *rpcURL)
+		if err != nil {
+			log.Crit("Failed to connect to node", "err", err)
+		}
+		r, err := reader.NewAuctionReader(client, common.HexToAddress(*oldAddr), *batchSize)
+		if err != nil {
+			log.Crit("Failed to create auction reader", "err", err)
+		}
+		snapshot, err := migration.Take(ctx, ethclient.NewClient(client), r, common.HexToAddress(*oldAddr))
+		if err != nil {
+			log.Crit("Failed to snapshot auctions", "err", err)
+		}
+		if err := snapshot.Save(*snapshotPath); err != nil {
+			log.Crit("Failed to save snapshot", "err", err)
+		}
+		log.Info("Snapshot taken", "auctions", len(snapshot.Auctions), "block", snapshot.Block, "path", *snapshotPath)
+
+	case "plan":
+		snapshot, err := migration.LoadSnapshot(*snapshotPath)
+		if err != nil {
+			log.Crit("Failed to load snapshot", "err", err)
+		}
+		var newContract common.Address
+		if *newAddr != "" {
+			if !common.IsHexAddress(*newAddr) {
+				log.Crit("Invalid new auction address", "address", *newAddr)
+			}
+			newContract = common.HexToAddress(*newAddr)
+		}
+		if !common.IsHexAddress(*migratorAddr) {
+			log.Crit("Invalid migrator address", "address", *migratorAddr)
+		}
+		plan := migration.NewPlan(snapshot, newContract, common.HexToAddress(*migratorAddr))
+		if err := plan.Save(*planPath); err != nil {
+			log.Crit("Failed to save plan", "err", err)
+		}
+		if err := plan.Report(os.Stdout); err != nil {
+			log.Crit("Failed to write report", "err", err)
+		}
+
+	case "run":
+		plan, err := migration.LoadPlan(*planPath)
+		if err != nil {
+			log.Crit("Failed to load plan", "err", err)
+		}
+		client, err := ethclient.DialContext(ctx, *rpcURL)
+		if err != nil {
+			log.Crit("Failed to connect to node", "err", err)
+		}
+		opts, err := transactOpts(ctx, client, signerConf)
+		if err != nil {
+			log.Crit("Failed to load migrator key", "err", err)
+		}
+		txs, err := txmanager.New(client, opts, txmanager.Config{JournalPath: *journal})
+		if err != nil {
+			log.Crit("Failed to open transaction journal", "err", err)
+		}
+		if err := txs.Resume(ctx); err != nil {
+			log.Crit("Failed to resume journaled transactions", "err", err)
+		}
+		m, err := migration.New(client, plan, txs, migration.Config{
+			CheckpointPath:    *checkpoints,
+			StartDelay:        *startDelay,
+			DurationIncrement: *increment,
+			DryRun:            *dryRun,
+		})
+		if err != nil {
+			log.Crit("Failed to create migrator", "err", err)
+		}
+		progress, err := m.Run(ctx)
+		fmt.Printf("done: %d, due: %d, waiting: %d\n", progress.Done, progress.Due, progress.Waiting)
+		if err != nil {
+			log.Crit("Migration stopped", "err", err)
+		}
+		if progress.Waiting != 0 {
+			log.Info("Auctions still running, run again once they finished", "waiting", progress.Waiting)
+		}
+
+	default:
+		log.Crit("Unknown action", "action", flag.Arg(0))
+	}
+}
+
+// transactOpts signs with the configured signer, by default with the hex
+// encoded private key in MIGRATOR_KEY.
+func transactOpts(ctx context.Context, client *ethclient.Client, config *signer.Config) (*bind.TransactOpts, error) {
+	s, err := signer.Open(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return signer.TransactOpts(ctx, s, chainID), nil
+}
@@ -0,0 +1,129 @@
+// Command proxybid bids the minimum raising amount on auctions up to a
+// ceiling per auction and a total budget.
+//
+// Usage:
+//
+//	proxybid --auction 0x... --weth 0x... --budget 5000 <auctionId>:<ceiling>...
+package main
+
+import (
+	"context"
+	"flag"
+	"math/big"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/one-click-platform/system-contracts/proxybid"
+	"github.com/one-click-platform/system-contracts/signer"
+	"github.com/one-click-platform/system-contracts/txmanager"
+)
+
+func main() {
+	var (
+		rpcURL      = flag.String("rpc", "ws://localhost:8546", "websocket endpoint of the node")
+		auctionAddr = flag.String("auction", "", "address of the Auction contract")
+		wethAddr    = flag.String("weth", "", "address of the WETH token the auctions are paid in")
+		budget      = flag.String("budget", "", "highest total in wei of leading and won bids")
+		interval    = flag.Duration("interval", proxybid.DefaultInterval, "time between two checks of the auctions")
+		journal     = flag.String("journal", "proxybid-journal.json", "file the sent transactions are journaled to")
+		signerConf  = signer.Flags(flag.CommandLine, "PROXYBID_KEY")
+	)
+	flag.Parse()
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(false)))
+
+	if !common.IsHexAddress(*auctionAddr) {
+		log.Crit("Invalid auction address", "address", *auctionAddr)
+	}
+	if !common.IsHexAddress(*wethAddr) {
+		log.Crit("Invalid WETH address", "address", *wethAddr)
+	}
+	total, ok := new(big.Int).SetString(*budget, 10)
+	if !ok {
+		log.Crit("Invalid budget", "budget", *budget)
+	}
+	if flag.NArg() == 0 {
+		log.Crit("No auctions to bid on, pass them as <auctionId>:<ceiling>")
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, *rpcURL)
+	if err != nil {
+		log.Crit("Failed to connect to node", "err", err)
+	}
+	opts, err := transactOpts(ctx, client, signerConf)
+	if err != nil {
+		log.Crit("Failed to load bidder key", "err", err)
+	}
+
+	txs, err := txmanager.New(client, opts, txmanager.Config{JournalPath: *journal})
+	if err != nil {
+		log.Crit("Failed to open transaction journal", "err", err)
+	}
+	if err := txs.Resume(ctx); err != nil {
+		log.Crit("Failed to resume journaled transactions", "err", err)
+	}
+
+	bidder, err := proxybid.New(client, common.HexToAddress(*auctionAddr), common.HexToAddress(*wethAddr), txs, proxybid.Config{
+		Budget:   total,
+		Interval: *interval,
+	})
+	if err != nil {
+		log.Crit("Failed to create bidder", "err", err)
+	}
+	for _, arg := range flag.Args() {
+		id, ceiling, ok := parseAuction(arg)
+		if !ok {
+			log.Crit("Invalid auction, expected <auctionId>:<ceiling>", "arg", arg)
+		}
+		if err := bidder.Add(ctx, id, ceiling); err != nil {
+			log.Crit("Failed to track auction", "auction", id, "err", err)
+		}
+	}
+
+	log.Info("Proxy bidder started", "bidder", opts.From, "auctions", flag.NArg(), "budget", total)
+	if err := bidder.Run(ctx); err != nil && err != context.Canceled {
+		log.Crit("Proxy bidder stopped", "err", err)
+	}
+	for _, a := range bidder.Auctions() {
+		log.Info("Auction result", "auction", a.ID, "won", a.Won(), "bid", a.Bid, "stopped", a.Stopped)
+	}
+}
+
+func parseAuction(arg string) (*big.Int, *big.Int, bool) {
+	parts := strings.SplitN(arg, ":", 2)
+	if len(parts) != 2 {
+		return nil, nil, false
+	}
+	id, ok := new(big.Int).SetString(parts[0], 10)
+	if !ok {
+		return nil, nil, false
+	}
+	ceiling, ok := new(big.Int).SetString(parts[1], 10)
+	if !ok {
+		return nil, nil, false
+	}
+	return id, ceiling, true
+}
+
+// transactOpts signs with the configured signer, by default with the hex
+// encoded private key in PROXYBID_KEY.
+func transactOpts(ctx context.Context, client *ethclient.Client, config *signer.Config) (*bind.TransactOpts, error) {
+	s, err := signer.Open(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return signer.TransactOpts(ctx, s, chainID), nil
+}
@@ -0,0 +1,155 @@
+// Command relayer serves the gasless relayer, which submits forward
+// requests signed by users to the Forwarder contract and pays their gas.
+//
+// Usage:
+//
+//	relayer --forwarder 0x... --auction 0x... --weth 0x... --werc721 0x... --keystore ./keys
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/one-click-platform/system-contracts/relayer"
+	"github.com/one-click-platform/system-contracts/signer"
+	"github.com/one-click-platform/system-contracts/txmanager"
+)
+
+func main() {
+	var (
+		rpcURL        = flag.String("rpc", "http://localhost:8545", "RPC endpoint of the node")
+		forwarderAddr = flag.String("forwarder", "", "address of the Forwarder contract")
+		auctionAddr   = flag.String("auction", "", "address of the Auction contract, not relayed if empty")
+		wethAddr      = flag.String("weth", "", "address of the WETH contract, not relayed if empty")
+		werc721Addr   = flag.String("werc721", "", "address of the WERC721 contract, not relayed if empty")
+		listen        = flag.String("listen", ":8080", "address to serve the relayer on")
+		quota         = flag.Int("quota", relayer.DefaultQuota, "requests relayed per user and window")
+		gasQuota      = flag.Uint64("gas-quota", 0, "gas relayed per user and window, unbounded if zero")
+		window        = flag.Duration("window", relayer.DefaultWindow, "period the quotas apply to")
+		maxGas        = flag.Uint64("max-gas", relayer.DefaultMaxGas, "highest gas of a single request")
+		journal       = flag.String("journal", "relayer-journal.json", "file the sent transactions are journaled to")
+		signerConf    = signer.Flags(flag.CommandLine, "RELAYER_KEY")
+	)
+	flag.Parse()
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(false)))
+
+	if !common.IsHexAddress(*forwarderAddr) {
+		log.Crit("Invalid forwarder address", "address", *forwarderAddr)
+	}
+	config := relayer.Config{
+		Quota:    *quota,
+		GasQuota: *gasQuota,
+		Window:   *window,
+		MaxGas:   *maxGas,
+	}
+	for _, target := range []struct {
+		name string
+		flag string
+	}{
+		{"auction", *auctionAddr},
+		{"weth", *wethAddr},
+		{"werc721", *werc721Addr},
+	} {
+		if target.flag == "" {
+			continue
+		}
+		if !common.IsHexAddress(target.flag) {
+			log.Crit("Invalid contract address", "contract", target.name, "address", target.flag)
+		}
+		config.Targets = append(config.Targets, common.HexToAddress(target.flag))
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, *rpcURL)
+	if err != nil {
+		log.Crit("Failed to connect to node", "err", err)
+	}
+	opts, err := transactOpts(ctx, client, signerConf)
+	if err != nil {
+		log.Crit("Failed to load relayer key", "err", err)
+	}
+	txs, err := txmanager.New(client, opts, txmanager.Config{JournalPath: *journal})
+	if err != nil {
+		log.Crit("Failed to open transaction journal", "err", err)
+	}
+	if err := txs.Resume(ctx); err != nil {
+		log.Crit("Failed to resume journaled transactions", "err", err)
+	}
+
+	r, err := relayer.New(client, common.HexToAddress(*forwarderAddr), txs, config)
+	if err != nil {
+		log.Crit("Failed to create relayer", "err", err)
+	}
+	go checkPending(ctx, txs)
+
+	server := &http.Server{Addr: *listen, Handler: relayer.NewHandler(r)}
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+
+	log.Info("Relayer started", "listen", *listen, "sender", opts.From, "targets", len(config.Targets))
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		log.Crit("Relayer stopped", "err", err)
+	}
+}
+
+// checkPending follows the relayed transactions, replacing stuck ones and
+// forgetting confirmed ones.
+func checkPending(ctx context.Context, txs *txmanager.Manager) {
+	ticker := time.NewTicker(txmanager.DefaultPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for _, tx := range txs.Pending() {
+			receipt, err := txs.Check(ctx, tx.Key)
+			if err != nil {
+				log.Warn("Failed to check relayed transaction", "key", tx.Key, "err", err)
+				if errors.Is(err, txmanager.ErrReplaced) {
+					txs.Forget(tx.Key)
+				}
+				continue
+			}
+			if receipt == nil {
+				continue
+			}
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				log.Warn("Relayed transaction reverted", "key", tx.Key, "tx", receipt.TxHash)
+			}
+			txs.Forget(tx.Key)
+		}
+	}
+}
+
+// transactOpts signs with the configured signer, by default with the hex
+// encoded private key in RELAYER_KEY.
+func transactOpts(ctx context.Context, client *ethclient.Client, config *signer.Config) (*bind.TransactOpts, error) {
+	s, err := signer.Open(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return signer.TransactOpts(ctx, s, chainID), nil
+}
@@ -0,0 +1,57 @@
+// Command signer serves the remote signer protocol of the signer package for
+// a local key, standing in for a dedicated signing service during
+// development and tests. Clients must send the token in SIGNER_TOKEN, as
+// the signer signs any hash it is given; --insecure serves without one.
+//
+// Usage:
+//
+//	SIGNER_TOKEN=... signer --keystore ./keys --password-file ./password --chain-id 1337
+package main
+
+import (
+	"context"
+	"flag"
+	"math/big"
+	"net/http"
+	"os"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/one-click-platform/system-contracts/signer"
+)
+
+func main() {
+	var (
+		listen     = flag.String("listen", "127.0.0.1:8550", "address to serve the signer on")
+		chainID    = flag.Int64("chain-id", 0, "only sign transactions of this chain, any chain if zero")
+		insecure   = flag.Bool("insecure", false, "serve without a token when none is set, letting anyone who can connect sign")
+		signerConf = signer.Flags(flag.CommandLine, "SIGNER_KEY")
+	)
+	flag.Parse()
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(false)))
+
+	if signerConf.URL != "" {
+		log.Crit("A remote signer can not be served again")
+	}
+	s, err := signer.Open(context.Background(), signerConf)
+	if err != nil {
+		log.Crit("Failed to open signer", "err", err)
+	}
+	var chain *big.Int
+	if *chainID != 0 {
+		chain = big.NewInt(*chainID)
+	}
+	server := signer.NewServer(s, signerConf.Token, chain)
+	if signerConf.Token == "" {
+		if !*insecure {
+			log.Crit("A token is required, set " + signer.TokenEnv + " or pass --insecure")
+		}
+		log.Warn("Serving without authentication, anyone who can connect can sign")
+		server = signer.NewInsecureServer(s, chain)
+	}
+
+	log.Info("Signer started", "listen", *listen, "account", s.Address(), "chainId", chain)
+	if err := http.ListenAndServe(*listen, server); err != nil {
+		log.Crit("Signer stopped", "err", err)
+	}
+}
@@ -0,0 +1,72 @@
+// Command stream serves auction, WERC721 and WETH events to WebSocket and
+// server-sent events clients.
+package main
+
+import (
+	"context"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/one-click-platform/system-contracts/stream"
+	"github.com/one-click-platform/system-contracts/watch"
+)
+
+func main() {
+	var (
+		rpcURL        = flag.String("rpc", "ws://localhost:8546", "websocket endpoint of the node")
+		auctionAddr   = flag.String("auction", "", "address of the Auction contract")
+		werc721Addr   = flag.String("werc721", "", "address of the WERC721 contract")
+		wethAddr      = flag.String("weth", "", "address of the WETH contract")
+		fromBlock     = flag.Uint64("from-block", 0, "first block to stream events of, the next confirmed block if zero")
+		confirmations = flag.Uint64("confirmations", 0, "blocks built on top of an event before it is streamed")
+		history       = flag.Int("history", stream.DefaultHistory, "recent events kept for replay")
+		listenAddr    = flag.String("listen", ":8080", "address to serve clients on")
+	)
+	flag.Parse()
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(false)))
+
+	for name, addr := range map[string]string{"auction": *auctionAddr, "werc721": *werc721Addr, "weth": *wethAddr} {
+		if !common.IsHexAddress(addr) {
+			log.Crit("Invalid contract address", "contract", name, "address", addr)
+		}
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	hub := stream.NewHub(*history)
+	watcher := watch.New(func(ctx context.Context) (watch.Backend, error) {
+		return ethclient.DialContext(ctx, *rpcURL)
+	}, watch.Config{Confirmations: *confirmations})
+	defer watcher.Close()
+
+	source, err := stream.NewSource(watcher, hub, stream.Config{
+		Auction:   common.HexToAddress(*auctionAddr),
+		WERC721:   common.HexToAddress(*werc721Addr),
+		WETH:      common.HexToAddress(*wethAddr),
+		FromBlock: *fromBlock,
+	})
+	if err != nil {
+		log.Crit("Failed to create event source", "err", err)
+	}
+
+	server := &http.Server{Addr: *listenAddr, Handler: stream.NewHandler(hub)}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Crit("Stream server stopped", "err", err)
+		}
+	}()
+
+	log.Info("Event stream started", "listen", *listenAddr)
+	if err := source.Run(ctx); err != nil && err != context.Canceled {
+		log.Error("Event source stopped", "err", err)
+	}
+	server.Close()
+}
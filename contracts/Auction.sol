@@ -4,16 +4,23 @@ pragma experimental ABIEncoderV2;
 
 import "@openzeppelin/contracts/token/ERC721/IERC721.sol";
 import "@openzeppelin/contracts/token/ERC20/IERC20.sol";
+import "@openzeppelin/contracts/token/ERC20/extensions/draft-IERC20Permit.sol";
 import "@openzeppelin/contracts/utils/math/SafeMath.sol";
 import "@openzeppelin/contracts/utils/Address.sol";
+import "@openzeppelin/contracts/proxy/utils/Initializable.sol";
+import "./ERC2771Recipient.sol";
 import "./Globals.sol";
 
-contract Auction {
+contract Auction is Initializable, ERC2771Recipient {
     using SafeMath for uint256;
     using Address for address;
 
     enum AuctionStatus {NONE, PENDING, ACTIVE, FINISHED, CLOSED}
 
+    // EIP-1967 slot a TransparentUpgradeableProxy keeps its admin in,
+    // bytes32(uint256(keccak256("eip1967.proxy.admin")) - 1).
+    bytes32 private constant PROXY_ADMIN_SLOT = 0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103;
+
     struct AuctionInfo {
         address creator;
         uint256 startPrice;
@@ -40,11 +47,85 @@ contract Auction {
     event AuctionBid(uint256 _auctionId, address _bidder, uint256 _amount);
     event RepaymentTransferred(uint256 _auctionId, address _creator);
     event LotTransferred(uint256 _auctionId, address _winner);
+    event BidCountered(uint256 _auctionId, address _bidder, uint256 _amount);
+    event Paused(address _account);
+    event Unpaused(address _account);
+    event GuardianChanged(address _guardian, bool _isGuardian);
+    event AdminTransferred(address _previousAdmin, address _newAdmin);
 
     uint256 public countOfAuctions;
     mapping(uint256 => AuctionInfo) private auctions;
+    mapping(address => uint256[]) private creatorAuctions;
+    mapping(address => uint256[]) private bidderAuctions;
+    mapping(uint256 => mapping(address => bool)) private hasBid;
+    mapping(uint256 => uint256) private maxBids;
+
+    // Appended after the variables above, which keeps the storage layout of
+    // deployed proxies.
+    address private admin;
+    mapping(address => bool) private guardians;
+    bool private auctionsPaused;
+
+    // Deployed directly, the constructor initializes the contract. Deployed as
+    // the implementation of a proxy, initialize is called through the proxy.
+    constructor(address _trustedForwarder) ERC2771Recipient(_trustedForwarder) initializer {
+        setAdmin(_msgSender());
+    }
+
+    function initialize(address _trustedForwarder, address _admin) external initializer {
+        _setTrustedForwarder(_trustedForwarder);
+        setAdmin(_admin);
+    }
 
-    constructor() {}
+    // Sets the admin of proxies initialized before there was one. Only the
+    // ProxyAdmin may call it, within upgradeAndCall, so that nobody can take
+    // over the admin between the upgrade and the call.
+    function initializeAdmin(address _admin) external {
+        require(msg.sender == proxyAdmin(), "Caller is not the proxy admin");
+        require(admin == address(0), "Admin is already set");
+
+        setAdmin(_admin);
+    }
+
+    function getAdmin() external view returns (address) {
+        return admin;
+    }
+
+    function transferAdmin(address _newAdmin) external onlyAdmin {
+        setAdmin(_newAdmin);
+    }
+
+    function setGuardian(address _guardian, bool _isGuardian) external onlyAdmin {
+        guardians[_guardian] = _isGuardian;
+
+        emit GuardianChanged(_guardian, _isGuardian);
+    }
+
+    function isGuardian(address _account) public view returns (bool) {
+        return guardians[_account] || _account == admin;
+    }
+
+    function paused() external view returns (bool) {
+        return auctionsPaused;
+    }
+
+    // Pausing stops new auctions and bids. Claims, settlements and refunds of
+    // lots stay possible, so that nothing is locked while paused.
+    function pause() external whenNotPaused {
+        require(isGuardian(_msgSender()), "Is not a guardian");
+
+        auctionsPaused = true;
+
+        emit Paused(_msgSender());
+    }
+
+    function unpause() external onlyAdmin {
+        require(auctionsPaused, "Auction is not paused");
+
+        auctionsPaused = false;
+
+        emit Unpaused(_msgSender());
+    }
 
     function createAuction(
         address _tokenAddress,
@@ -57,10 +138,10 @@ contract Auction {
         uint256 _durationIncrement,
         uint256 _bidIncrement,
         string memory _description
-    ) external returns (uint256) {
+    ) external whenNotPaused returns (uint256) {
         require(_tokenAddress.isContract(), "Given token is not a contract");
         IERC721 _tokenContract = IERC721(_tokenAddress);
-        require(_tokenContract.ownerOf(_tokenId) == msg.sender, "Is not owner of asset");
+        require(_tokenContract.ownerOf(_tokenId) == _msgSender(), "Is not owner of asset");
         require(_tokenContract.getApproved(_tokenId) == address(this), "Lot is not approved");
         require(_currencyAddress.isContract(), "Given currency is not a contract");
         require(_startPrice != 0, "Invalid start price");
@@ -69,7 +150,7 @@ contract Auction {
         require(_durationIncrement != 0, "Invalid auction increment");
         require(0 < _bidIncrement && _bidIncrement <= getDecimal(), "Invalid bid increment");
 
-        _tokenContract.transferFrom(msg.sender, address(this), _tokenId);
+        _tokenContract.transferFrom(_msgSender(), address(this), _tokenId);
 
         AuctionInfo memory _auction;
 
@@ -81,17 +162,19 @@ contract Auction {
             _auction.duration = _duration;
         }
 
-        _auction.creator = msg.sender;
+        _auction.creator = _msgSender();
         _auction.tokenAddress = _tokenAddress;
         _auction.tokenId = _tokenId;
         _auction.currencyAddress = _currencyAddress;
         _auction.startPrice = _startPrice;
         _auction.buyNowPrice = _buyNowPrice;
+        _auction.durationIncrement = _durationIncrement;
         _auction.bidIncrement = _bidIncrement;
         _auction.description = _description;
 
         uint256 _auctionId = countOfAuctions;
         auctions[_auctionId] = _auction;
+        creatorAuctions[_msgSender()].push(_auctionId);
         countOfAuctions++;
 
         emit AuctionCreated(_auction.creator, _auction.tokenAddress, _auction.tokenId, _auction.currencyAddress, _auctionId);
@@ -103,6 +186,33 @@ contract Auction {
         return auctions[_auctionId];
     }
 
+    function getAuctions(uint256 _offset, uint256 _limit) external view returns (AuctionInfo[] memory) {
+        uint256 _end = pageEnd(_offset, _limit, countOfAuctions);
+        AuctionInfo[] memory _page = new AuctionInfo[](_end.sub(_offset));
+
+        for (uint256 i = _offset; i < _end; i++) {
+            _page[i.sub(_offset)] = auctions[i];
+        }
+
+        return _page;
+    }
+
+    function countOfCreatorAuctions(address _creator) external view returns (uint256) {
+        return creatorAuctions[_creator].length;
+    }
+
+    function getCreatorAuctions(address _creator, uint256 _offset, uint256 _limit) external view returns (uint256[] memory) {
+        return getPage(creatorAuctions[_creator], _offset, _limit);
+    }
+
+    function countOfBidderAuctions(address _bidder) external view returns (uint256) {
+        return bidderAuctions[_bidder].length;
+    }
+
+    function getBidderAuctions(address _bidder, uint256 _offset, uint256 _limit) external view returns (uint256[] memory) {
+        return getPage(bidderAuctions[_bidder], _offset, _limit);
+    }
+
     function getStatus(uint256 _auctionId) public view returns (AuctionStatus) {
         AuctionInfo memory _auction = auctions[_auctionId];
 
@@ -125,33 +235,111 @@ contract Auction {
         return AuctionStatus.FINISHED;
     }
 
-    function bid(uint256 _auctionId, uint256 _amount) external {
+    function bid(uint256 _auctionId, uint256 _amount) external whenNotPaused {
+        placeBid(_auctionId, _amount, false);
+    }
+
+    function bidMax(uint256 _auctionId, uint256 _maxAmount) external whenNotPaused {
+        placeBid(_auctionId, _maxAmount, true);
+    }
+
+    function bidWithPermit(
+        uint256 _auctionId,
+        uint256 _amount,
+        uint256 _deadline,
+        uint8 _v,
+        bytes32 _r,
+        bytes32 _s
+    ) external whenNotPaused {
+        IERC20Permit(auctions[_auctionId].currencyAddress).permit(
+            _msgSender(),
+            address(this),
+            _amount,
+            _deadline,
+            _v,
+            _r,
+            _s
+        );
+
+        placeBid(_auctionId, _amount, false);
+    }
+
+    function getMaxBid(uint256 _auctionId) external view returns (uint256) {
+        require(auctions[_auctionId].currentBidder == _msgSender(), "Is not the current bidder");
+
+        return maxBids[_auctionId];
+    }
+
+    function placeBid(uint256 _auctionId, uint256 _maxAmount, bool _isMaxBid) private {
+        uint256 _raisingBid = getRaisingBid(_auctionId);
         require(
-            _amount >= getRaisingBid(_auctionId),
+            _maxAmount >= _raisingBid,
             "Bid amount must exceed the highest bid by the minimum increment percentage or more."
         );
 
+        address _bidder = _msgSender();
         AuctionInfo memory _auction = auctions[_auctionId];
+        uint256 _leaderMaxBid = maxBids[_auctionId];
+
+        _auction.duration = _auction.duration.add(_auction.durationIncrement);
+
+        if (!hasBid[_auctionId][_bidder]) {
+            hasBid[_auctionId][_bidder] = true;
+            bidderAuctions[_bidder].push(_auctionId);
+        }
+
+        if (_auction.highestBid != 0 && _auction.currentBidder != _bidder && _maxAmount <= _leaderMaxBid) {
+            // The max bid of the current bidder counters the new bid.
+            uint256 _counterBid = raiseOver(_maxAmount, _auction.bidIncrement);
+            if (_counterBid > _leaderMaxBid) {
+                _counterBid = _leaderMaxBid;
+            }
+
+            _auction.highestBid = _counterBid;
+            auctions[_auctionId] = _auction;
+
+            emit BidCountered(_auctionId, _bidder, _maxAmount);
+            emit AuctionBid(_auctionId, _auction.currentBidder, _counterBid);
+            return;
+        }
+
+        uint256 _amount = _maxAmount;
+        if (_isMaxBid && _auction.highestBid == 0) {
+            _amount = _raisingBid;
+        } else if (_isMaxBid && _auction.currentBidder == _bidder) {
+            // Raising an own max bid keeps the visible price.
+            _amount = _auction.highestBid;
+        } else if (_isMaxBid) {
+            // Only what is needed to beat the previous max bid becomes visible.
+            _amount = raiseOver(_leaderMaxBid, _auction.bidIncrement);
+            if (_amount > _maxAmount) {
+                _amount = _maxAmount;
+            }
+            if (_amount < _raisingBid) {
+                _amount = _raisingBid;
+            }
+        }
+
         IERC20 _token = IERC20(_auction.currencyAddress);
 
-        bool _ok = _token.transferFrom(msg.sender, address(this), _amount);
+        bool _ok = _token.transferFrom(_bidder, address(this), _maxAmount);
         require(_ok, "Failed to transfer tokens to bid");
 
         if (_auction.highestBid != 0) {
             _ok = _token.transfer(
                 _auction.currentBidder,
-                _auction.highestBid
+                _leaderMaxBid
             );
             require(_ok, "Failed to pay back");
         }
 
         _auction.highestBid = _amount;
-        _auction.currentBidder = msg.sender;
-        _auction.duration = _auction.duration.add(_auction.durationIncrement);
+        _auction.currentBidder = _bidder;
 
         auctions[_auctionId] = _auction;
+        maxBids[_auctionId] = _maxAmount;
 
-        emit AuctionBid(_auctionId, msg.sender, _amount);
+        emit AuctionBid(_auctionId, _bidder, _amount);
     }
 
     function getRaisingBid(uint256 _auctionId) public view shouldBeActive(_auctionId) returns (uint256) {
@@ -161,55 +349,84 @@ contract Auction {
             return _auction.startPrice;
         }
 
-        uint256 _highestBid = _auction.highestBid;
-        return _highestBid.mul(_auction.bidIncrement).div(getDecimal()).add(_highestBid);
+        return raiseOver(_auction.highestBid, _auction.bidIncrement);
     }
 
     function claimRepayment(uint256 _auctionId) external shouldBeFinished(_auctionId) {
         AuctionInfo memory _auction = auctions[_auctionId];
-        require(_auction.creator == msg.sender, "The Sender is not a auction creator");
         require(!_auction.repaymentTransferred, "The repayment has already been transferred");
 
-        bool _ok = IERC20(_auction.currencyAddress).transfer(_auction.creator, _auction.highestBid);
-        require(_ok, "Failed to transfer the repayment");
-
         auctions[_auctionId].repaymentTransferred = true;
 
+        transferRepayment(_auctionId, _auction);
+
         emit RepaymentTransferred(_auctionId, _auction.creator);
     }
 
     function claimLot(uint256 _auctionId) external shouldBeFinished(_auctionId) {
         AuctionInfo memory _auction = auctions[_auctionId];
-        require(_auction.currentBidder == msg.sender, "The sender is not a winner");
+        require(_auction.highestBid != 0, "The auction has no winner");
         require(!_auction.lotTransferred, "The lot has already been transferred");
 
         IERC721(_auction.tokenAddress).transferFrom(address(this), _auction.currentBidder, _auction.tokenId);
 
         auctions[_auctionId].lotTransferred = true;
 
-        emit LotTransferred(_auctionId, msg.sender);
+        emit LotTransferred(_auctionId, _auction.currentBidder);
     }
 
-    function buyNow(uint256 _auctionId) external shouldBeActive(_auctionId) {
+    function settle(uint256 _auctionId) external shouldBeFinished(_auctionId) {
+        AuctionInfo memory _auction = auctions[_auctionId];
+        bool _hasWinner = _auction.highestBid != 0;
+
+        auctions[_auctionId].lotTransferred = true;
+        auctions[_auctionId].repaymentTransferred = true;
+
+        if (!_auction.lotTransferred) {
+            address _recipient = _hasWinner ? _auction.currentBidder : _auction.creator;
+            IERC721(_auction.tokenAddress).transferFrom(address(this), _recipient, _auction.tokenId);
+
+            emit LotTransferred(_auctionId, _recipient);
+        }
+
+        if (!_auction.repaymentTransferred && _hasWinner) {
+            transferRepayment(_auctionId, _auction);
+
+            emit RepaymentTransferred(_auctionId, _auction.creator);
+        }
+
+        emit AuctionClosed(_auctionId);
+    }
+
+    function buyNow(uint256 _auctionId) external whenNotPaused shouldBeActive(_auctionId) {
         AuctionInfo memory _auction = auctions[_auctionId];
 
         require(_auction.buyNowPrice > _auction.highestBid, "Buying immediately is no longer relevant");
 
-        bool _ok = IERC20(_auction.currencyAddress).transferFrom(msg.sender, address(this), _auction.buyNowPrice);
+        IERC20 _token = IERC20(_auction.currencyAddress);
+
+        bool _ok = _token.transferFrom(_msgSender(), address(this), _auction.buyNowPrice);
         require(_ok, "Failed to transfer the repayment");
 
-        IERC721(_auction.tokenAddress).transferFrom(address(this), msg.sender, _auction.tokenId);
+        if (_auction.highestBid != 0) {
+            _ok = _token.transfer(_auction.currentBidder, maxBids[_auctionId]);
+            require(_ok, "Failed to pay back");
+        }
+
+        IERC721(_auction.tokenAddress).transferFrom(address(this), _msgSender(), _auction.tokenId);
 
+        _auction.highestBid = _auction.buyNowPrice;
+        _auction.currentBidder = _msgSender();
         _auction.lotBought = true;
         _auction.lotTransferred = true;
         auctions[_auctionId] = _auction;
+        maxBids[_auctionId] = _auction.buyNowPrice;
 
-        emit LotTransferred(_auctionId, msg.sender);
+        emit LotTransferred(_auctionId, _msgSender());
     }
 
     function regainLot(uint256 _auctionId) external shouldBeFinished(_auctionId) {
         AuctionInfo memory _auction = auctions[_auctionId];
-        require(msg.sender == _auction.creator, "The sender is not an auction creator");
         require(_auction.highestBid == 0, "The lot belongs to the winner of the auction");
 
         IERC721(_auction.tokenAddress).transferFrom(address(this), _auction.creator, _auction.tokenId);
@@ -221,6 +438,62 @@ contract Auction {
         emit LotTransferred(_auctionId, _auction.creator);
     }
 
+    function transferRepayment(uint256 _auctionId, AuctionInfo memory _auction) private {
+        IERC20 _token = IERC20(_auction.currencyAddress);
+
+        bool _ok = _token.transfer(_auction.creator, _auction.highestBid);
+        require(_ok, "Failed to transfer the repayment");
+
+        uint256 _excess = maxBids[_auctionId].sub(_auction.highestBid);
+        if (_excess != 0) {
+            _ok = _token.transfer(_auction.currentBidder, _excess);
+            require(_ok, "Failed to pay back");
+        }
+    }
+
+    // Reads the admin of the proxy delegating to this contract, the zero
+    // address if it is not called through a proxy.
+    function proxyAdmin() private view returns (address _proxyAdmin) {
+        bytes32 _slot = PROXY_ADMIN_SLOT;
+        assembly {
+            _proxyAdmin := sload(_slot)
+        }
+    }
+
+    function setAdmin(address _newAdmin) private {
+        require(_newAdmin != address(0), "Invalid admin");
+
+        emit AdminTransferred(admin, _newAdmin);
+
+        admin = _newAdmin;
+    }
+
+    function raiseOver(uint256 _amount, uint256 _bidIncrement) private pure returns (uint256) {
+        return _amount.mul(_bidIncrement).div(getDecimal()).add(_amount);
+    }
+
+    function getPage(uint256[] storage _ids, uint256 _offset, uint256 _limit) private view returns (uint256[] memory) {
+        uint256 _end = pageEnd(_offset, _limit, _ids.length);
+        uint256[] memory _page = new uint256[](_end.sub(_offset));
+
+        for (uint256 i = _offset; i < _end; i++) {
+            _page[i.sub(_offset)] = _ids[i];
+        }
+
+        return _page;
+    }
+
+    function pageEnd(uint256 _offset, uint256 _limit, uint256 _total) private pure returns (uint256) {
+        if (_offset >= _total) {
+            return _offset;
+        }
+        if (_limit > _total.sub(_offset)) {
+            return _total;
+        }
+
+        return _offset.add(_limit);
+    }
+
     modifier shouldBeActive(uint256 _auctionId)  {
         require(
             getStatus(_auctionId) == AuctionStatus.ACTIVE,
@@ -237,6 +510,16 @@ contract Auction {
         _;
     }
 
+    modifier whenNotPaused() {
+        require(!auctionsPaused, "Auction is paused");
+        _;
+    }
+
+    modifier onlyAdmin() {
+        require(_msgSender() == admin, "Is not the admin");
+        _;
+    }
+
     modifier shouldExist(uint256 _auctionId) {
         require(
             getStatus(_auctionId) != AuctionStatus.NONE,
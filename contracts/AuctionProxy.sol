@@ -0,0 +1,7 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+// Compiles the OpenZeppelin proxy contracts an upgradeable Auction is
+// deployed with: a TransparentUpgradeableProxy administered by a ProxyAdmin.
+import "@openzeppelin/contracts/proxy/transparent/ProxyAdmin.sol";
+import "@openzeppelin/contracts/proxy/transparent/TransparentUpgradeableProxy.sol";
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+// Standard base64 encoding with padding, for data URIs.
+// OpenZeppelin 4.0 has no Base64 library.
+library Base64 {
+    bytes private constant alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/";
+
+    function encode(bytes memory _data) internal pure returns (string memory) {
+        if (_data.length == 0) {
+            return "";
+        }
+
+        bytes memory _result = new bytes(4 * ((_data.length + 2) / 3));
+        uint256 j = 0;
+        for (uint256 i = 0; i < _data.length; i += 3) {
+            uint256 _chunk = uint256(uint8(_data[i])) << 16;
+            if (i + 1 < _data.length) {
+                _chunk |= uint256(uint8(_data[i + 1])) << 8;
+            }
+            if (i + 2 < _data.length) {
+                _chunk |= uint256(uint8(_data[i + 2]));
+            }
+
+            _result[j++] = alphabet[(_chunk >> 18) & 0x3f];
+            _result[j++] = alphabet[(_chunk >> 12) & 0x3f];
+            _result[j++] = i + 1 < _data.length ? alphabet[(_chunk >> 6) & 0x3f] : bytes1("=");
+            _result[j++] = i + 2 < _data.length ? alphabet[_chunk & 0x3f] : bytes1("=");
+        }
+        return string(_result);
+    }
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "@openzeppelin/contracts/utils/Context.sol";
+
+abstract contract ERC2771Recipient is Context {
+    event TrustedForwarderChanged(address _forwarder);
+
+    // Kept in storage rather than immutable: immutables can not be read while
+    // the inheriting contract is constructed, which Ownable does.
+    address private trustedForwarder;
+
+    constructor (address _trustedForwarder) {
+        _setTrustedForwarder(_trustedForwarder);
+    }
+
+    function isTrustedForwarder(address _forwarder) public view returns (bool) {
+        return _forwarder != address(0) && _forwarder == trustedForwarder;
+    }
+
+    function getTrustedForwarder() external view returns (address) {
+        return trustedForwarder;
+    }
+
+    function _setTrustedForwarder(address _forwarder) internal {
+        trustedForwarder = _forwarder;
+        emit TrustedForwarderChanged(_forwarder);
+    }
+
+    function _msgSender() internal view virtual override returns (address _sender) {
+        if (isTrustedForwarder(msg.sender) && msg.data.length >= 20) {
+            // The forwarder appends the address of the signer to the calldata.
+            assembly {
+                _sender := shr(96, calldataload(sub(calldatasize(), 20)))
+            }
+            return _sender;
+        }
+
+        return super._msgSender();
+    }
+
+    function _msgData() internal view virtual override returns (bytes calldata) {
+        if (isTrustedForwarder(msg.sender) && msg.data.length >= 20) {
+            return msg.data[:msg.data.length - 20];
+        }
+
+        return super._msgData();
+    }
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "@openzeppelin/contracts/utils/cryptography/ECDSA.sol";
+import "@openzeppelin/contracts/utils/cryptography/draft-EIP712.sol";
+
+contract Forwarder is EIP712 {
+    struct ForwardRequest {
+        address from;
+        address to;
+        uint256 value;
+        uint256 gas;
+        uint256 nonce;
+        uint256 deadline;
+        bytes data;
+    }
+
+    bytes32 public constant FORWARD_REQUEST_TYPEHASH = keccak256(
+        "ForwardRequest(address from,address to,uint256 value,uint256 gas,uint256 nonce,uint256 deadline,bytes data)"
+    );
+
+    event Executed(address _from, uint256 _nonce, bool _success);
+
+    mapping(address => uint256) private nonces;
+
+    constructor() EIP712("Forwarder", "1") {}
+
+    function getNonce(address _from) external view returns (uint256) {
+        return nonces[_from];
+    }
+
+    function verify(ForwardRequest calldata _request, bytes calldata _signature) public view returns (bool) {
+        return nonces[_request.from] == _request.nonce
+            && _request.deadline >= block.timestamp
+            && ECDSA.recover(hashRequest(_request), _signature) == _request.from;
+    }
+
+    function execute(ForwardRequest calldata _request, bytes calldata _signature) external payable returns (bool, bytes memory) {
+        require(verify(_request, _signature), "Signature does not match request");
+        require(msg.value == _request.value, "Value does not match request");
+
+        nonces[_request.from] = _request.nonce + 1;
+
+        (bool _success, bytes memory _result) = _request.to.call{gas: _request.gas, value: _request.value}(
+            abi.encodePacked(_request.data, _request.from)
+        );
+        // Fails the whole execution when the relayer sent too little gas for
+        // the call to get the requested amount.
+        require(gasleft() > _request.gas / 63, "Not enough gas for the request");
+
+        emit Executed(_request.from, _request.nonce, _success);
+
+        return (_success, _result);
+    }
+
+    function hashRequest(ForwardRequest calldata _request) public view returns (bytes32) {
+        return _hashTypedDataV4(keccak256(abi.encode(
+            FORWARD_REQUEST_TYPEHASH,
+            _request.from,
+            _request.to,
+            _request.value,
+            _request.gas,
+            _request.nonce,
+            _request.deadline,
+            keccak256(_request.data)
+        )));
+    }
+
+    function domainSeparator() external view returns (bytes32) {
+        return _domainSeparatorV4();
+    }
+}
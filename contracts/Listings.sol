@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "@openzeppelin/contracts/token/ERC721/IERC721.sol";
+import "@openzeppelin/contracts/token/ERC20/IERC20.sol";
+import "@openzeppelin/contracts/utils/cryptography/ECDSA.sol";
+import "@openzeppelin/contracts/utils/cryptography/draft-EIP712.sol";
+
+contract Listings is EIP712 {
+    struct Listing {
+        address seller;
+        address tokenAddress;
+        uint256 tokenId;
+        address currencyAddress;
+        uint256 price;
+        uint256 expiry;
+        uint256 nonce;
+    }
+
+    bytes32 public constant LISTING_TYPEHASH = keccak256(
+        "Listing(address seller,address tokenAddress,uint256 tokenId,address currencyAddress,uint256 price,uint256 expiry,uint256 nonce)"
+    );
+
+    event ListingFilled(address _seller, uint256 _nonce, address _buyer, address _tokenAddress, uint256 _tokenId, uint256 _price);
+    event ListingCancelled(address _seller, uint256 _nonce);
+    event MinNonceUpdated(address _seller, uint256 _minNonce);
+
+    mapping(address => mapping(uint256 => bool)) private usedNonces;
+    mapping(address => uint256) public minNonces;
+
+    constructor() EIP712("Listings", "1") {}
+
+    function buy(Listing calldata _listing, bytes calldata _signature) external {
+        require(_listing.expiry >= block.timestamp, "Listing has expired");
+        require(isValidNonce(_listing.seller, _listing.nonce), "Listing is filled or cancelled");
+        require(ECDSA.recover(hashListing(_listing), _signature) == _listing.seller, "Invalid listing signature");
+
+        usedNonces[_listing.seller][_listing.nonce] = true;
+
+        bool _ok = IERC20(_listing.currencyAddress).transferFrom(msg.sender, _listing.seller, _listing.price);
+        require(_ok, "Failed to transfer the payment");
+
+        IERC721(_listing.tokenAddress).transferFrom(_listing.seller, msg.sender, _listing.tokenId);
+
+        emit ListingFilled(_listing.seller, _listing.nonce, msg.sender, _listing.tokenAddress, _listing.tokenId, _listing.price);
+    }
+
+    function cancel(uint256 _nonce) external {
+        require(isValidNonce(msg.sender, _nonce), "Listing is filled or cancelled");
+
+        usedNonces[msg.sender][_nonce] = true;
+
+        emit ListingCancelled(msg.sender, _nonce);
+    }
+
+    function cancelBelow(uint256 _minNonce) external {
+        require(_minNonce > minNonces[msg.sender], "Nonce should increase");
+
+        minNonces[msg.sender] = _minNonce;
+
+        emit MinNonceUpdated(msg.sender, _minNonce);
+    }
+
+    function isValidNonce(address _seller, uint256 _nonce) public view returns (bool) {
+        return _nonce >= minNonces[_seller] && !usedNonces[_seller][_nonce];
+    }
+
+    function hashListing(Listing calldata _listing) public view returns (bytes32) {
+        return _hashTypedDataV4(keccak256(abi.encode(
+            LISTING_TYPEHASH,
+            _listing.seller,
+            _listing.tokenAddress,
+            _listing.tokenId,
+            _listing.currencyAddress,
+            _listing.price,
+            _listing.expiry,
+            _listing.nonce
+        )));
+    }
+
+    function domainSeparator() external view returns (bytes32) {
+        return _domainSeparatorV4();
+    }
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+pragma experimental ABIEncoderV2;
+
+import "@openzeppelin/contracts/token/ERC721/IERC721.sol";
+import "@openzeppelin/contracts/token/ERC20/IERC20.sol";
+import "@openzeppelin/contracts/utils/Address.sol";
+
+contract Offers {
+    using Address for address;
+
+    enum OfferStatus {NONE, OPEN, EXPIRED, ACCEPTED, CANCELLED}
+
+    struct OfferInfo {
+        address bidder;
+        address tokenAddress;
+        uint256 tokenId;
+        address currencyAddress;
+        uint256 amount;
+        uint256 expiry;
+
+        bool accepted;
+        bool cancelled;
+    }
+
+    event OfferCreated(
+        uint256 _offerId,
+        address _bidder,
+        address _tokenAddress,
+        uint256 _tokenId,
+        address _currencyAddress,
+        uint256 _amount,
+        uint256 _expiry
+    );
+    event OfferAccepted(uint256 _offerId, address _seller);
+    event OfferCancelled(uint256 _offerId);
+
+    uint256 public countOfOffers;
+    mapping(uint256 => OfferInfo) private offers;
+
+    constructor() {}
+
+    function makeOffer(
+        address _tokenAddress,
+        uint256 _tokenId,
+        address _currencyAddress,
+        uint256 _amount,
+        uint256 _expiry
+    ) external returns (uint256) {
+        require(_tokenAddress.isContract(), "Given token is not a contract");
+        require(_currencyAddress.isContract(), "Given currency is not a contract");
+        require(_amount != 0, "Invalid offer amount");
+        require(_expiry > block.timestamp, "Invalid offer expiry");
+        require(IERC721(_tokenAddress).ownerOf(_tokenId) != msg.sender, "Is owner of asset");
+
+        bool _ok = IERC20(_currencyAddress).transferFrom(msg.sender, address(this), _amount);
+        require(_ok, "Failed to transfer tokens to offer");
+
+        OfferInfo memory _offer;
+        _offer.bidder = msg.sender;
+        _offer.tokenAddress = _tokenAddress;
+        _offer.tokenId = _tokenId;
+        _offer.currencyAddress = _currencyAddress;
+        _offer.amount = _amount;
+        _offer.expiry = _expiry;
+
+        uint256 _offerId = countOfOffers;
+        offers[_offerId] = _offer;
+        countOfOffers++;
+
+        emit OfferCreated(_offerId, msg.sender, _tokenAddress, _tokenId, _currencyAddress, _amount, _expiry);
+
+        return _offerId;
+    }
+
+    function acceptOffer(uint256 _offerId) external shouldBeOpen(_offerId) {
+        OfferInfo memory _offer = offers[_offerId];
+        IERC721 _tokenContract = IERC721(_offer.tokenAddress);
+        require(_tokenContract.ownerOf(_offer.tokenId) == msg.sender, "Is not owner of asset");
+
+        offers[_offerId].accepted = true;
+
+        _tokenContract.transferFrom(msg.sender, _offer.bidder, _offer.tokenId);
+
+        bool _ok = IERC20(_offer.currencyAddress).transfer(msg.sender, _offer.amount);
+        require(_ok, "Failed to transfer the payment");
+
+        emit OfferAccepted(_offerId, msg.sender);
+    }
+
+    function cancelOffer(uint256 _offerId) external {
+        OfferStatus _status = getStatus(_offerId);
+        require(_status == OfferStatus.OPEN || _status == OfferStatus.EXPIRED, "Offer is not open");
+
+        OfferInfo memory _offer = offers[_offerId];
+        require(_offer.bidder == msg.sender, "The sender is not an offer bidder");
+
+        offers[_offerId].cancelled = true;
+
+        bool _ok = IERC20(_offer.currencyAddress).transfer(_offer.bidder, _offer.amount);
+        require(_ok, "Failed to refund the offer");
+
+        emit OfferCancelled(_offerId);
+    }
+
+    function getOfferInfo(uint256 _offerId) external shouldExist(_offerId) view returns (OfferInfo memory) {
+        return offers[_offerId];
+    }
+
+    function getStatus(uint256 _offerId) public view returns (OfferStatus) {
+        OfferInfo memory _offer = offers[_offerId];
+
+        if (_offer.bidder == address(0)) {
+            return OfferStatus.NONE;
+        }
+        if (_offer.accepted) {
+            return OfferStatus.ACCEPTED;
+        }
+        if (_offer.cancelled) {
+            return OfferStatus.CANCELLED;
+        }
+        if (block.timestamp > _offer.expiry) {
+            return OfferStatus.EXPIRED;
+        }
+
+        return OfferStatus.OPEN;
+    }
+
+    modifier shouldBeOpen(uint256 _offerId) {
+        require(
+            getStatus(_offerId) == OfferStatus.OPEN,
+            "Offer is not open"
+        );
+        _;
+    }
+
+    modifier shouldExist(uint256 _offerId) {
+        require(
+            getStatus(_offerId) != OfferStatus.NONE,
+            "Offer does not exist"
+        );
+        _;
+    }
+}
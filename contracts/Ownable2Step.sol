@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "@openzeppelin/contracts/utils/Context.sol";
+
+// Ownable with a two-step transfer: the new owner has to accept the
+// ownership, so that a mistyped address can not lose the contract.
+// OpenZeppelin 4.0 has no such variant and keeps the owner of Ownable private,
+// hence the separate contract. Events and errors match Ownable.
+abstract contract Ownable2Step is Context {
+    event OwnershipTransferStarted(address indexed previousOwner, address indexed newOwner);
+    event OwnershipTransferred(address indexed previousOwner, address indexed newOwner);
+
+    address private contractOwner;
+    address private pendingContractOwner;
+
+    constructor () {
+        setOwner(_msgSender());
+    }
+
+    function owner() public view virtual returns (address) {
+        return contractOwner;
+    }
+
+    function pendingOwner() public view virtual returns (address) {
+        return pendingContractOwner;
+    }
+
+    // Starts the transfer to _newOwner, who has to call acceptOwnership.
+    // Transferring to the zero address cancels a pending transfer.
+    function transferOwnership(address _newOwner) public virtual onlyOwner {
+        pendingContractOwner = _newOwner;
+
+        emit OwnershipTransferStarted(contractOwner, _newOwner);
+    }
+
+    function acceptOwnership() external {
+        require(pendingContractOwner == _msgSender(), "Ownable2Step: caller is not the new owner");
+
+        setOwner(_msgSender());
+    }
+
+    function renounceOwnership() public virtual onlyOwner {
+        setOwner(address(0));
+    }
+
+    function setOwner(address _newOwner) private {
+        address _previousOwner = contractOwner;
+        contractOwner = _newOwner;
+        delete pendingContractOwner;
+
+        emit OwnershipTransferred(_previousOwner, _newOwner);
+    }
+
+    modifier onlyOwner() {
+        require(owner() == _msgSender(), "Ownable: caller is not the owner");
+        _;
+    }
+}
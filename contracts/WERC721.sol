@@ -2,58 +2,340 @@
 pragma solidity ^0.8.0;
 
 import "@openzeppelin/contracts/token/ERC721/ERC721.sol";
-import "@openzeppelin/contracts/access/Ownable.sol";
+import "@openzeppelin/contracts/token/ERC721/extensions/ERC721Enumerable.sol";
+import "@openzeppelin/contracts/token/ERC721/extensions/IERC721Metadata.sol";
+import "@openzeppelin/contracts/token/ERC721/IERC721Receiver.sol";
+import "./Ownable2Step.sol";
+import "@openzeppelin/contracts/access/AccessControlEnumerable.sol";
+import "@openzeppelin/contracts/security/Pausable.sol";
 import "@openzeppelin/contracts/utils/math/SafeMath.sol";
+import "@openzeppelin/contracts/utils/Strings.sol";
+import "@openzeppelin/contracts/utils/Context.sol";
+import "./ERC2771Recipient.sol";
+import "./Base64.sol";
 
-contract WERC721 is Ownable, ERC721 {
+contract WERC721 is Ownable2Step, ERC721Enumerable, AccessControlEnumerable, Pausable, IERC721Receiver, ERC2771Recipient {
     using SafeMath for uint256;
+    using Strings for uint256;
+
+    bytes32 public constant ADMIN_ROLE = keccak256("ADMIN_ROLE");
+    bytes32 public constant MINTER_ROLE = keccak256("MINTER_ROLE");
+    bytes32 public constant GUARDIAN_ROLE = keccak256("GUARDIAN_ROLE");
+
+    struct WrappedToken {
+        address tokenAddress;
+        uint256 tokenId;
+    }
+
+    event MetadataUpdate(uint256 _tokenId);
+    event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId);
+    event Wrapped(address _owner, address _tokenAddress, uint256 _tokenId, uint256 _wrappedTokenId);
+    event Unwrapped(address _owner, address _tokenAddress, uint256 _tokenId, uint256 _wrappedTokenId);
+    event PauseExemptionChanged(address _account, bool _isExempt);
+    event AdminRoleOffered(address _account, address _offeredBy, bool _handover);
+    event AdminRoleOfferCancelled(address _account);
+
+    struct AdminRoleOffer {
+        address offeredBy;
+        bool handover;
+    }
+
+    bytes4 private constant ERC4906_INTERFACE_ID = 0x49064906;
 
-    mapping(address => bool) private eligibleUsers;
     mapping(uint256 => string) public tokensData;
+    mapping(uint256 => string) private tokenURIs;
+    mapping(uint256 => WrappedToken) private wrappedTokens;
+    mapping(address => mapping(uint256 => uint256)) private wrappedTokenIds;
+
+    string private baseURI;
+    uint256 private lastTokenId;
+    mapping(address => bool) private pauseExempt;
+    mapping(address => AdminRoleOffer) private adminRoleOffers;
+
+    constructor (address[] memory _minters, string memory _name, string memory _symbol, address _trustedForwarder)
+        ERC721(_name, _symbol)
+        ERC2771Recipient(_trustedForwarder)
+    {
+        _setRoleAdmin(ADMIN_ROLE, ADMIN_ROLE);
+        _setRoleAdmin(MINTER_ROLE, ADMIN_ROLE);
+        _setRoleAdmin(GUARDIAN_ROLE, ADMIN_ROLE);
+
+        _setupRole(ADMIN_ROLE, msg.sender);
+        _setupRole(MINTER_ROLE, msg.sender);
+        for (uint256 i = 0; i < _minters.length; i++) {
+            _setupRole(MINTER_ROLE, _minters[i]);
+        }
+    }
+
+    function mint(address _to, string memory _data) public onlyMinter(_msgSender()) {
+        mintToken(_to, _data);
+    }
 
-    uint256 public totalSupply;
+    function mintBatch(address[] memory _to, string[] memory _data) public onlyMinter(_msgSender()) {
+        require(_to.length == _data.length, "Recipients and data length mismatch");
 
-    constructor (address[] memory _eligibleUsers, string memory _name, string memory _symbol) ERC721(_name, _symbol) {
-        eligibleUsers[msg.sender] = true;
-        for (uint256 i = 0; i < _eligibleUsers.length; i++) {
-            eligibleUsers[_eligibleUsers[i]] = true;
+        for (uint256 i = 0; i < _to.length; i++) {
+            mintToken(_to[i], _data[i]);
         }
     }
 
-    function mint(address _to, string memory _data) public onlyEligibleUser(msg.sender) {
-        uint256 _tokenId = totalSupply.add(1);
-        totalSupply = _tokenId;
+    function burn(uint256 _tokenId) public {
+        require(_isApprovedOrOwner(_msgSender(), _tokenId), "Is not owner nor approved");
+        require(wrappedTokens[_tokenId].tokenAddress == address(0), "Wrapped token should be unwrapped");
+
+        _burn(_tokenId);
+        delete tokensData[_tokenId];
+        delete tokenURIs[_tokenId];
+    }
+
+    function wrap(address _tokenAddress, uint256 _tokenId) external returns (uint256) {
+        IERC721(_tokenAddress).safeTransferFrom(_msgSender(), address(this), _tokenId);
+
+        return wrappedTokenIds[_tokenAddress][_tokenId];
+    }
+
+    function onERC721Received(
+        address,
+        address _from,
+        uint256 _tokenId,
+        bytes calldata
+    ) external override returns (bytes4) {
+        require(msg.sender != address(this), "Wrapped tokens can not be wrapped");
+        require(IERC721(msg.sender).ownerOf(_tokenId) == address(this), "Token has not been transferred");
+
+        uint256 _wrappedTokenId = mintToken(_from, "");
+        wrappedTokens[_wrappedTokenId] = WrappedToken(msg.sender, _tokenId);
+        wrappedTokenIds[msg.sender][_tokenId] = _wrappedTokenId;
+
+        emit Wrapped(_from, msg.sender, _tokenId, _wrappedTokenId);
+
+        return this.onERC721Received.selector;
+    }
+
+    function unwrap(uint256 _wrappedTokenId) external {
+        require(_isApprovedOrOwner(_msgSender(), _wrappedTokenId), "Is not owner nor approved");
+
+        WrappedToken memory _wrapped = wrappedTokens[_wrappedTokenId];
+        require(_wrapped.tokenAddress != address(0), "Token is not wrapped");
+
+        _burn(_wrappedTokenId);
+        delete tokensData[_wrappedTokenId];
+        delete tokenURIs[_wrappedTokenId];
+        delete wrappedTokens[_wrappedTokenId];
+        delete wrappedTokenIds[_wrapped.tokenAddress][_wrapped.tokenId];
+
+        IERC721(_wrapped.tokenAddress).safeTransferFrom(address(this), _msgSender(), _wrapped.tokenId);
+
+        emit Unwrapped(_msgSender(), _wrapped.tokenAddress, _wrapped.tokenId, _wrappedTokenId);
+    }
+
+    function getWrappedToken(uint256 _wrappedTokenId) external view returns (WrappedToken memory) {
+        return wrappedTokens[_wrappedTokenId];
+    }
+
+    function getWrappedTokenId(address _tokenAddress, uint256 _tokenId) external view returns (uint256) {
+        return wrappedTokenIds[_tokenAddress][_tokenId];
+    }
+
+    function totalMinted() public view returns (uint256) {
+        return lastTokenId;
+    }
+
+    function tokenURI(uint256 _tokenId) public view override returns (string memory) {
+        require(_exists(_tokenId), "Token does not exist");
+
+        if (bytes(tokenURIs[_tokenId]).length != 0) {
+            return tokenURIs[_tokenId];
+        }
+        if (bytes(baseURI).length != 0) {
+            return string(abi.encodePacked(baseURI, _tokenId.toString()));
+        }
+
+        WrappedToken memory _wrapped = wrappedTokens[_tokenId];
+        if (_wrapped.tokenAddress != address(0)) {
+            try IERC721Metadata(_wrapped.tokenAddress).tokenURI(_wrapped.tokenId) returns (string memory _uri) {
+                return _uri;
+            } catch {}
+        }
+
+        return string(abi.encodePacked("data:application/json;base64,", Base64.encode(bytes(tokensData[_tokenId]))));
+    }
+
+    function setBaseURI(string memory _newBaseURI) public onlyAdmin(_msgSender()) {
+        baseURI = _newBaseURI;
+        emit BatchMetadataUpdate(1, lastTokenId);
+    }
+
+    function setTokenURI(uint256 _tokenId, string memory _tokenURI) public onlyAdmin(_msgSender()) {
+        require(_exists(_tokenId), "Token does not exist");
+        tokenURIs[_tokenId] = _tokenURI;
+        emit MetadataUpdate(_tokenId);
+    }
+
+    function setTokenData(uint256 _tokenId, string memory _data) public onlyMinter(_msgSender()) {
+        require(_exists(_tokenId), "Token does not exist");
         tokensData[_tokenId] = _data;
-        _safeMint(_to, _tokenId);
+        emit MetadataUpdate(_tokenId);
+    }
+
+    function setTrustedForwarder(address _forwarder) external onlyAdmin(_msgSender()) {
+        _setTrustedForwarder(_forwarder);
+    }
+
+    function isGuardian(address _account) public view returns (bool) {
+        return hasRole(GUARDIAN_ROLE, _account) || hasRole(ADMIN_ROLE, _account);
+    }
+
+    // Exempt accounts, such as the auction, keep transferring out while
+    // transfers are paused, so that lots can still be claimed and regained.
+    function setPauseExempt(address _account, bool _isExempt) external onlyAdmin(_msgSender()) {
+        pauseExempt[_account] = _isExempt;
+
+        emit PauseExemptionChanged(_account, _isExempt);
+    }
+
+    function isPauseExempt(address _account) external view returns (bool) {
+        return pauseExempt[_account];
+    }
+
+    function pause() external {
+        require(isGuardian(_msgSender()), "Is not a guardian");
+
+        _pause();
+    }
+
+    function unpause() external onlyAdmin(_msgSender()) {
+        _unpause();
+    }
+
+    // ADMIN_ROLE holds the minting and the roles, so it changes hands in two
+    // steps like the ownership: granting it only offers it, and the account
+    // takes it with acceptAdminRole. A mistyped address can not end up
+    // holding it, and the last admin can not be removed.
+    function grantRole(bytes32 _role, address _account) public override {
+        if (_role != ADMIN_ROLE) {
+            super.grantRole(_role, _account);
+            return;
+        }
+
+        offerAdminRole(_account, false);
+    }
+
+    // Offers the admin role of the sender to _newAdmin, the sender loses it
+    // once _newAdmin accepts.
+    function transferAdminRole(address _newAdmin) external {
+        offerAdminRole(_newAdmin, true);
+    }
+
+    function cancelAdminRoleOffer(address _account) external onlyAdmin(_msgSender()) {
+        require(adminRoleOffers[_account].offeredBy != address(0), "Admin role is not offered");
+
+        delete adminRoleOffers[_account];
+
+        emit AdminRoleOfferCancelled(_account);
+    }
+
+    function acceptAdminRole() external {
+        AdminRoleOffer memory _offer = adminRoleOffers[_msgSender()];
+        require(_offer.offeredBy != address(0), "Admin role is not offered");
+        require(hasRole(ADMIN_ROLE, _offer.offeredBy), "Admin role offer is stale");
+
+        delete adminRoleOffers[_msgSender()];
+        _setupRole(ADMIN_ROLE, _msgSender());
+        if (_offer.handover) {
+            super.revokeRole(ADMIN_ROLE, _offer.offeredBy);
+        }
+    }
+
+    function getAdminRoleOffer(address _account) external view returns (address _offeredBy, bool _handover) {
+        AdminRoleOffer memory _offer = adminRoleOffers[_account];
+
+        return (_offer.offeredBy, _offer.handover);
+    }
+
+    function revokeRole(bytes32 _role, address _account) public override {
+        require(_role != ADMIN_ROLE || getRoleMemberCount(ADMIN_ROLE) > 1, "Can not remove the last admin");
+
+        super.revokeRole(_role, _account);
+    }
+
+    function renounceRole(bytes32 _role, address _account) public override {
+        require(_role != ADMIN_ROLE || getRoleMemberCount(ADMIN_ROLE) > 1, "Can not remove the last admin");
+
+        super.renounceRole(_role, _account);
     }
 
     function tokensOfOwner(address _ownerOfTokens) public view returns (uint256[] memory) {
+        return getTokensOfOwner(_ownerOfTokens, 0, balanceOf(_ownerOfTokens));
+    }
+
+    function getTokensOfOwner(address _ownerOfTokens, uint256 _offset, uint256 _limit) public view returns (uint256[] memory) {
         uint256 _tokenCount = balanceOf(_ownerOfTokens);
 
-        if (_tokenCount == 0) {
+        if (_offset >= _tokenCount) {
             return new uint256[](0);
         }
+        if (_limit > _tokenCount.sub(_offset)) {
+            _limit = _tokenCount.sub(_offset);
+        }
 
-        uint256[] memory _ownerTokens = new uint256[](_tokenCount);
-        uint256 _totalSupply = totalSupply;
-        uint256 _resultIndex = 0;
+        uint256[] memory _ownerTokens = new uint256[](_limit);
 
-        for (uint256 _tokenId = 1; _tokenId <= _totalSupply; _tokenId++) {
-            if (ownerOf(_tokenId) == _ownerOfTokens) {
-                _ownerTokens[_resultIndex] = _tokenId;
-                _resultIndex++;
-            }
+        for (uint256 i = 0; i < _limit; i++) {
+            _ownerTokens[i] = tokenOfOwnerByIndex(_ownerOfTokens, _offset.add(i));
         }
 
         return _ownerTokens;
     }
 
-    function switchUserPermissions(address _user) public onlyOwner {
-        eligibleUsers[_user] = !eligibleUsers[_user];
+    function supportsInterface(bytes4 _interfaceId)
+        public
+        view
+        override(ERC721Enumerable, AccessControlEnumerable)
+        returns (bool)
+    {
+        return _interfaceId == ERC4906_INTERFACE_ID || super.supportsInterface(_interfaceId);
+    }
+
+    function _beforeTokenTransfer(address _from, address _to, uint256 _tokenId) internal override {
+        super._beforeTokenTransfer(_from, _to, _tokenId);
+
+        require(!paused() || pauseExempt[_from], "Token transfers are paused");
+    }
+
+    function _msgSender() internal view override(Context, ERC2771Recipient) returns (address) {
+        return ERC2771Recipient._msgSender();
+    }
+
+    function _msgData() internal view override(Context, ERC2771Recipient) returns (bytes calldata) {
+        return ERC2771Recipient._msgData();
+    }
+
+    function offerAdminRole(address _account, bool _handover) private onlyAdmin(_msgSender()) {
+        require(_account != address(0), "Admin role can not be offered to the zero address");
+        require(!hasRole(ADMIN_ROLE, _account), "Account is already an admin");
+
+        adminRoleOffers[_account] = AdminRoleOffer(_msgSender(), _handover);
+
+        emit AdminRoleOffered(_account, _msgSender(), _handover);
+    }
+
+    function mintToken(address _to, string memory _data) private returns (uint256) {
+        uint256 _tokenId = lastTokenId.add(1);
+        lastTokenId = _tokenId;
+        tokensData[_tokenId] = _data;
+        _safeMint(_to, _tokenId);
+
+        return _tokenId;
+    }
+
+    modifier onlyAdmin(address _user) {
+        require(hasRole(ADMIN_ROLE, _user), "Is not an admin");
+        _;
     }
 
-    modifier onlyEligibleUser(address _user) {
-        require(eligibleUsers[_user], "Is not eligible user");
+    modifier onlyMinter(address _user) {
+        require(hasRole(MINTER_ROLE, _user), "Is not a minter");
         _;
     }
 }
@@ -2,13 +2,164 @@
 pragma solidity ^0.8.0;
 
 import "@openzeppelin/contracts/token/ERC20/ERC20.sol";
-import "@openzeppelin/contracts/access/Ownable.sol";
+import "@openzeppelin/contracts/token/ERC20/extensions/draft-ERC20Permit.sol";
+import "./Ownable2Step.sol";
+import "@openzeppelin/contracts/security/Pausable.sol";
+import "@openzeppelin/contracts/utils/Context.sol";
+import "@openzeppelin/contracts/utils/structs/EnumerableSet.sol";
+import "./ERC2771Recipient.sol";
 
-contract WETH is Ownable, ERC20 {
-    constructor (string memory _name, string memory _symbol) ERC20(_name, _symbol) {}
+contract WETH is Ownable2Step, ERC20Permit, Pausable, ERC2771Recipient {
+    using EnumerableSet for EnumerableSet.AddressSet;
 
-    function mint(address _recepient, uint256 _amount) external onlyOwner returns (bool) {
+    event GuardianChanged(address _guardian, bool _isGuardian);
+    event PauseExemptionChanged(address _account, bool _isExempt);
+    event Mint(address indexed _minter, address indexed _recepient, uint256 _amount);
+    event Burn(address indexed _burner, address indexed _account, uint256 _amount);
+    event MinterChanged(address _minter, uint256 _allowance);
+    event MinterRemoved(address _minter);
+    event SupplyCapChanged(uint256 _supplyCap);
+
+    mapping(address => bool) private guardians;
+    mapping(address => bool) private pauseExempt;
+
+    // Zero means the supply is not capped.
+    uint256 private supplyCap;
+    EnumerableSet.AddressSet private minters;
+    mapping(address => uint256) private minterAllowances;
+    mapping(address => uint256) private minted;
+
+    constructor (string memory _name, string memory _symbol, address _trustedForwarder)
+        ERC20(_name, _symbol)
+        ERC20Permit(_name)
+        ERC2771Recipient(_trustedForwarder)
+    {}
+
+    function mint(address _recepient, uint256 _amount) external returns (bool) {
+        address _minter = _msgSender();
+        require(minters.contains(_minter), "Is not a minter");
+        require(minted[_minter] + _amount <= minterAllowances[_minter], "Minter allowance exceeded");
+        require(supplyCap == 0 || totalSupply() + _amount <= supplyCap, "Supply cap exceeded");
+
+        minted[_minter] += _amount;
         _mint(_recepient, _amount);
+
+        emit Mint(_minter, _recepient, _amount);
         return true;
     }
+
+    function burn(uint256 _amount) external {
+        _burn(_msgSender(), _amount);
+
+        emit Burn(_msgSender(), _msgSender(), _amount);
+    }
+
+    function burnFrom(address _account, uint256 _amount) external {
+        uint256 _allowance = allowance(_account, _msgSender());
+        require(_allowance >= _amount, "Burn amount exceeds allowance");
+
+        _approve(_account, _msgSender(), _allowance - _amount);
+        _burn(_account, _amount);
+
+        emit Burn(_msgSender(), _account, _amount);
+    }
+
+    function setSupplyCap(uint256 _supplyCap) external onlyOwner {
+        require(_supplyCap == 0 || _supplyCap >= totalSupply(), "Supply cap is below the supply");
+
+        supplyCap = _supplyCap;
+
+        emit SupplyCapChanged(_supplyCap);
+    }
+
+    function getSupplyCap() external view returns (uint256) {
+        return supplyCap;
+    }
+
+    // The allowance bounds everything _minter mints over its lifetime, burns
+    // do not give it back. Raise it to let the minter mint more.
+    function setMinter(address _minter, uint256 _allowance) external onlyOwner {
+        minters.add(_minter);
+        minterAllowances[_minter] = _allowance;
+
+        emit MinterChanged(_minter, _allowance);
+    }
+
+    function removeMinter(address _minter) external onlyOwner {
+        require(minters.remove(_minter), "Is not a minter");
+
+        delete minterAllowances[_minter];
+
+        emit MinterRemoved(_minter);
+    }
+
+    function isMinter(address _account) external view returns (bool) {
+        return minters.contains(_account);
+    }
+
+    function getMinters() external view returns (address[] memory) {
+        address[] memory _minters = new address[](minters.length());
+        for (uint256 i = 0; i < _minters.length; i++) {
+            _minters[i] = minters.at(i);
+        }
+        return _minters;
+    }
+
+    function getMinterAllowance(address _minter) external view returns (uint256) {
+        return minterAllowances[_minter];
+    }
+
+    function getMinted(address _minter) external view returns (uint256) {
+        return minted[_minter];
+    }
+
+    function setGuardian(address _guardian, bool _isGuardian) external onlyOwner {
+        guardians[_guardian] = _isGuardian;
+
+        emit GuardianChanged(_guardian, _isGuardian);
+    }
+
+    function isGuardian(address _account) public view returns (bool) {
+        return guardians[_account] || _account == owner();
+    }
+
+    // Exempt accounts, such as the auction, keep paying out while transfers
+    // are paused, so that claims and refunds stay possible.
+    function setPauseExempt(address _account, bool _isExempt) external onlyOwner {
+        pauseExempt[_account] = _isExempt;
+
+        emit PauseExemptionChanged(_account, _isExempt);
+    }
+
+    function isPauseExempt(address _account) external view returns (bool) {
+        return pauseExempt[_account];
+    }
+
+    function pause() external {
+        require(isGuardian(_msgSender()), "Is not a guardian");
+
+        _pause();
+    }
+
+    function unpause() external onlyOwner {
+        _unpause();
+    }
+
+    function setTrustedForwarder(address _forwarder) external onlyOwner {
+        _setTrustedForwarder(_forwarder);
+    }
+
+    function _beforeTokenTransfer(address _from, address _to, uint256 _amount) internal override {
+        super._beforeTokenTransfer(_from, _to, _amount);
+
+        require(!paused() || pauseExempt[_from], "Token transfers are paused");
+    }
+
+    function _msgSender() internal view override(Context, ERC2771Recipient) returns (address) {
+        return ERC2771Recipient._msgSender();
+    }
+
+    function _msgData() internal view override(Context, ERC2771Recipient) returns (bytes calldata) {
+        return ERC2771Recipient._msgData();
+    }
 }
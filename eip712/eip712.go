@@ -0,0 +1,136 @@
+// Package eip712 hashes and signs EIP-712 typed structured data for the
+// system contracts. Only the static field types used by the contracts are
+// supported: address, uint256, bytes32, bool and string.
+package eip712
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// DomainType is the encoded type of the EIP712Domain struct used by
+// OpenZeppelin's EIP712.
+const DomainType = "EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"
+
+// Domain is the EIP-712 signing domain of a contract.
+type Domain struct {
+	Name              string
+	Version           string
+	ChainID           *big.Int
+	VerifyingContract common.Address
+}
+
+// Separator returns the domain separator, equal to the contract's DOMAIN_SEPARATOR().
+func (d Domain) Separator() common.Hash {
+	return HashStruct(TypeHash(DomainType), d.Name, d.Version, d.ChainID, d.VerifyingContract)
+}
+
+// Signature is an ECDSA signature split the way Solidity expects it.
+type Signature struct {
+	V uint8
+	R [32]byte
+	S [32]byte
+}
+
+// Bytes returns the 65 byte r || s || v encoding of the signature.
+func (s Signature) Bytes() []byte {
+	sig := make([]byte, 65)
+	copy(sig[:32], s.R[:])
+	copy(sig[32:64], s.S[:])
+	sig[64] = s.V
+	return sig
+}
+
+// TypeHash returns the keccak256 hash of an encoded struct type.
+func TypeHash(encodedType string) common.Hash {
+	return crypto.Keccak256Hash([]byte(encodedType))
+}
+
+// HashStruct returns hashStruct(s) for a struct with the given type hash and
+// field values in declaration order. Strings are hashed as EIP-712 requires.
+func HashStruct(typeHash common.Hash, fields ...interface{}) common.Hash {
+	data := make([]byte, 0, 32*(len(fields)+1))
+	data = append(data, typeHash.Bytes()...)
+	for _, field := range fields {
+		data = append(data, encodeField(field)...)
+	}
+	return crypto.Keccak256Hash(data)
+}
+
+// Digest returns the hash to sign for a struct within the given domain.
+func Digest(domainSeparator, structHash common.Hash) common.Hash {
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator.Bytes(), structHash.Bytes())
+}
+
+// Sign signs the digest with key.
+func Sign(key *ecdsa.PrivateKey, digest common.Hash) (Signature, error) {
+	sig, err := crypto.Sign(digest.Bytes(), key)
+	if err != nil {
+		return Signature{}, err
+	}
+	return split(sig), nil
+}
+
+// Recover returns the address that produced sig over digest.
+func Recover(digest common.Hash, sig Signature) (common.Address, error) {
+	if sig.V != 27 && sig.V != 28 {
+		return common.Address{}, fmt.Errorf("invalid signature recovery id %d", sig.V)
+	}
+	raw := sig.Bytes()
+	raw[64] -= 27
+
+	pub, err := crypto.SigToPub(digest.Bytes(), raw)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// ParseSignature splits a 65 byte r || s || v signature, accepting v as 0/1 or 27/28.
+func ParseSignature(sig []byte) (Signature, error) {
+	if len(sig) != 65 {
+		return Signature{}, errors.New("signature must be 65 bytes long")
+	}
+	return split(sig), nil
+}
+
+func split(sig []byte) Signature {
+	var s Signature
+	copy(s.R[:], sig[:32])
+	copy(s.S[:], sig[32:64])
+	s.V = sig[64]
+	if s.V < 27 {
+		s.V += 27
+	}
+	return s
+}
+
+func encodeField(field interface{}) []byte {
+	switch v := field.(type) {
+	case common.Address:
+		return common.LeftPadBytes(v.Bytes(), 32)
+	case common.Hash:
+		return v.Bytes()
+	case [32]byte:
+		return v[:]
+	case *big.Int:
+		return math.U256Bytes(new(big.Int).Set(v))
+	case uint64:
+		return math.U256Bytes(new(big.Int).SetUint64(v))
+	case bool:
+		if v {
+			return common.LeftPadBytes([]byte{1}, 32)
+		}
+		return make([]byte, 32)
+	case string:
+		return crypto.Keccak256([]byte(v))
+	default:
+		panic(fmt.Sprintf("eip712: unsupported field type %T", field))
+	}
+}
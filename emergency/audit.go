@@ -0,0 +1,65 @@
+package emergency
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Record is an entry of the audit log.
+type Record struct {
+	Time     time.Time      `json:"time"`
+	Manifest string         `json:"manifest"`
+	Operator common.Address `json:"operator"`
+	Action   Action         `json:"action"`
+	Contract string         `json:"contract"`
+	Address  common.Address `json:"address"`
+	Event    string         `json:"event"` // sent, confirmed or failed
+	Tx       *common.Hash   `json:"tx,omitempty"`
+	Reason   string         `json:"reason"` // Why the operator acted
+	Error    string         `json:"error,omitempty"`
+}
+
+// AuditLog appends records to a file, one JSON object per line. Every
+// record is synced before the next step, so that the log survives a crash
+// of the operator.
+type AuditLog struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// OpenAuditLog opens or creates the audit log at path.
+func OpenAuditLog(path string) (*AuditLog, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &AuditLog{file: file}, nil
+}
+
+// Append writes a record, setting its time if unset.
+func (l *AuditLog) Append(record Record) error {
+	if record.Time.IsZero() {
+		record.Time = time.Now().UTC()
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := l.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	return l.file.Sync()
+}
+
+// Close closes the log file.
+func (l *AuditLog) Close() error {
+	return l.file.Close()
+}
@@ -0,0 +1,171 @@
+// Package emergency pauses and unpauses the system contracts listed in a
+// deployment manifest, recording every step in an audit log.
+package emergency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/one-click-platform/system-contracts/manifest"
+	"github.com/one-click-platform/system-contracts/txmanager"
+)
+
+// Action is what the operator does to the targets.
+type Action string
+
+const (
+	ActionPause   Action = "pause"
+	ActionUnpause Action = "unpause"
+)
+
+// Pausable is implemented by the bindings of the Auction, WETH and WERC721
+// contracts. Guardians may pause, only the owner or admin may unpause.
+type Pausable interface {
+	Paused(opts *bind.CallOpts) (bool, error)
+	IsGuardian(opts *bind.CallOpts, account common.Address) (bool, error)
+	Pause(opts *bind.TransactOpts) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts) (*types.Transaction, error)
+}
+
+// Target is a contract of a manifest.
+type Target struct {
+	manifest.Entry
+	Contract Pausable
+}
+
+// State is the pause state of a target.
+type State struct {
+	Target   Target
+	Paused   bool
+	Guardian bool // Whether the inspecting account may pause it
+}
+
+// Inspect reads the state of every target for account.
+func Inspect(ctx context.Context, targets []Target, account common.Address) ([]State, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	states := make([]State, len(targets))
+	for i, t := range targets {
+		paused, err := t.Contract.Paused(opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read state of %s: %w", t.Name, err)
+		}
+		guardian, err := t.Contract.IsGuardian(opts, account)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read guardians of %s: %w", t.Name, err)
+		}
+		states[i] = State{Target: t, Paused: paused, Guardian: guardian}
+	}
+	return states, nil
+}
+
+// Pending returns the targets action changes, leaving out those already
+// paused or unpaused.
+func Pending(states []State, action Action) []Target {
+	var targets []Target
+	for _, s := range states {
+		if s.Paused != (action == ActionPause) {
+			targets = append(targets, s.Target)
+		}
+	}
+	return targets
+}
+
+// Operator applies actions through a transaction manager and audits them.
+type Operator struct {
+	manifest string
+	txs      *txmanager.Manager
+	audit    *AuditLog
+	reason   string
+}
+
+// NewOperator creates an operator for the contracts of m. The reason
+// is recorded with every audit record and must not be empty.
+func NewOperator(m *manifest.Manifest, txs *txmanager.Manager, audit *AuditLog, reason string) (*Operator, error) {
+	if reason == "" {
+		return nil, errors.New("a reason is required")
+	}
+	return &Operator{manifest: m.Name, txs: txs, audit: audit, reason: reason}, nil
+}
+
+// Apply sends action to every target before waiting for any, so that a
+// pause takes effect on all contracts as fast as possible. A failing target
+// does not stop the others; the first error is returned once every target
+// has been handled.
+func (o *Operator) Apply(ctx context.Context, action Action, targets []Target) error {
+	var (
+		firstErr error
+		sent     []Target
+	)
+	fail := func(t Target, tx *common.Hash, err error) {
+		log.Error("Emergency action failed", "action", action, "contract", t.Name, "err", err)
+		if auditErr := o.record(action, t, "failed", tx, err); auditErr != nil {
+			log.Error("Failed to write audit record", "err", auditErr)
+		}
+		if firstErr == nil {
+			firstErr = fmt.Errorf("%s of %s failed: %w", action, t.Name, err)
+		}
+	}
+
+	for _, t := range targets {
+		t := t
+		tx, err := o.txs.Send(ctx, key(action, t), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			if action == ActionPause {
+				return t.Contract.Pause(opts)
+			}
+			return t.Contract.Unpause(opts)
+		})
+		if err != nil {
+			fail(t, nil, err)
+			continue
+		}
+		log.Info("Sent emergency action", "action", action, "contract", t.Name, "address", t.Address, "tx", tx.Hashes[0])
+		if err := o.record(action, t, "sent", &tx.Hashes[0], nil); err != nil {
+			return err
+		}
+		sent = append(sent, t)
+	}
+
+	for _, t := range sent {
+		receipt, err := o.txs.Wait(ctx, key(action, t))
+		if err != nil {
+			fail(t, nil, err)
+			continue
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			fail(t, &receipt.TxHash, errors.New("transaction reverted"))
+			continue
+		}
+		log.Info("Emergency action confirmed", "action", action, "contract", t.Name, "tx", receipt.TxHash)
+		if err := o.record(action, t, "confirmed", &receipt.TxHash, nil); err != nil {
+			return err
+		}
+	}
+	return firstErr
+}
+
+func (o *Operator) record(action Action, t Target, event string, tx *common.Hash, err error) error {
+	record := Record{
+		Manifest: o.manifest,
+		Operator: o.txs.From(),
+		Action:   action,
+		Contract: t.Name,
+		Address:  t.Address,
+		Event:    event,
+		Tx:       tx,
+		Reason:   o.reason,
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	return o.audit.Append(record)
+}
+
+func key(action Action, t Target) string {
+	return fmt.Sprintf("%s:%s", action, t.Name)
+}
@@ -0,0 +1,37 @@
+package emergency
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/one-click-platform/system-contracts/generated"
+	"github.com/one-click-platform/system-contracts/manifest"
+)
+
+// Targets binds the contracts of m with the given names, or every contract
+// of the manifest if names is empty.
+func Targets(backend bind.ContractBackend, m *manifest.Manifest, names []string) ([]Target, error) {
+	entries, err := m.Select(names)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]Target, 0, len(entries))
+	for _, entry := range entries {
+		contract, err := bindPausable(entry, backend)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, Target{Entry: entry, Contract: contract})
+	}
+	return targets, nil
+}
+
+func bindPausable(entry manifest.Entry, backend bind.ContractBackend) (Pausable, error) {
+	switch entry.Type {
+	case manifest.TypeAuction:
+		return generated.NewAuction(entry.Address, backend)
+	case manifest.TypeWETH:
+		return generated.NewWETH(entry.Address, backend)
+	default:
+		return generated.NewWERC721(entry.Address, backend)
+	}
+}
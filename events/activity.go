@@ -0,0 +1,219 @@
+package events
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/one-click-platform/system-contracts/generated"
+)
+
+// wethDecimals are the decimals of WETH amounts.
+const wethDecimals = 18
+
+// roleNames are the roles granted on the system contracts.
+var roleNames = map[common.Hash]string{
+	common.Hash{}: "DEFAULT_ADMIN_ROLE",
+	crypto.Keccak256Hash([]byte("ADMIN_ROLE")):    "ADMIN_ROLE",
+	crypto.Keccak256Hash([]byte("MINTER_ROLE")):   "MINTER_ROLE",
+	crypto.Keccak256Hash([]byte("GUARDIAN_ROLE")): "GUARDIAN_ROLE",
+}
+
+// Activity is a human-readable entry of what a transaction did.
+type Activity struct {
+	Block    uint64      `json:"block"`
+	TxHash   common.Hash `json:"txHash"`
+	Index    uint        `json:"logIndex"`
+	Contract string      `json:"contract"`
+	Event    string      `json:"event"`
+	Summary  string      `json:"summary"`
+}
+
+// Activity decodes a receipt into activity entries, one per known event.
+func (d *Decoder) Activity(receipt *types.Receipt) ([]Activity, error) {
+	decoded, err := d.DecodeReceipt(receipt)
+	if err != nil {
+		return nil, err
+	}
+	activity := make([]Activity, len(decoded))
+	for i, ev := range decoded {
+		activity[i] = Activity{
+			Block:    ev.Raw.BlockNumber,
+			TxHash:   ev.Raw.TxHash,
+			Index:    ev.Raw.Index,
+			Contract: ev.Contract,
+			Event:    ev.Name,
+			Summary:  Describe(ev),
+		}
+	}
+	return activity, nil
+}
+
+// Describe summarizes an event in a sentence.
+func Describe(ev *Event) string {
+	switch v := ev.Value.(type) {
+	case *generated.AuctionAuctionCreated:
+		return fmt.Sprintf("%s created auction %s for token %s of %s", v.Creator.Hex(), v.AuctionId, v.TokenId, v.TokenAddress.Hex())
+	case *generated.AuctionAuctionClosed:
+		return fmt.Sprintf("Auction %s closed", v.AuctionId)
+	case *generated.AuctionAuctionBid:
+		return fmt.Sprintf("%s leads auction %s with %s", v.Bidder.Hex(), v.AuctionId, v.Amount)
+	case *generated.AuctionBidCountered:
+		return fmt.Sprintf("Bid of %s by %s on auction %s was countered by a max bid", v.Amount, v.Bidder.Hex(), v.AuctionId)
+	case *generated.AuctionRepaymentTransferred:
+		return fmt.Sprintf("Repayment of auction %s transferred to %s", v.AuctionId, v.Creator.Hex())
+	case *generated.AuctionLotTransferred:
+		return fmt.Sprintf("Lot of auction %s transferred to %s", v.AuctionId, v.Winner.Hex())
+	case *generated.AuctionTrustedForwarderChanged:
+		return fmt.Sprintf("Auction trusts forwarder %s", v.Forwarder.Hex())
+	case *generated.AuctionPaused:
+		return fmt.Sprintf("Auction paused by %s", v.Account.Hex())
+	case *generated.AuctionUnpaused:
+		return fmt.Sprintf("Auction unpaused by %s", v.Account.Hex())
+	case *generated.AuctionGuardianChanged:
+		return fmt.Sprintf("%s %s an auction guardian", v.Guardian.Hex(), guardianChange(v.IsGuardian))
+	case *generated.AuctionAdminTransferred:
+		return fmt.Sprintf("Auction admin transferred from %s to %s", v.PreviousAdmin.Hex(), v.NewAdmin.Hex())
+
+	case *generated.WETHTransfer:
+		amount := formatUnits(v.Value, wethDecimals) + " WETH"
+		switch {
+		case v.From == (common.Address{}):
+			return fmt.Sprintf("%s minted to %s", amount, v.To.Hex())
+		case v.To == (common.Address{}):
+			return fmt.Sprintf("%s burned from %s", amount, v.From.Hex())
+		}
+		return fmt.Sprintf("%s transferred %s to %s", v.From.Hex(), amount, v.To.Hex())
+	case *generated.WETHApproval:
+		return fmt.Sprintf("%s allowed %s to spend %s WETH", v.Owner.Hex(), v.Spender.Hex(), formatUnits(v.Value, wethDecimals))
+	case *generated.WETHOwnershipTransferred:
+		return fmt.Sprintf("WETH ownership transferred from %s to %s", v.PreviousOwner.Hex(), v.NewOwner.Hex())
+	case *generated.WETHOwnershipTransferStarted:
+		return ownershipTransfer("WETH", v.PreviousOwner, v.NewOwner)
+	case *generated.WETHTrustedForwarderChanged:
+		return fmt.Sprintf("WETH trusts forwarder %s", v.Forwarder.Hex())
+	case *generated.WETHPaused:
+		return fmt.Sprintf("WETH transfers paused by %s", v.Account.Hex())
+	case *generated.WETHUnpaused:
+		return fmt.Sprintf("WETH transfers unpaused by %s", v.Account.Hex())
+	case *generated.WETHGuardianChanged:
+		return fmt.Sprintf("%s %s a WETH guardian", v.Guardian.Hex(), guardianChange(v.IsGuardian))
+	case *generated.WETHPauseExemptionChanged:
+		return fmt.Sprintf("%s %s WETH pause", v.Account.Hex(), exemptionChange(v.IsExempt))
+	case *generated.WETHMint:
+		return fmt.Sprintf("Minter %s minted %s WETH to %s", v.Minter.Hex(), formatUnits(v.Amount, wethDecimals), v.Recepient.Hex())
+	case *generated.WETHBurn:
+		if v.Burner == v.Account {
+			return fmt.Sprintf("%s burned %s WETH", v.Burner.Hex(), formatUnits(v.Amount, wethDecimals))
+		}
+		return fmt.Sprintf("%s burned %s WETH of %s", v.Burner.Hex(), formatUnits(v.Amount, wethDecimals), v.Account.Hex())
+	case *generated.WETHMinterChanged:
+		return fmt.Sprintf("%s may mint %s WETH in total", v.Minter.Hex(), formatUnits(v.Allowance, wethDecimals))
+	case *generated.WETHMinterRemoved:
+		return fmt.Sprintf("%s is no longer a WETH minter", v.Minter.Hex())
+	case *generated.WETHSupplyCapChanged:
+		if v.SupplyCap.Sign() == 0 {
+			return "WETH supply cap removed"
+		}
+		return fmt.Sprintf("WETH supply capped at %s", formatUnits(v.SupplyCap, wethDecimals))
+
+	case *generated.WERC721Transfer:
+		switch {
+		case v.From == (common.Address{}):
+			return fmt.Sprintf("Token %s minted to %s", v.TokenId, v.To.Hex())
+		case v.To == (common.Address{}):
+			return fmt.Sprintf("Token %s burned by %s", v.TokenId, v.From.Hex())
+		}
+		return fmt.Sprintf("Token %s transferred from %s to %s", v.TokenId, v.From.Hex(), v.To.Hex())
+	case *generated.WERC721Approval:
+		return fmt.Sprintf("%s approved %s for token %s", v.Owner.Hex(), v.Approved.Hex(), v.TokenId)
+	case *generated.WERC721ApprovalForAll:
+		if v.Approved {
+			return fmt.Sprintf("%s approved operator %s for all tokens", v.Owner.Hex(), v.Operator.Hex())
+		}
+		return fmt.Sprintf("%s revoked operator %s for all tokens", v.Owner.Hex(), v.Operator.Hex())
+	case *generated.WERC721MetadataUpdate:
+		return fmt.Sprintf("Metadata of token %s updated", v.TokenId)
+	case *generated.WERC721BatchMetadataUpdate:
+		return fmt.Sprintf("Metadata of tokens %s to %s updated", v.FromTokenId, v.ToTokenId)
+	case *generated.WERC721Wrapped:
+		return fmt.Sprintf("%s wrapped token %s of %s as token %s", v.Owner.Hex(), v.TokenId, v.TokenAddress.Hex(), v.WrappedTokenId)
+	case *generated.WERC721Unwrapped:
+		return fmt.Sprintf("%s unwrapped token %s back to token %s of %s", v.Owner.Hex(), v.WrappedTokenId, v.TokenId, v.TokenAddress.Hex())
+	case *generated.WERC721OwnershipTransferred:
+		return fmt.Sprintf("WERC721 ownership transferred from %s to %s", v.PreviousOwner.Hex(), v.NewOwner.Hex())
+	case *generated.WERC721OwnershipTransferStarted:
+		return ownershipTransfer("WERC721", v.PreviousOwner, v.NewOwner)
+	case *generated.WERC721RoleGranted:
+		return fmt.Sprintf("%s granted %s to %s", v.Sender.Hex(), roleName(v.Role), v.Account.Hex())
+	case *generated.WERC721RoleRevoked:
+		return fmt.Sprintf("%s revoked %s from %s", v.Sender.Hex(), roleName(v.Role), v.Account.Hex())
+	case *generated.WERC721RoleAdminChanged:
+		return fmt.Sprintf("Admin role of %s changed from %s to %s", roleName(v.Role), roleName(v.PreviousAdminRole), roleName(v.NewAdminRole))
+	case *generated.WERC721TrustedForwarderChanged:
+		return fmt.Sprintf("WERC721 trusts forwarder %s", v.Forwarder.Hex())
+	case *generated.WERC721Paused:
+		return fmt.Sprintf("WERC721 transfers paused by %s", v.Account.Hex())
+	case *generated.WERC721Unpaused:
+		return fmt.Sprintf("WERC721 transfers unpaused by %s", v.Account.Hex())
+	case *generated.WERC721PauseExemptionChanged:
+		return fmt.Sprintf("%s %s WERC721 pause", v.Account.Hex(), exemptionChange(v.IsExempt))
+	case *generated.WERC721AdminRoleOffered:
+		if v.Handover {
+			return fmt.Sprintf("%s offered to hand its WERC721 admin role over to %s", v.OfferedBy.Hex(), v.Account.Hex())
+		}
+		return fmt.Sprintf("%s offered the WERC721 admin role to %s", v.OfferedBy.Hex(), v.Account.Hex())
+	case *generated.WERC721AdminRoleOfferCancelled:
+		return fmt.Sprintf("WERC721 admin role offer to %s cancelled", v.Account.Hex())
+	}
+	return fmt.Sprintf("%s.%s", ev.Contract, ev.Name)
+}
+
+func ownershipTransfer(contract string, owner, newOwner common.Address) string {
+	if newOwner == (common.Address{}) {
+		return fmt.Sprintf("%s cancelled the %s ownership transfer", owner.Hex(), contract)
+	}
+	return fmt.Sprintf("%s offered %s ownership to %s", owner.Hex(), contract, newOwner.Hex())
+}
+
+func guardianChange(isGuardian bool) string {
+	if isGuardian {
+		return "became"
+	}
+	return "is no longer"
+}
+
+func exemptionChange(isExempt bool) string {
+	if isExempt {
+		return "is exempt from the"
+	}
+	return "is no longer exempt from the"
+}
+
+func roleName(role [32]byte) string {
+	if name, ok := roleNames[role]; ok {
+		return name
+	}
+	return common.Hash(role).Hex()
+}
+
+// formatUnits renders amount with the given number of decimals, without
+// trailing zeros.
+func formatUnits(amount *big.Int, decimals int) string {
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	whole, frac := new(big.Int).QuoRem(new(big.Int).Abs(amount), unit, new(big.Int))
+
+	s := whole.String()
+	if frac.Sign() != 0 {
+		digits := fmt.Sprintf("%0*s", decimals, frac.String())
+		s += "." + strings.TrimRight(digits, "0")
+	}
+	if amount.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
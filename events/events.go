@@ -0,0 +1,223 @@
+// Package events decodes logs of the Auction, WETH and WERC721 contracts
+// into the generated event types, without knowing up front which event a
+// log holds.
+package events
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/one-click-platform/system-contracts/generated"
+)
+
+// Contract names.
+const (
+	Auction = "Auction"
+	WETH    = "WETH"
+	WERC721 = "WERC721"
+)
+
+var (
+	ErrUnknownContract = errors.New("log of an unknown contract")
+	ErrUnknownEvent    = errors.New("unknown event")
+)
+
+// Event is a decoded log. Value holds a pointer to the generated event
+// struct named after Contract and Name, e.g. *generated.AuctionAuctionBid
+// for the AuctionBid event of the Auction contract; switch on its type to
+// access the fields.
+type Event struct {
+	Contract string
+	Name     string
+	Value    interface{}
+	Raw      types.Log
+}
+
+type parseFunc func(types.Log) (interface{}, error)
+
+type event struct {
+	name  string
+	parse parseFunc
+}
+
+type contract struct {
+	name   string
+	events map[common.Hash]event
+}
+
+// Decoder maps the emitting address and topic0 of a log to the event it
+// holds.
+type Decoder struct {
+	contracts map[common.Address]*contract
+}
+
+// Addresses are the deployed system contracts. Contracts at the zero
+// address are not decoded.
+type Addresses struct {
+	Auction common.Address
+	WETH    common.Address
+	WERC721 common.Address
+}
+
+// NewDecoder creates a decoder for the contracts at addresses.
+func NewDecoder(addresses Addresses) (*Decoder, error) {
+	d := &Decoder{contracts: make(map[common.Address]*contract)}
+
+	var none bind.ContractFilterer
+	if addresses.Auction != (common.Address{}) {
+		f, err := generated.NewAuctionFilterer(addresses.Auction, none)
+		if err != nil {
+			return nil, err
+		}
+		err = d.add(addresses.Auction, Auction, generated.AuctionABI, map[string]parseFunc{
+			"AuctionCreated":          func(l types.Log) (interface{}, error) { return f.ParseAuctionCreated(l) },
+			"AuctionClosed":           func(l types.Log) (interface{}, error) { return f.ParseAuctionClosed(l) },
+			"AuctionBid":              func(l types.Log) (interface{}, error) { return f.ParseAuctionBid(l) },
+			"BidCountered":            func(l types.Log) (interface{}, error) { return f.ParseBidCountered(l) },
+			"RepaymentTransferred":    func(l types.Log) (interface{}, error) { return f.ParseRepaymentTransferred(l) },
+			"LotTransferred":          func(l types.Log) (interface{}, error) { return f.ParseLotTransferred(l) },
+			"TrustedForwarderChanged": func(l types.Log) (interface{}, error) { return f.ParseTrustedForwarderChanged(l) },
+			"Paused":                  func(l types.Log) (interface{}, error) { return f.ParsePaused(l) },
+			"Unpaused":                func(l types.Log) (interface{}, error) { return f.ParseUnpaused(l) },
+			"GuardianChanged":         func(l types.Log) (interface{}, error) { return f.ParseGuardianChanged(l) },
+			"AdminTransferred":        func(l types.Log) (interface{}, error) { return f.ParseAdminTransferred(l) },
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if addresses.WETH != (common.Address{}) {
+		f, err := generated.NewWETHFilterer(addresses.WETH, none)
+		if err != nil {
+			return nil, err
+		}
+		err = d.add(addresses.WETH, WETH, generated.WETHABI, map[string]parseFunc{
+			"Transfer":                 func(l types.Log) (interface{}, error) { return f.ParseTransfer(l) },
+			"Approval":                 func(l types.Log) (interface{}, error) { return f.ParseApproval(l) },
+			"OwnershipTransferred":     func(l types.Log) (interface{}, error) { return f.ParseOwnershipTransferred(l) },
+			"OwnershipTransferStarted": func(l types.Log) (interface{}, error) { return f.ParseOwnershipTransferStarted(l) },
+			"TrustedForwarderChanged":  func(l types.Log) (interface{}, error) { return f.ParseTrustedForwarderChanged(l) },
+			"Paused":                   func(l types.Log) (interface{}, error) { return f.ParsePaused(l) },
+			"Unpaused":                 func(l types.Log) (interface{}, error) { return f.ParseUnpaused(l) },
+			"GuardianChanged":          func(l types.Log) (interface{}, error) { return f.ParseGuardianChanged(l) },
+			"PauseExemptionChanged":    func(l types.Log) (interface{}, error) { return f.ParsePauseExemptionChanged(l) },
+			"Mint":                     func(l types.Log) (interface{}, error) { return f.ParseMint(l) },
+			"Burn":                     func(l types.Log) (interface{}, error) { return f.ParseBurn(l) },
+			"MinterChanged":            func(l types.Log) (interface{}, error) { return f.ParseMinterChanged(l) },
+			"MinterRemoved":            func(l types.Log) (interface{}, error) { return f.ParseMinterRemoved(l) },
+			"SupplyCapChanged":         func(l types.Log) (interface{}, error) { return f.ParseSupplyCapChanged(l) },
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if addresses.WERC721 != (common.Address{}) {
+		f, err := generated.NewWERC721Filterer(addresses.WERC721, none)
+		if err != nil {
+			return nil, err
+		}
+		err = d.add(addresses.WERC721, WERC721, generated.WERC721ABI, map[string]parseFunc{
+			"Transfer":                 func(l types.Log) (interface{}, error) { return f.ParseTransfer(l) },
+			"Approval":                 func(l types.Log) (interface{}, error) { return f.ParseApproval(l) },
+			"ApprovalForAll":           func(l types.Log) (interface{}, error) { return f.ParseApprovalForAll(l) },
+			"MetadataUpdate":           func(l types.Log) (interface{}, error) { return f.ParseMetadataUpdate(l) },
+			"BatchMetadataUpdate":      func(l types.Log) (interface{}, error) { return f.ParseBatchMetadataUpdate(l) },
+			"Wrapped":                  func(l types.Log) (interface{}, error) { return f.ParseWrapped(l) },
+			"Unwrapped":                func(l types.Log) (interface{}, error) { return f.ParseUnwrapped(l) },
+			"OwnershipTransferred":     func(l types.Log) (interface{}, error) { return f.ParseOwnershipTransferred(l) },
+			"OwnershipTransferStarted": func(l types.Log) (interface{}, error) { return f.ParseOwnershipTransferStarted(l) },
+			"RoleGranted":              func(l types.Log) (interface{}, error) { return f.ParseRoleGranted(l) },
+			"RoleRevoked":              func(l types.Log) (interface{}, error) { return f.ParseRoleRevoked(l) },
+			"RoleAdminChanged":         func(l types.Log) (interface{}, error) { return f.ParseRoleAdminChanged(l) },
+			"TrustedForwarderChanged":  func(l types.Log) (interface{}, error) { return f.ParseTrustedForwarderChanged(l) },
+			"Paused":                   func(l types.Log) (interface{}, error) { return f.ParsePaused(l) },
+			"Unpaused":                 func(l types.Log) (interface{}, error) { return f.ParseUnpaused(l) },
+			"PauseExemptionChanged":    func(l types.Log) (interface{}, error) { return f.ParsePauseExemptionChanged(l) },
+			"AdminRoleOffered":         func(l types.Log) (interface{}, error) { return f.ParseAdminRoleOffered(l) },
+			"AdminRoleOfferCancelled":  func(l types.Log) (interface{}, error) { return f.ParseAdminRoleOfferCancelled(l) },
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+func (d *Decoder) add(address common.Address, name, abiJSON string, parsers map[string]parseFunc) error {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return err
+	}
+	c := &contract{name: name, events: make(map[common.Hash]event, len(parsers))}
+	for eventName, parse := range parsers {
+		ev, ok := parsed.Events[eventName]
+		if !ok {
+			return fmt.Errorf("event %s is missing from the %s ABI", eventName, name)
+		}
+		c.events[ev.ID] = event{name: eventName, parse: parse}
+	}
+	d.contracts[address] = c
+	return nil
+}
+
+// Decode decodes a single log.
+func (d *Decoder) Decode(log types.Log) (*Event, error) {
+	c, ok := d.contracts[log.Address]
+	if !ok {
+		return nil, ErrUnknownContract
+	}
+	if len(log.Topics) == 0 {
+		return nil, ErrUnknownEvent
+	}
+	ev, ok := c.events[log.Topics[0]]
+	if !ok {
+		return nil, ErrUnknownEvent
+	}
+	value, err := ev.parse(log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s.%s: %w", c.name, ev.name, err)
+	}
+	return &Event{Contract: c.name, Name: ev.name, Value: value, Raw: log}, nil
+}
+
+// DecodeReceipt decodes the logs of a receipt in order. Logs of other
+// contracts and unknown events are skipped.
+func (d *Decoder) DecodeReceipt(receipt *types.Receipt) ([]*Event, error) {
+	var decoded []*Event
+	for _, log := range receipt.Logs {
+		ev, err := d.Decode(*log)
+		if errors.Is(err, ErrUnknownContract) || errors.Is(err, ErrUnknownEvent) {
+			continue
+		}
+		if err != nil {
+			return decoded, err
+		}
+		decoded = append(decoded, ev)
+	}
+	return decoded, nil
+}
+
+// Query returns a filter matching every decoded event of every contract.
+func (d *Decoder) Query() ethereum.FilterQuery {
+	var query ethereum.FilterQuery
+	var ids []common.Hash
+	seen := make(map[common.Hash]bool)
+	for address, c := range d.contracts {
+		query.Addresses = append(query.Addresses, address)
+		for id := range c.events {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	query.Topics = [][]common.Hash{ids}
+	return query
+}
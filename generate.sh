@@ -1,10 +1,19 @@
 #!/bin/bash
 
-docker run -v $PWD:$PWD -w $PWD ethereum/solc:0.8.3 @openzeppelin/=$(pwd)/node_modules/@openzeppelin/ --overwrite --abi --bin -o ./build \
+docker run -v $PWD:$PWD -w $PWD ethereum/solc:0.8.21 @openzeppelin/=$(pwd)/node_modules/@openzeppelin/ --optimize --evm-version istanbul --overwrite --abi --bin --storage-layout -o ./build \
 contracts/Auction.sol \
 contracts/WETH.sol \
-contracts/WERC721.sol
+contracts/WERC721.sol \
+contracts/Listings.sol \
+contracts/Offers.sol \
+contracts/Forwarder.sol \
+contracts/AuctionProxy.sol
 
 ./bin/abigen  --abi ./build/Auction.abi --bin ./build/Auction.bin --type Auction --pkg generated --out ./generated/auction.go
 ./bin/abigen  --abi ./build/WETH.abi --bin ./build/WETH.bin --type WETH --pkg generated --out ./generated/weth.go
 ./bin/abigen  --abi ./build/WERC721.abi --bin ./build/WERC721.bin --type WERC721 --pkg generated --out ./generated/werc721.go
+./bin/abigen  --abi ./build/Listings.abi --bin ./build/Listings.bin --type Listings --pkg generated --out ./generated/listings.go
+./bin/abigen  --abi ./build/Offers.abi --bin ./build/Offers.bin --type Offers --pkg generated --out ./generated/offers.go
+./bin/abigen  --abi ./build/Forwarder.abi --bin ./build/Forwarder.bin --type Forwarder --pkg generated --out ./generated/forwarder.go
+./bin/abigen  --abi ./build/ProxyAdmin.abi --bin ./build/ProxyAdmin.bin --type ProxyAdmin --pkg generated --out ./generated/proxyadmin.go
+./bin/abigen  --abi ./build/TransparentUpgradeableProxy.abi --bin ./build/TransparentUpgradeableProxy.bin --type TransparentUpgradeableProxy --pkg generated --out ./generated/transparentupgradeableproxy.go
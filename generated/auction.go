@@ -4,6 +4,7 @@
 package generated
 
 import (
+	"errors"
 	"math/big"
 	"strings"
 
@@ -17,6 +18,7 @@ import (
 
 // Reference imports to suppress errors if they are not otherwise used.
 var (
+	_ = errors.New
 	_ = big.NewInt
 	_ = strings.NewReader
 	_ = ethereum.NotFound
@@ -46,20 +48,31 @@ type AuctionAuctionInfo struct {
 	LotTransferred       bool
 }
 
+// AuctionMetaData contains all meta data concerning the Auction contract.
+var AuctionMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_trustedForwarder\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_previousAdmin\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_newAdmin\",\"type\":\"address\"}],\"name\":\"AdminTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_bidder\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"AuctionBid\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"AuctionClosed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_creator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_tokenAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_currencyAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"AuctionCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_bidder\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"BidCountered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_guardian\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"_isGuardian\",\"type\":\"bool\"}],\"name\":\"GuardianChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_winner\",\"type\":\"address\"}],\"name\":\"LotTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"Paused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_creator\",\"type\":\"address\"}],\"name\":\"RepaymentTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_forwarder\",\"type\":\"address\"}],\"name\":\"TrustedForwarderChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"Unpaused\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"bid\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_maxAmount\",\"type\":\"uint256\"}],\"name\":\"bidMax\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_deadline\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"_v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"_r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"_s\",\"type\":\"bytes32\"}],\"name\":\"bidWithPermit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"buyNow\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"claimLot\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"claimRepayment\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"countOfAuctions\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_bidder\",\"type\":\"address\"}],\"name\":\"countOfBidderAuctions\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_creator\",\"type\":\"address\"}],\"name\":\"countOfCreatorAuctions\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_tokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_currencyAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_startPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_buyNowPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_startTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_duration\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_durationIncrement\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_bidIncrement\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"_description\",\"type\":\"string\"}],\"name\":\"createAuction\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getAdmin\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"getAuctionInfo\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"startPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"buyNowPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"startTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"duration\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"durationIncrement\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bidIncrement\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"tokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"currencyAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"currentBidder\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"highestBid\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"lotBought\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"repaymentTransferred\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"lotTransferred\",\"type\":\"bool\"}],\"internalType\":\"structAuction.AuctionInfo\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_offset\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_limit\",\"type\":\"uint256\"}],\"name\":\"getAuctions\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"startPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"buyNowPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"startTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"duration\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"durationIncrement\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bidIncrement\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"tokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"currencyAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"currentBidder\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"highestBid\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"lotBought\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"repaymentTransferred\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"lotTransferred\",\"type\":\"bool\"}],\"internalType\":\"structAuction.AuctionInfo[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_bidder\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_offset\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_limit\",\"type\":\"uint256\"}],\"name\":\"getBidderAuctions\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_creator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_offset\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_limit\",\"type\":\"uint256\"}],\"name\":\"getCreatorAuctions\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"getMaxBid\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"getRaisingBid\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"getStatus\",\"outputs\":[{\"internalType\":\"enumAuction.AuctionStatus\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTrustedForwarder\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_trustedForwarder\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_admin\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_admin\",\"type\":\"address\"}],\"name\":\"initializeAdmin\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isGuardian\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_forwarder\",\"type\":\"address\"}],\"name\":\"isTrustedForwarder\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"paused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"regainLot\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_guardian\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"_isGuardian\",\"type\":\"bool\"}],\"name\":\"setGuardian\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"settle\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_newAdmin\",\"type\":\"address\"}],\"name\":\"transferAdmin\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x60806040523480156200001157600080fd5b50604051620045503803806200455083398101604081905262000034916200028c565b80620000408162000116565b50600054610100900460ff16806200005b575060005460ff16155b620000c45760405162461bcd60e51b815260206004820152602e60248201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160448201526d191e481a5b9a5d1a585b1a5e995960921b60648201526084015b60405180910390fd5b600054610100900460ff16158015620000e7576000805461ffff19166101011790555b620000fb620000f562000174565b620001a7565b80156200010e576000805461ff00191690555b5050620002be565b6000805462010000600160b01b031916620100006001600160a01b038416908102919091179091556040519081527f871264f4293af7d2865ae7eae628b228f4991c57cb45b39c99f0b774ebe290189060200160405180910390a150565b6000620001813362000258565b80156200018f575060143610155b15620001a2575060131936013560601c90565b503390565b6001600160a01b038116620001ef5760405162461bcd60e51b815260206004820152600d60248201526c24b73b30b634b21030b236b4b760991b6044820152606401620000bb565b600754604080516001600160a01b03928316815291831660208301527ff8ccb027dfcd135e000e9d45e6cc2d662578a8825d4c45b5e32e0adf67e79ec6910160405180910390a1600780546001600160a01b0319166001600160a01b0392909216919091179055565b60006001600160a01b038216158015906200028657506000546001600160a01b038381166201000090920416145b92915050565b6000602082840312156200029f57600080fd5b81516001600160a01b0381168114620002b757600080fd5b9392505050565b61428280620002ce6000396000f3fe608060405234801561001057600080fd5b50600436106101d95760003560e01c8063617fbce91161010457806393923d2e116100a2578063d1fa406b11610071578063d1fa406b14610425578063d999e5d414610445578063f2da066414610458578063fc3fc4ed1461046b57600080fd5b806393923d2e146103c8578063a2165920146103db578063ce1b815f146103ee578063ceb6a22f1461040557600080fd5b806375829def116100de57806375829def146103875780638456cb591461039a5780638df82800146103a257806392496337146103b557600080fd5b8063617fbce91461033c5780636e9960c31461034f5780637553ee321461037457600080fd5b80633f4ba83a1161017c578063572b6c051161014b578063572b6c05146102eb578063598647f8146102fe5780635c622a0e146103115780635c975abb1461033157600080fd5b80633f4ba83a14610294578063485cc9551461029c578063490abbd0146102af5780634bc28ede146102d857600080fd5b80631080f5c9116101b85780631080f5c91461022e57806322a0119b146102415780632b8a1c5a14610258578063302619d11461026b57600080fd5b8062d878e8146101de57806308a0f32f146101f35780630c68ba2114610206575b600080fd5b6101f16101ec366004613a5e565b61048b565b005b6101f1610201366004613a5e565b610713565b610219610214366004613a8c565b610d36565b60405190151581526020015b60405180910390f35b6101f161023c366004613a5e565b610d70565b61024a60015481565b604051908152602001610225565b6101f1610266366004613ab7565b61115e565b61024a610279366004613a8c565b6001600160a01b031660009081526003602052604090205490565b6101f16111fb565b6101f16102aa366004613af0565b6112cf565b61024a6102bd366004613a8c565b6001600160a01b031660009081526004602052604090205490565b61024a6102e6366004613b34565b611396565b6102196102f9366004613a8c565b611a93565b6101f161030c366004613c5a565b611ac3565b61032461031f366004613a5e565b611af6565b6040516102259190613c92565b60095460ff16610219565b61024a61034a366004613a5e565b611cea565b6007546001600160a01b03165b6040516001600160a01b039091168152602001610225565b6101f1610382366004613a8c565b611d73565b6101f1610395366004613a8c565b611e51565b6101f1611e8b565b6101f16103b0366004613a5e565b611f2f565b6101f16103c3366004613cba565b612266565b6101f16103d6366004613c5a565b612349565b61024a6103e9366004613a5e565b612378565b6000546201000090046001600160a01b031661035c565b610418610413366004613c5a565b61257e565b6040516102259190613e4a565b610438610433366004613eac565b6127a8565b6040516102259190613ee1565b610438610453366004613eac565b6127d8565b6101f1610466366004613a5e565b6127fe565b61047e610479366004613a5e565b612b1f565b6040516102259190613f25565b80600361049782611af6565b60048111156104a8576104a8613c7c565b146104ce5760405162461bcd60e51b81526004016104c590613f38565b60405180910390fd5b600082815260026020818152604080842081516102008101835281546001600160a01b0316815260018201549381019390935292830154908201526003820154606082015260048201546080820152600582015460a0820152600682015460c082015260078201805491929160e08401919061054990613f6f565b80601f016020809104026020016040519081016040528092919081815260200182805461057590613f6f565b80156105c25780601f10610597576101008083540402835291602001916105c2565b820191906000526020600020905b8154815290600101906020018083116105a557829003601f168201915b505050918352505060088201546001600160a01b03908116602083015260098301546040830152600a83015481166060830152600b830154166080820152600c82015460a0820152600d9091015460ff808216151560c08401526101008083048216151560e0850152620100009092041615159101526101c0810151909150156106a15760405162461bcd60e51b815260206004820152602a60248201527f5468652072657061796d656e742068617320616c7265616479206265656e20746044820152691c985b9cd9995c9c995960b21b60648201526084016104c5565b6000838152600260205260409020600d01805461ff0019166101001790556106c98382612cfc565b8051604080518581526001600160a01b0390921660208301527fcede1e8bc44e44a1be509c46755459a8fef702efbee4649158b5376942bc14b991015b60405180910390a1505050565b60095460ff16156107365760405162461bcd60e51b81526004016104c590613fa3565b80600261074282611af6565b600481111561075357610753613c7c565b146107985760405162461bcd60e51b815260206004820152601560248201527441756374696f6e206973206e6f742061637469766560581b60448201526064016104c5565b600082815260026020818152604080842081516102008101835281546001600160a01b0316815260018201549381019390935292830154908201526003820154606082015260048201546080820152600582015460a0820152600682015460c082015260078201805491929160e08401919061081390613f6f565b80601f016020809104026020016040519081016040528092919081815260200182805461083f90613f6f565b801561088c5780601f106108615761010080835404028352916020019161088c565b820191906000526020600020905b81548152906001019060200180831161086f57829003601f168201915b505050918352505060088201546001600160a01b0390811660208301526009830154604080840191909152600a84015482166060840152600b8401549091166080830152600c83015460a0830152600d9092015460ff808216151560c08401526101008083048216151560e08501526201000090920416151591015261018082015190820151919250106109735760405162461bcd60e51b815260206004820152602860248201527f427579696e6720696d6d6564696174656c79206973206e6f206c6f6e676572206044820152671c995b195d985b9d60c21b60648201526084016104c5565b61014081015160006001600160a01b0382166323b872dd610992612e99565b3086604001516040518463ffffffff1660e01b81526004016109b693929190613fce565b6020604051808303816000875af11580156109d5573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906109f99190613ff2565b905080610a485760405162461bcd60e51b815260206004820181905260248201527f4661696c656420746f207472616e73666572207468652072657061796d656e7460448201526064016104c5565b61018083015115610b09576101608301516000868152600660205260409081902054905163a9059cbb60e01b81526001600160a01b0385169263a9059cbb92610aa7926004016001600160a01b03929092168252602082015260400190565b6020604051808303816000875af1158015610ac6573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610aea9190613ff2565b905080610b095760405162461bcd60e51b81526004016104c59061400f565b8261010001516001600160a01b03166323b872dd30610b26612e99565b8661012001516040518463ffffffff1660e01b8152600401610b4a93929190613fce565b600060405180830381600087803b158015610b6457600080fd5b505af1158015610b78573d6000803e3d6000fd5b505050604084015161018085015250610b8f612e99565b6001600160a01b0390811661016085015260016101a085018190526101e08501819052600087815260026020818152604092839020885181546001600160a01b03191696169590951785558701519284019290925585015190820155606084015160038201556080840151600482015560a0840151600582015560c0840151600682015560e08401518491906007820190610c2a9082614081565b50610100828101516008830180546001600160a01b03199081166001600160a01b03938416179091556101208501516009850155610140850151600a850180548316918416919091179055610160850151600b850180549092169216919091179055610180830151600c8301556101a0830151600d90920180546101c08501516101e09095015161ffff1990911693151561ff001916939093179315159091029290921762ff00001916620100009115159190910217905560408381015160008781526006602052919091205560008051602061422d83398151915285610d0f612e99565b604080519283526001600160a01b0390911660208301520160405180910390a15050505050565b6001600160a01b03811660009081526008602052604081205460ff1680610d6a57506007546001600160a01b038381169116145b92915050565b806003610d7c82611af6565b6004811115610d8d57610d8d613c7c565b14610daa5760405162461bcd60e51b81526004016104c590613f38565b600082815260026020818152604080842081516102008101835281546001600160a01b0316815260018201549381019390935292830154908201526003820154606082015260048201546080820152600582015460a0820152600682015460c082015260078201805491929160e084019190610e2590613f6f565b80601f0160208091040260200160405190810160405280929190818152602001828054610e5190613f6f565b8015610e9e5780601f10610e7357610100808354040283529160200191610e9e565b820191906000526020600020905b815481529060010190602001808311610e8157829003601f168201915b505050918352505060088201546001600160a01b03908116602083015260098301546040830152600a83015481166060830152600b830154166080820152600c82015460a0820152600d9091015460ff808216151560c08401526101008083048216151560e08501526201000090920416151591015261018081015190915015610f7f5760405162461bcd60e51b815260206004820152602c60248201527f546865206c6f742062656c6f6e677320746f207468652077696e6e6572206f6660448201526b103a34329030bab1ba34b7b760a11b60648201526084016104c5565b61010081015181516101208301516040516323b872dd60e01b81526001600160a01b03909316926323b872dd92610fba923092600401613fce565b600060405180830381600087803b158015610fd457600080fd5b505af1158015610fe8573d6000803e3d6000fd5b505060016101c084018190526101e08401819052600086815260026020818152604092839020875181546001600160a01b0319166001600160a01b0390911617815590870151938101939093559085015190820155606084015160038201556080840151600482015560a0840151600582015560c0840151600682015560e0840151849350909150600782019061107f9082614081565b50610100828101516008830180546001600160a01b039283166001600160a01b0319918216179091556101208501516009850155610140850151600a85018054918416918316919091179055610160850151600b8501805491841691909216179055610180840151600c8401556101a0840151600d90930180546101c08601516101e0909601511515620100000262ff00001996151590940261ff00199515159590951661ffff1990911617939093179390931617905581516040805186815291909216602082015260008051602061422d8339815191529101610706565b6007546001600160a01b0316611172612e99565b6001600160a01b0316146111985760405162461bcd60e51b81526004016104c590614141565b6001600160a01b038216600081815260086020908152604091829020805460ff19168515159081179091558251938452908301527fafef3d05547c718394a99a79aa641db2143708cd1114b68479af4740f173cc50910160405180910390a15050565b6007546001600160a01b031661120f612e99565b6001600160a01b0316146112355760405162461bcd60e51b81526004016104c590614141565b60095460ff1661127f5760405162461bcd60e51b8152602060048201526015602482015274105d58dd1a5bdb881a5cc81b9bdd081c185d5cd959605a1b60448201526064016104c5565b6009805460ff191690557f5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa6112b2612e99565b6040516001600160a01b03909116815260200160405180910390a1565b600054610100900460ff16806112e8575060005460ff16155b61134b5760405162461bcd60e51b815260206004820152602e60248201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160448201526d191e481a5b9a5d1a585b1a5e995960921b60648201526084016104c5565b600054610100900460ff1615801561136d576000805461ffff19166101011790555b61137683612ec8565b61137f82612f26565b8015611391576000805461ff00191690555b505050565b60095460009060ff16156113bc5760405162461bcd60e51b81526004016104c590613fa3565b6001600160a01b038b163b6114135760405162461bcd60e51b815260206004820152601d60248201527f476976656e20746f6b656e206973206e6f74206120636f6e747261637400000060448201526064016104c5565b8a61141c612e99565b6040516331a9108f60e11b8152600481018d90526001600160a01b0391821691831690636352211e90602401602060405180830381865afa158015611465573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611489919061416b565b6001600160a01b0316146114d75760405162461bcd60e51b8152602060048201526015602482015274125cc81b9bdd081bdddb995c881bd988185cdcd95d605a1b60448201526064016104c5565b60405163020604bf60e21b8152600481018c905230906001600160a01b0383169063081812fc90602401602060405180830381865afa15801561151e573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611542919061416b565b6001600160a01b03161461158e5760405162461bcd60e51b8152602060048201526013602482015272131bdd081a5cc81b9bdd08185c1c1c9bdd9959606a1b60448201526064016104c5565b6001600160a01b038a163b6115e55760405162461bcd60e51b815260206004820181905260248201527f476976656e2063757272656e6379206973206e6f74206120636f6e747261637460448201526064016104c5565b8860000361162b5760405162461bcd60e51b8152602060048201526013602482015272496e76616c696420737461727420707269636560681b60448201526064016104c5565b888810156116975760405162461bcd60e51b815260206004820152603360248201527f427579206e6f772070726963652073686f756c6420686967686572206f7220656044820152727175616c20746f20737461727420707269636560681b60648201526084016104c5565b856000036116e75760405162461bcd60e51b815260206004820152601860248201527f496e76616c69642061756374696f6e206475726174696f6e000000000000000060448201526064016104c5565b846000036117375760405162461bcd60e51b815260206004820152601960248201527f496e76616c69642061756374696f6e20696e6372656d656e740000000000000060448201526064016104c5565b83600010801561175357506b033b2e3c9fd0803ce80000008411155b6117975760405162461bcd60e51b8152602060048201526015602482015274125b9d985b1a5908189a59081a5b98dc995b595b9d605a1b60448201526064016104c5565b806001600160a01b03166323b872dd6117ae612e99565b308e6040518463ffffffff1660e01b81526004016117ce93929190613fce565b600060405180830381600087803b1580156117e857600080fd5b505af11580156117fc573d6000803e3d6000fd5b505050506118086139b7565b4288101561183757426060820181905261182d90611826908a612fd5565b8890612fd5565b6080820152611846565b60608101889052608081018790525b61184e612e99565b6001600160a01b0390811682528d811661010083015261012082018d90528b811661014083015260208083018c815260408085018d815260a086018b815260c087018b815260e088018b81526001805460008181526002998a9052969096208a5181546001600160a01b0319169a16999099178955955195880195909555915194860194909455606086015160038601556080860151600486015592516005850155915160068401555190918391600782019061190b9082614081565b50610100828101516008830180546001600160a01b03199081166001600160a01b03938416179091556101208501516009850155610140850151600a850180548316918416919091179055610160850151600b850180549092169216919091179055610180830151600c8301556101a0830151600d90920180546101c08501516101e09095015161ffff1990911693151561ff001916939093179315159091029290921762ff000019166201000091151591909102179055600360006119cf612e99565b6001600160a01b03168152602080820192909252604001600090812080546001818101835591835292822090920183905581549190611a0d8361419e565b90915550508151610100830151610120840151610140850151604080516001600160a01b0395861681529385166020850152830191909152919091166060820152608081018290527f03bb6e669c5d9d2143afb3599bda2cc92f483158549e37b474a6dc117f848b689060a00160405180910390a19d9c50505050505050505050505050565b60006001600160a01b03821615801590610d6a5750506000546201000090046001600160a01b0390811691161490565b60095460ff1615611ae65760405162461bcd60e51b81526004016104c590613fa3565b611af282826000612fe1565b5050565b600081815260026020818152604080842081516102008101835281546001600160a01b0316815260018201549381019390935292830154908201526003820154606082015260048201546080820152600582015460a0820152600682015460c082015260078201805484939160e0840191611b7090613f6f565b80601f0160208091040260200160405190810160405280929190818152602001828054611b9c90613f6f565b8015611be95780601f10611bbe57610100808354040283529160200191611be9565b820191906000526020600020905b815481529060010190602001808311611bcc57829003601f168201915b505050918352505060088201546001600160a01b03908116602083015260098301546040830152600a83015481166060830152600b83015481166080830152600c83015460a0830152600d9092015460ff808216151560c08401526101008083048216151560e085015262010000909204161515910152815191925016611c735750600092915050565b806101c001518015611c875750806101e001515b15611c955750600492915050565b806101a0015115611ca95750600392915050565b8060600151421015611cbe5750600192915050565b60808101516060820151611cd191613866565b421015611ce15750600292915050565b50600392915050565b6000611cf4612e99565b6000838152600260205260409020600b01546001600160a01b03908116911614611d605760405162461bcd60e51b815260206004820152601960248201527f4973206e6f74207468652063757272656e74206269646465720000000000000060448201526064016104c5565b5060009081526006602052604090205490565b7fb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103546001600160a01b0316336001600160a01b031614611df55760405162461bcd60e51b815260206004820152601d60248201527f43616c6c6572206973206e6f74207468652070726f78792061646d696e00000060448201526064016104c5565b6007546001600160a01b031615611e455760405162461bcd60e51b815260206004820152601460248201527310591b5a5b881a5cc8185b1c9958591e481cd95d60621b60448201526064016104c5565b611e4e81612f26565b50565b6007546001600160a01b0316611e65612e99565b6001600160a01b031614611e455760405162461bcd60e51b81526004016104c590614141565b60095460ff1615611eae5760405162461bcd60e51b81526004016104c590613fa3565b611eb9610214612e99565b611ef95760405162461bcd60e51b815260206004820152601160248201527024b9903737ba10309033bab0b93234b0b760791b60448201526064016104c5565b6009805460ff191660011790557f62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a2586112b2612e99565b806003611f3b82611af6565b6004811115611f4c57611f4c613c7c565b14611f695760405162461bcd60e51b81526004016104c590613f38565b600082815260026020818152604080842081516102008101835281546001600160a01b0316815260018201549381019390935292830154908201526003820154606082015260048201546080820152600582015460a0820152600682015460c082015260078201805491929160e084019190611fe490613f6f565b80601f016020809104026020016040519081016040528092919081815260200182805461201090613f6f565b801561205d5780601f106120325761010080835404028352916020019161205d565b820191906000526020600020905b81548152906001019060200180831161204057829003601f168201915b505050918352505060088201546001600160a01b039081166020808401919091526009840154604080850191909152600a85015483166060850152600b8501549092166080840152600c84015460a0840152600d9384015460ff808216151560c08601526101008083048216151560e087015262010000909204161515930192909252610180840151600088815260029093529120909101805462ffff001916620101001790556101e08201519192501515906121c957600081612122578251612129565b8261016001515b90508261010001516001600160a01b03166323b872dd30838661012001516040518463ffffffff1660e01b815260040161216593929190613fce565b600060405180830381600087803b15801561217f57600080fd5b505af1158015612193573d6000803e3d6000fd5b5050604080518881526001600160a01b038516602082015260008051602061422d833981519152935001905060405180910390a1505b816101c001511580156121d95750805b1561222d576121e88483612cfc565b8151604080518681526001600160a01b0390921660208301527fcede1e8bc44e44a1be509c46755459a8fef702efbee4649158b5376942bc14b9910160405180910390a15b6040518481527fac4a907ec29adcc56774b757ecb1e1b4d597374fc9386107d05e2670259df7d39060200160405180910390a150505050565b60095460ff16156122895760405162461bcd60e51b81526004016104c590613fa3565b6000868152600260205260409020600a01546001600160a01b031663d505accf6122b1612e99565b6040516001600160e01b031960e084901b1681526001600160a01b039091166004820152306024820152604481018890526064810187905260ff8616608482015260a4810185905260c4810184905260e401600060405180830381600087803b15801561231d57600080fd5b505af1158015612331573d6000803e3d6000fd5b5050505061234186866000612fe1565b505050505050565b60095460ff161561236c5760405162461bcd60e51b81526004016104c590613fa3565b611af282826001612fe1565b600081600261238682611af6565b600481111561239757612397613c7c565b146123dc5760405162461bcd60e51b815260206004820152601560248201527441756374696f6e206973206e6f742061637469766560581b60448201526064016104c5565b600083815260026020818152604080842081516102008101835281546001600160a01b0316815260018201549381019390935292830154908201526003820154606082015260048201546080820152600582015460a0820152600682015460c082015260078201805491929160e08401919061245790613f6f565b80601f016020809104026020016040519081016040528092919081815260200182805461248390613f6f565b80156124d05780601f106124a5576101008083540402835291602001916124d0565b820191906000526020600020905b8154815290600101906020018083116124b357829003601f168201915b505050918352505060088201546001600160a01b03908116602083015260098301546040830152600a83015481166060830152600b830154166080820152600c82015460a0820152600d9091015460ff808216151560c08401526101008083048216151560e08501526201000090920416151591015261018081015190915060000361256157602001519150612578565b6125748161018001518260c00151613872565b9250505b50919050565b6060600061258f848460015461389e565b9050600061259d8286612fd5565b67ffffffffffffffff8111156125b5576125b5613b1e565b6040519080825280602002602001820160405280156125ee57816020015b6125db6139b7565b8152602001906001900390816125d35790505b509050845b8281101561279f5760008181526002602081815260409283902083516102008101855281546001600160a01b0316815260018201549281019290925291820154928101929092526003810154606083015260048101546080830152600581015460a0830152600681015460c083015260078101805460e08401919061267790613f6f565b80601f01602080910402602001604051908101604052809291908181526020018280546126a390613f6f565b80156126f05780601f106126c5576101008083540402835291602001916126f0565b820191906000526020600020905b8154815290600101906020018083116126d357829003601f168201915b505050918352505060088201546001600160a01b03908116602083015260098301546040830152600a83015481166060830152600b830154166080820152600c82015460a0820152600d9091015460ff808216151560c08401526101008083048216151560e085015262010000909204161515910152826127718389612fd5565b81518110612781576127816141b7565b602002602001018190525080806127979061419e565b9150506125f3565b50949350505050565b6001600160a01b03831660009081526003602052604090206060906127ce9084846138d0565b90505b9392505050565b6001600160a01b03831660009081526004602052604090206060906127ce9084846138d0565b80600361280a82611af6565b600481111561281b5761281b613c7c565b146128385760405162461bcd60e51b81526004016104c590613f38565b600082815260026020818152604080842081516102008101835281546001600160a01b0316815260018201549381019390935292830154908201526003820154606082015260048201546080820152600582015460a0820152600682015460c082015260078201805491929160e0840191906128b390613f6f565b80601f01602080910402602001604051908101604052809291908181526020018280546128df90613f6f565b801561292c5780601f106129015761010080835404028352916020019161292c565b820191906000526020600020905b81548152906001019060200180831161290f57829003601f168201915b505050918352505060088201546001600160a01b03908116602083015260098301546040830152600a83015481166060830152600b830154166080820152600c82015460a0820152600d9091015460ff808216151560c08401526101008083048216151560e0850152620100009092041615159101526101808101519091506000036129fa5760405162461bcd60e51b815260206004820152601960248201527f5468652061756374696f6e20686173206e6f2077696e6e65720000000000000060448201526064016104c5565b806101e0015115612a595760405162461bcd60e51b8152602060048201526024808201527f546865206c6f742068617320616c7265616479206265656e207472616e7366656044820152631c9c995960e21b60648201526084016104c5565b6101008101516101608201516101208301516040516323b872dd60e01b81526001600160a01b03909316926323b872dd92612a98923092600401613fce565b600060405180830381600087803b158015612ab257600080fd5b505af1158015612ac6573d6000803e3d6000fd5b50505060008481526002602052604090819020600d01805462ff0000191662010000179055610160830151905160008051602061422d8339815191529250610706918682526001600160a01b0316602082015260400190565b612b276139b7565b816000612b3382611af6565b6004811115612b4457612b44613c7c565b03612b8a5760405162461bcd60e51b8152602060048201526016602482015275105d58dd1a5bdb88191bd95cc81b9bdd08195e1a5cdd60521b60448201526064016104c5565b60008381526002602081815260409283902083516102008101855281546001600160a01b0316815260018201549281019290925291820154928101929092526003810154606083015260048101546080830152600581015460a0830152600681015460c083015260078101805460e084019190612c0690613f6f565b80601f0160208091040260200160405190810160405280929190818152602001828054612c3290613f6f565b8015612c7f5780601f10612c5457610100808354040283529160200191612c7f565b820191906000526020600020905b815481529060010190602001808311612c6257829003601f168201915b505050918352505060088201546001600160a01b03908116602083015260098301546040830152600a83015481166060830152600b830154166080820152600c82015460a0820152600d9091015460ff808216151560c08401526101008083048216151560e0850152620100009092041615159101529392505050565b610140810151815161018083015160405163a9059cbb60e01b81526001600160a01b039283166004820152602481019190915260009183169063a9059cbb906044016020604051808303816000875af1158015612d5d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612d819190613ff2565b905080612dd05760405162461bcd60e51b815260206004820181905260248201527f4661696c656420746f207472616e73666572207468652072657061796d656e7460448201526064016104c5565b6101808301516000858152600660205260408120549091612df19190612fd5565b90508015612e925761016084015160405163a9059cbb60e01b81526001600160a01b039182166004820152602481018390529084169063a9059cbb906044016020604051808303816000875af1158015612e4f573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612e739190613ff2565b915081612e925760405162461bcd60e51b81526004016104c59061400f565b5050505050565b6000612ea433611a93565b8015612eb1575060143610155b15612ec3575060131936013560601c90565b503390565b6000805462010000600160b01b031916620100006001600160a01b038416908102919091179091556040519081527f871264f4293af7d2865ae7eae628b228f4991c57cb45b39c99f0b774ebe290189060200160405180910390a150565b6001600160a01b038116612f6c5760405162461bcd60e51b815260206004820152600d60248201526c24b73b30b634b21030b236b4b760991b60448201526064016104c5565b600754604080516001600160a01b03928316815291831660208301527ff8ccb027dfcd135e000e9d45e6cc2d662578a8825d4c45b5e32e0adf67e79ec6910160405180910390a1600780546001600160a01b0319166001600160a01b0392909216919091179055565b60006127d182846141cd565b6000612fec84612378565b9050808310156130805760405162461bcd60e51b815260206004820152605360248201527f42696420616d6f756e74206d757374206578636565642074686520686967686560448201527f73742062696420627920746865206d696e696d756d20696e6372656d656e74206064820152723832b931b2b73a30b3b29037b91036b7b9329760691b608482015260a4016104c5565b600061308a612e99565b600086815260026020818152604080842081516102008101835281546001600160a01b0316815260018201549381019390935292830154908201526003820154606082015260048201546080820152600582015460a0820152600682015460c08201526007820180549495509293909260e084019161310890613f6f565b80601f016020809104026020016040519081016040528092919081815260200182805461313490613f6f565b80156131815780601f1061315657610100808354040283529160200191613181565b820191906000526020600020905b81548152906001019060200180831161316457829003601f168201915b505050918352505060088201546001600160a01b039081166020808401919091526009840154604080850191909152600a85015483166060850152600b850154909216608080850191909152600c85015460a080860191909152600d9095015460ff808216151560c08701526101008083048216151560e08801526201000090920416151594019390935260008b8152600690915220549183015190830151929350909161322e91613866565b608083015260008781526005602090815260408083206001600160a01b038716845290915290205460ff166132a45760008781526005602090815260408083206001600160a01b03871684528252808320805460ff19166001908117909155600483529083208054918201815583529120018790555b610180820151158015906132cf5750826001600160a01b03168261016001516001600160a01b031614155b80156132db5750808611155b156134d45760006132f0878460c00151613872565b9050818111156132fd5750805b6101808301819052600088815260026020818152604092839020865181546001600160a01b0319166001600160a01b039091161781559086015160018201559185015190820155606084015160038201556080840151600482015560a0840151600582015560c0840151600682015560e084015184919060078201906133839082614081565b50610100828101516008830180546001600160a01b039283166001600160a01b0319918216179091556101208501516009850155610140850151600a85018054918416918316919091179055610160850151600b8501805491841691909216179055610180840151600c8401556101a0840151600d90930180546101c08601516101e0909601511515620100000262ff00001996151590940261ff00199515159590951661ffff19909116179390931793909316179055604080518a8152918616602083015281018890527fd271751b3fc329e4f543fc69c9f69c12b5152eabfe4f47a7661a397f7096c2159060600160405180910390a1610160830151604080518a81526001600160a01b03909216602083015281018290527fb8d756f2d1da4663767eb4d559780ace84f2f65a421a60ddcb47e8b2e5d2fd269060600160405180910390a15050505050505050565b858580156134e55750610180830151155b156134f1575083613551565b8580156135145750836001600160a01b03168361016001516001600160a01b0316145b156135255750610180820151613551565b851561355157613539828460c00151613872565b9050868111156135465750855b848110156135515750835b6101408301516040516323b872dd60e01b81526000906001600160a01b038316906323b872dd9061358a90899030908e90600401613fce565b6020604051808303816000875af11580156135a9573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906135cd9190613ff2565b90508061361c5760405162461bcd60e51b815260206004820181905260248201527f4661696c656420746f207472616e7366657220746f6b656e7320746f2062696460448201526064016104c5565b610180850151156136c05761016085015160405163a9059cbb60e01b81526001600160a01b039182166004820152602481018690529083169063a9059cbb906044016020604051808303816000875af115801561367d573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906136a19190613ff2565b9050806136c05760405162461bcd60e51b81526004016104c59061400f565b61018085018390526001600160a01b0386811661016087015260008b815260026020818152604092839020895181546001600160a01b031916951694909417845588015160018401559087015190820155606086015160038201556080860151600482015560a0860151600582015560c0860151600682015560e086015186919060078201906137509082614081565b50610100828101516008830180546001600160a01b039283166001600160a01b0319918216179091556101208501516009850155610140850151600a85018054918416918316919091179055610160850151600b8501805491841691909216179055610180840151600c8401556101a0840151600d90930180546101c08601516101e0909601511515620100000262ff00001996151590940261ff00199515159590951661ffff1990911617939093179390931617905560008b8152600660209081526040918290208c905581518d81529289169083015281018490527fb8d756f2d1da4663767eb4d559780ace84f2f65a421a60ddcb47e8b2e5d2fd269060600160405180910390a150505050505050505050565b60006127d182846141e0565b60006127d1836138986b033b2e3c9fd0803ce8000000613892878761399f565b906139ab565b90613866565b60008184106138ae5750826127d1565b6138b88285612fd5565b8311156138c65750806127d1565b6127ce8484613866565b606060006138e38484878054905061389e565b905060006138f18286612fd5565b67ffffffffffffffff81111561390957613909613b1e565b604051908082528060200260200182016040528015613932578160200160208202803683370190505b509050845b8281101561399557868181548110613951576139516141b7565b600091825260209091200154826139688389612fd5565b81518110613978576139786141b7565b60209081029190910101528061398d8161419e565b915050613937565b5095945050505050565b60006127d182846141f3565b60006127d1828461420a565b60405180610200016040528060006001600160a01b031681526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016060815260200160006001600160a01b031681526020016000815260200160006001600160a01b0316815260200160006001600160a01b03168152602001600081526020016000151581526020016000151581526020016000151581525090565b600060208284031215613a7057600080fd5b5035919050565b6001600160a01b0381168114611e4e57600080fd5b600060208284031215613a9e57600080fd5b81356127d181613a77565b8015158114611e4e57600080fd5b60008060408385031215613aca57600080fd5b8235613ad581613a77565b91506020830135613ae581613aa9565b809150509250929050565b60008060408385031215613b0357600080fd5b8235613b0e81613a77565b91506020830135613ae581613a77565b634e487b7160e01b600052604160045260246000fd5b6000806000806000806000806000806101408b8d031215613b5457600080fd5b613b5e8b35613a77565b8a35995060208b01359850613b7660408c0135613a77565b60408b0135975060608b0135965060808b0135955060a08b0135945060c08b0135935060e08b013592506101008b0135915067ffffffffffffffff806101208d01351115613bc357600080fd5b6101208c01358c018d601f820112613bda57600080fd5b8181351115613beb57613beb613b1e565b6040518135601f01601f19908116603f01168101908382118183101715613c1457613c14613b1e565b81604052823581528f602084358501011115613c2f57600080fd5b823560208401602083013760006020843583010152809450505050509295989b9194979a5092959850565b60008060408385031215613c6d57600080fd5b50508035926020909101359150565b634e487b7160e01b600052602160045260246000fd5b6020810160058310613cb457634e487b7160e01b600052602160045260246000fd5b91905290565b60008060008060008060c08789031215613cd357600080fd5b863595506020870135945060408701359350606087013560ff81168114613cf957600080fd5b9598949750929560808101359460a0909101359350915050565b6000815180845260005b81811015613d3957602081850181015186830182015201613d1d565b506000602082860101526020601f19601f83011685010191505092915050565b80516001600160a01b0316825260006102006020830151602085015260408301516040850152606083015160608501526080830151608085015260a083015160a085015260c083015160c085015260e08301518160e0860152613dbe82860182613d13565b91505061010080840151613ddc828701826001600160a01b03169052565b50506101208381015190850152610140808401516001600160a01b0390811691860191909152610160808501519091169085015261018080840151908501526101a0808401511515908501526101c0808401511515908501526101e092830151151592909301919091525090565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b82811015613e9f57603f19888603018452613e8d858351613d59565b94509285019290850190600101613e71565b5092979650505050505050565b600080600060608486031215613ec157600080fd5b8335613ecc81613a77565b95602085013595506040909401359392505050565b6020808252825182820181905260009190848201906040850190845b81811015613f1957835183529284019291840191600101613efd565b50909695505050505050565b6020815260006127d16020830184613d59565b60208082526017908201527f41756374696f6e206973206e6f742066696e6973686564000000000000000000604082015260600190565b600181811c90821680613f8357607f821691505b60208210810361257857634e487b7160e01b600052602260045260246000fd5b602080825260119082015270105d58dd1a5bdb881a5cc81c185d5cd959607a1b604082015260600190565b6001600160a01b039384168152919092166020820152604081019190915260600190565b60006020828403121561400457600080fd5b81516127d181613aa9565b6020808252601290820152714661696c656420746f20706179206261636b60701b604082015260600190565b601f82111561139157600081815260208120601f850160051c810160208610156140625750805b601f850160051c820191505b818110156123415782815560010161406e565b815167ffffffffffffffff81111561409b5761409b613b1e565b6140af816140a98454613f6f565b8461403b565b602080601f8311600181146140e457600084156140cc5750858301515b600019600386901b1c1916600185901b178555612341565b600085815260208120601f198616915b82811015614113578886015182559484019460019091019084016140f4565b50858210156141315787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b60208082526010908201526f24b9903737ba103a34329030b236b4b760811b604082015260600190565b60006020828403121561417d57600080fd5b81516127d181613a77565b634e487b7160e01b600052601160045260246000fd5b6000600182016141b0576141b0614188565b5060010190565b634e487b7160e01b600052603260045260246000fd5b81810381811115610d6a57610d6a614188565b80820180821115610d6a57610d6a614188565b8082028115828204841417610d6a57610d6a614188565b60008261422757634e487b7160e01b600052601260045260246000fd5b50049056fe0e60401031695311e5192456b98ba458c20ba883eb86b101645de6252c6e9dd3a26469706673582212204022bda9727b2976fafcb09a84bea8c8d14d3e7b7fb61df6d6d7ef3e76e5ebea64736f6c63430008150033",
+}
+
 // AuctionABI is the input ABI used to generate the binding from.
-const AuctionABI = "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_bidder\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"AuctionBid\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"AuctionClosed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_creator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_tokenAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_currencyAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"AuctionCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_winner\",\"type\":\"address\"}],\"name\":\"LotTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_creator\",\"type\":\"address\"}],\"name\":\"RepaymentTransferred\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"bid\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"buyNow\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"claimLot\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"claimRepayment\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"countOfAuctions\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_tokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_currencyAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_startPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_buyNowPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_startTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_duration\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_durationIncrement\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_bidIncrement\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"_description\",\"type\":\"string\"}],\"name\":\"createAuction\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"getAuctionInfo\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"startPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"buyNowPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"startTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"duration\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"durationIncrement\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bidIncrement\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"tokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"currencyAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"currentBidder\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"highestBid\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"lotBought\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"repaymentTransferred\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"lotTransferred\",\"type\":\"bool\"}],\"internalType\":\"structAuction.AuctionInfo\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"getRaisingBid\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"getStatus\",\"outputs\":[{\"internalType\":\"enumAuction.AuctionStatus\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_auctionId\",\"type\":\"uint256\"}],\"name\":\"regainLot\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+// Deprecated: Use AuctionMetaData.ABI instead.
+var AuctionABI = AuctionMetaData.ABI
 
 // AuctionBin is the compiled bytecode used for deploying new contracts.
-var AuctionBin = "0x608060405234801561001057600080fd5b50614cdd806100206000396000f3fe608060405234801561001057600080fd5b506004361061009d5760003560e01c8063598647f811610066578063598647f8146101445780635c622a0e14610160578063a216592014610190578063f2da0664146101c0578063fc3fc4ed146101dc5761009d565b8062d878e8146100a257806308a0f32f146100be5780631080f5c9146100da57806322a0119b146100f65780634bc28ede14610114575b600080fd5b6100bc60048036038101906100b7919061399a565b61020c565b005b6100d860048036038101906100d3919061399a565b61077e565b005b6100f460048036038101906100ef919061399a565b610ef3565b005b6100fe611602565b60405161010b91906142df565b60405180910390f35b61012e6004803603810190610129919061387e565b611608565b60405161013b91906142df565b60405180910390f35b61015e600480360381019061015991906139c3565b611e54565b005b61017a6004803603810190610175919061399a565b6125c3565b6040516101879190613fc2565b60405180910390f35b6101aa60048036038101906101a5919061399a565b61293a565b6040516101b791906142df565b60405180910390f35b6101da60048036038101906101d5919061399a565b612d18565b005b6101f660048036038101906101f1919061399a565b613225565b60405161020391906142bd565b60405180910390f35b8060036004811115610247577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b610250826125c3565b6004811115610288577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b146102c8576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016102bf90613ffd565b60405180910390fd5b600060016000848152602001908152602001600020604051806102000160405290816000820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200160018201548152602001600282015481526020016003820154815260200160048201548152602001600582015481526020016006820154815260200160078201805461038b906145a1565b80601f01602080910402602001604051908101604052809291908181526020018280546103b7906145a1565b80156104045780601f106103d957610100808354040283529160200191610404565b820191906000526020600020905b8154815290600101906020018083116103e757829003601f168201915b505050505081526020016008820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200160098201548152602001600a820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600b820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600c8201548152602001600d820160009054906101000a900460ff16151515158152602001600d820160019054906101000a900460ff16151515158152602001600d820160029054906101000a900460ff16151515158152505090503373ffffffffffffffffffffffffffffffffffffffff16816000015173ffffffffffffffffffffffffffffffffffffffff16146105e8576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016105df9061423d565b60405180910390fd5b806101c001511561062e576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610625906141bd565b60405180910390fd5b600081610140015173ffffffffffffffffffffffffffffffffffffffff1663a9059cbb83600001518461018001516040518363ffffffff1660e01b8152600401610679929190613f99565b602060405180830381600087803b15801561069357600080fd5b505af11580156106a7573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906106cb9190613971565b90508061070d576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016107049061429d565b60405180910390fd5b6001806000868152602001908152602001600020600d0160016101000a81548160ff0219169083151502179055507fcede1e8bc44e44a1be509c46755459a8fef702efbee4649158b5376942bc14b98483600001516040516107709291906142fa565b60405180910390a150505050565b80600260048111156107b9577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b6107c2826125c3565b60048111156107fa577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b1461083a576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016108319061405d565b60405180910390fd5b600060016000848152602001908152602001600020604051806102000160405290816000820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020016001820154815260200160028201548152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820180546108fd906145a1565b80601f0160208091040260200160405190810160405280929190818152602001828054610929906145a1565b80156109765780601f1061094b57610100808354040283529160200191610976565b820191906000526020600020905b81548152906001019060200180831161095957829003601f168201915b505050505081526020016008820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200160098201548152602001600a820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600b820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600c8201548152602001600d820160009054906101000a900460ff16151515158152602001600d820160019054906101000a900460ff16151515158152602001600d820160029054906101000a900460ff1615151515815250509050806101800151816040015111610b33576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610b2a9061413d565b60405180910390fd5b600081610140015173ffffffffffffffffffffffffffffffffffffffff166323b872dd333085604001516040518463ffffffff1660e01b8152600401610b7b93929190613f0f565b602060405180830381600087803b158015610b9557600080fd5b505af1158015610ba9573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610bcd9190613971565b905080610c0f576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610c069061429d565b60405180910390fd5b81610100015173ffffffffffffffffffffffffffffffffffffffff166323b872dd30338561012001516040518463ffffffff1660e01b8152600401610c5693929190613f0f565b600060405180830381600087803b158015610c7057600080fd5b505af1158015610c84573d6000803e3d6000fd5b505050506001826101a00190151590811515815250506001826101e0019015159081151581525050816001600086815260200190815260200160002060008201518160000160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff1602179055506020820151816001015560408201518160020155606082015181600301556080820151816004015560a0820151816005015560c0820151816006015560e0820151816007019080519060200190610d5f92919061361b565b506101008201518160080160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550610120820151816009015561014082015181600a0160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555061016082015181600b0160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555061018082015181600c01556101a082015181600d0160006101000a81548160ff0219169083151502179055506101c082015181600d0160016101000a81548160ff0219169083151502179055506101e082015181600d0160026101000a81548160ff0219169083151502179055509050507f0e60401031695311e5192456b98ba458c20ba883eb86b101645de6252c6e9dd38433604051610ee59291906142fa565b60405180910390a150505050565b8060036004811115610f2e577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b610f37826125c3565b6004811115610f6f577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b14610faf576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610fa690613ffd565b60405180910390fd5b600060016000848152602001908152602001600020604051806102000160405290816000820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600182015481526020016002820154815260200160038201548152602001600482015481526020016005820154815260200160068201548152602001600782018054611072906145a1565b80601f016020809104026020016040519081016040528092919081815260200182805461109e906145a1565b80156110eb5780601f106110c0576101008083540402835291602001916110eb565b820191906000526020600020905b8154815290600101906020018083116110ce57829003601f168201915b505050505081526020016008820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200160098201548152602001600a820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600b820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600c8201548152602001600d820160009054906101000a900460ff16151515158152602001600d820160019054906101000a900460ff16151515158152602001600d820160029054906101000a900460ff1615151515815250509050806000015173ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff16146112cf576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016112c6906141fd565b60405180910390fd5b600081610180015114611317576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161130e906140fd565b60405180910390fd5b80610100015173ffffffffffffffffffffffffffffffffffffffff166323b872dd3083600001518461012001516040518463ffffffff1660e01b815260040161136293929190613f0f565b600060405180830381600087803b15801561137c57600080fd5b505af1158015611390573d6000803e3d6000fd5b505050506001816101c00190151590811515815250506001816101e0019015159081151581525050806001600085815260200190815260200160002060008201518160000160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff1602179055506020820151816001015560408201518160020155606082015181600301556080820151816004015560a0820151816005015560c0820151816006015560e082015181600701908051906020019061146b92919061361b565b506101008201518160080160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550610120820151816009015561014082015181600a0160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555061016082015181600b0160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555061018082015181600c01556101a082015181600d0160006101000a81548160ff0219169083151502179055506101c082015181600d0160016101000a81548160ff0219169083151502179055506101e082015181600d0160026101000a81548160ff0219169083151502179055509050507f0e60401031695311e5192456b98ba458c20ba883eb86b101645de6252c6e9dd38382600001516040516115f59291906142fa565b60405180910390a1505050565b60005481565b60006116298b73ffffffffffffffffffffffffffffffffffffffff1661359c565b611668576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161165f9061421d565b60405180910390fd5b60008b90503373ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff16636352211e8d6040518263ffffffff1660e01b81526004016116bd91906142df565b60206040518083038186803b1580156116d557600080fd5b505afa1580156116e9573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061170d9190613855565b73ffffffffffffffffffffffffffffffffffffffff1614611763576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161175a9061403d565b60405180910390fd5b3073ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff1663081812fc8d6040518263ffffffff1660e01b81526004016117b391906142df565b60206040518083038186803b1580156117cb57600080fd5b505afa1580156117df573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906118039190613855565b73ffffffffffffffffffffffffffffffffffffffff1614611859576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016118509061419d565b60405180910390fd5b6118788a73ffffffffffffffffffffffffffffffffffffffff1661359c565b6118b7576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016118ae90613fdd565b60405180910390fd5b60008914156118fb576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016118f29061411d565b60405180910390fd5b8888101561193e576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016119359061417d565b60405180910390fd5b6000861415611982576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016119799061415d565b60405180910390fd5b60008514156119c6576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016119bd906140bd565b60405180910390fd5b8360001080156119dd57506119d96135af565b8411155b611a1c576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611a139061401d565b60405180910390fd5b8073ffffffffffffffffffffffffffffffffffffffff166323b872dd33308e6040518463ffffffff1660e01b8152600401611a5993929190613f0f565b600060405180830381600087803b158015611a7357600080fd5b505af1158015611a87573d6000803e3d6000fd5b50505050611a936136a1565b42881015611ad85742816060018181525050611aca611abb89426135c390919063ffffffff16565b886135c390919063ffffffff16565b816080018181525050611aed565b87816060018181525050868160800181815250505b33816000019073ffffffffffffffffffffffffffffffffffffffff16908173ffffffffffffffffffffffffffffffffffffffff16815250508c81610100019073ffffffffffffffffffffffffffffffffffffffff16908173ffffffffffffffffffffffffffffffffffffffff16815250508b816101200181815250508a81610140019073ffffffffffffffffffffffffffffffffffffffff16908173ffffffffffffffffffffffffffffffffffffffff16815250508981602001818152505088816040018181525050848160c0018181525050838160e00181905250600080549050816001600083815260200190815260200160002060008201518160000160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff1602179055506020820151816001015560408201518160020155606082015181600301556080820151816004015560a0820151816005015560c0820151816006015560e0820151816007019080519060200190611c8292919061361b565b506101008201518160080160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550610120820151816009015561014082015181600a0160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555061016082015181600b0160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555061018082015181600c01556101a082015181600d0160006101000a81548160ff0219169083151502179055506101c082015181600d0160016101000a81548160ff0219169083151502179055506101e082015181600d0160026101000a81548160ff021916908315150217905550905050600080815480929190611de990614604565b91905055507f03bb6e669c5d9d2143afb3599bda2cc92f483158549e37b474a6dc117f848b68826000015183610100015184610120015185610140015185604051611e38959493929190613f46565b60405180910390a18093505050509a9950505050505050505050565b611e5d8261293a565b811015611e9f576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611e969061407d565b60405180910390fd5b600060016000848152602001908152602001600020604051806102000160405290816000820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600182015481526020016002820154815260200160038201548152602001600482015481526020016005820154815260200160068201548152602001600782018054611f62906145a1565b80601f0160208091040260200160405190810160405280929190818152602001828054611f8e906145a1565b8015611fdb5780601f10611fb057610100808354040283529160200191611fdb565b820191906000526020600020905b815481529060010190602001808311611fbe57829003601f168201915b505050505081526020016008820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200160098201548152602001600a820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600b820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600c8201548152602001600d820160009054906101000a900460ff16151515158152602001600d820160019054906101000a900460ff16151515158152602001600d820160029054906101000a900460ff16151515158152505090506000816101400151905060008173ffffffffffffffffffffffffffffffffffffffff166323b872dd3330876040518463ffffffff1660e01b815260040161219693929190613f0f565b602060405180830381600087803b1580156121b057600080fd5b505af11580156121c4573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906121e89190613971565b90508061222a576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401612221906141dd565b60405180910390fd5b600083610180015114612311578173ffffffffffffffffffffffffffffffffffffffff1663a9059cbb8461016001518561018001516040518363ffffffff1660e01b815260040161227c929190613f99565b602060405180830381600087803b15801561229657600080fd5b505af11580156122aa573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906122ce9190613971565b905080612310576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016123079061409d565b60405180910390fd5b5b83836101800181815250503383610160019073ffffffffffffffffffffffffffffffffffffffff16908173ffffffffffffffffffffffffffffffffffffffff16815250506123708360a0015184608001516135d990919063ffffffff16565b836080018181525050826001600087815260200190815260200160002060008201518160000160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff1602179055506020820151816001015560408201518160020155606082015181600301556080820151816004015560a0820151816005015560c0820151816006015560e082015181600701908051906020019061242c92919061361b565b506101008201518160080160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550610120820151816009015561014082015181600a0160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555061016082015181600b0160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555061018082015181600c01556101a082015181600d0160006101000a81548160ff0219169083151502179055506101c082015181600d0160016101000a81548160ff0219169083151502179055506101e082015181600d0160026101000a81548160ff0219169083151502179055509050507fb8d756f2d1da4663767eb4d559780ace84f2f65a421a60ddcb47e8b2e5d2fd268533866040516125b493929190614323565b60405180910390a15050505050565b60008060016000848152602001908152602001600020604051806102000160405290816000820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600182015481526020016002820154815260200160038201548152602001600482015481526020016005820154815260200160068201548152602001600782018054612687906145a1565b80601f01602080910402602001604051908101604052809291908181526020018280546126b3906145a1565b80156127005780601f106126d557610100808354040283529160200191612700565b820191906000526020600020905b8154815290600101906020018083116126e357829003601f168201915b505050505081526020016008820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200160098201548152602001600a820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600b820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600c8201548152602001600d820160009054906101000a900460ff16151515158152602001600d820160019054906101000a900460ff16151515158152602001600d820160029054906101000a900460ff1615151515815250509050600073ffffffffffffffffffffffffffffffffffffffff16816000015173ffffffffffffffffffffffffffffffffffffffff1614156128b5576000915050612935565b806101c0015180156128c95750806101e001515b156128d8576004915050612935565b806101a00151156128ed576003915050612935565b8060600151421015612903576001915050612935565b61291e816080015182606001516135d990919063ffffffff16565b42101561292f576002915050612935565b60039150505b919050565b60008160026004811115612977577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b612980826125c3565b60048111156129b8577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b146129f8576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016129ef9061405d565b60405180910390fd5b600060016000858152602001908152602001600020604051806102000160405290816000820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600182015481526020016002820154815260200160038201548152602001600482015481526020016005820154815260200160068201548152602001600782018054612abb906145a1565b80601f0160208091040260200160405190810160405280929190818152602001828054612ae7906145a1565b8015612b345780601f10612b0957610100808354040283529160200191612b34565b820191906000526020600020905b815481529060010190602001808311612b1757829003601f168201915b505050505081526020016008820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200160098201548152602001600a820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600b820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600c8201548152602001600d820160009054906101000a900460ff16151515158152602001600d820160019054906101000a900460ff16151515158152602001600d820160029054906101000a900460ff161515151581525050905060008161018001511415612cc1578060200151925050612d12565b60008161018001519050612d0d81612cff612cda6135af565b612cf18660c00151866135ef90919063ffffffff16565b61360590919063ffffffff16565b6135d990919063ffffffff16565b935050505b50919050565b8060036004811115612d53577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b612d5c826125c3565b6004811115612d94577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b14612dd4576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401612dcb90613ffd565b60405180910390fd5b600060016000848152602001908152602001600020604051806102000160405290816000820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600182015481526020016002820154815260200160038201548152602001600482015481526020016005820154815260200160068201548152602001600782018054612e97906145a1565b80601f0160208091040260200160405190810160405280929190818152602001828054612ec3906145a1565b8015612f105780601f10612ee557610100808354040283529160200191612f10565b820191906000526020600020905b815481529060010190602001808311612ef357829003601f168201915b505050505081526020016008820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200160098201548152602001600a820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600b820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600c8201548152602001600d820160009054906101000a900460ff16151515158152602001600d820160019054906101000a900460ff16151515158152602001600d820160029054906101000a900460ff16151515158152505090503373ffffffffffffffffffffffffffffffffffffffff1681610160015173ffffffffffffffffffffffffffffffffffffffff16146130f5576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016130ec9061427d565b60405180910390fd5b806101e001511561313b576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016131329061425d565b60405180910390fd5b80610100015173ffffffffffffffffffffffffffffffffffffffff166323b872dd308361016001518461012001516040518463ffffffff1660e01b815260040161318793929190613f0f565b600060405180830381600087803b1580156131a157600080fd5b505af11580156131b5573d6000803e3d6000fd5b505050506001806000858152602001908152602001600020600d0160026101000a81548160ff0219169083151502179055507f0e60401031695311e5192456b98ba458c20ba883eb86b101645de6252c6e9dd383336040516132189291906142fa565b60405180910390a1505050565b61322d6136a1565b8160006004811115613268577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b613271826125c3565b60048111156132a9577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b14156132ea576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016132e1906140dd565b60405180910390fd5b60016000848152602001908152602001600020604051806102000160405290816000820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020016001820154815260200160028201548152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820180546133ab906145a1565b80601f01602080910402602001604051908101604052809291908181526020018280546133d7906145a1565b80156134245780601f106133f957610100808354040283529160200191613424565b820191906000526020600020905b81548152906001019060200180831161340757829003601f168201915b505050505081526020016008820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200160098201548152602001600a820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600b820160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001600c8201548152602001600d820160009054906101000a900460ff16151515158152602001600d820160019054906101000a900460ff16151515158152602001600d820160029054906101000a900460ff161515151581525050915050919050565b600080823b905060008111915050919050565b60006b033b2e3c9fd0803ce8000000905090565b600081836135d191906144be565b905092915050565b600081836135e791906143dd565b905092915050565b600081836135fd9190614464565b905092915050565b600081836136139190614433565b905092915050565b828054613627906145a1565b90600052602060002090601f0160209004810192826136495760008555613690565b82601f1061366257805160ff1916838001178555613690565b82800160010185558215613690579182015b8281111561368f578251825591602001919060010190613674565b5b50905061369d919061377c565b5090565b604051806102000160405280600073ffffffffffffffffffffffffffffffffffffffff16815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160608152602001600073ffffffffffffffffffffffffffffffffffffffff16815260200160008152602001600073ffffffffffffffffffffffffffffffffffffffff168152602001600073ffffffffffffffffffffffffffffffffffffffff168152602001600081526020016000151581526020016000151581526020016000151581525090565b5b8082111561379557600081600090555060010161377d565b5090565b60006137ac6137a78461437f565b61435a565b9050828152602081018484840111156137c457600080fd5b6137cf84828561455f565b509392505050565b6000813590506137e681614c62565b92915050565b6000815190506137fb81614c62565b92915050565b60008151905061381081614c79565b92915050565b600082601f83011261382757600080fd5b8135613837848260208601613799565b91505092915050565b60008135905061384f81614c90565b92915050565b60006020828403121561386757600080fd5b6000613875848285016137ec565b91505092915050565b6000806000806000806000806000806101408b8d03121561389e57600080fd5b60006138ac8d828e016137d7565b9a505060206138bd8d828e01613840565b99505060406138ce8d828e016137d7565b98505060606138df8d828e01613840565b97505060806138f08d828e01613840565b96505060a06139018d828e01613840565b95505060c06139128d828e01613840565b94505060e06139238d828e01613840565b9350506101006139358d828e01613840565b9250506101208b013567ffffffffffffffff81111561395357600080fd5b61395f8d828e01613816565b9150509295989b9194979a5092959850565b60006020828403121561398357600080fd5b600061399184828501613801565b91505092915050565b6000602082840312156139ac57600080fd5b60006139ba84828501613840565b91505092915050565b600080604083850312156139d657600080fd5b60006139e485828601613840565b92505060206139f585828601613840565b9150509250929050565b613a08816144f2565b82525050565b613a17816144f2565b82525050565b613a2681614504565b82525050565b613a358161454d565b82525050565b6000613a46826143b0565b613a5081856143bb565b9350613a6081856020860161456e565b613a6981614738565b840191505092915050565b6000613a816020836143cc565b9150613a8c82614749565b602082019050919050565b6000613aa46017836143cc565b9150613aaf82614772565b602082019050919050565b6000613ac76015836143cc565b9150613ad28261479b565b602082019050919050565b6000613aea6015836143cc565b9150613af5826147c4565b602082019050919050565b6000613b0d6015836143cc565b9150613b18826147ed565b602082019050919050565b6000613b306053836143cc565b9150613b3b82614816565b606082019050919050565b6000613b536012836143cc565b9150613b5e8261488b565b602082019050919050565b6000613b766019836143cc565b9150613b81826148b4565b602082019050919050565b6000613b996016836143cc565b9150613ba4826148dd565b602082019050919050565b6000613bbc602c836143cc565b9150613bc782614906565b604082019050919050565b6000613bdf6013836143cc565b9150613bea82614955565b602082019050919050565b6000613c026028836143cc565b9150613c0d8261497e565b604082019050919050565b6000613c256018836143cc565b9150613c30826149cd565b602082019050919050565b6000613c486033836143cc565b9150613c53826149f6565b604082019050919050565b6000613c6b6013836143cc565b9150613c7682614a45565b602082019050919050565b6000613c8e602a836143cc565b9150613c9982614a6e565b604082019050919050565b6000613cb16020836143cc565b9150613cbc82614abd565b602082019050919050565b6000613cd46024836143cc565b9150613cdf82614ae6565b604082019050919050565b6000613cf7601d836143cc565b9150613d0282614b35565b602082019050919050565b6000613d1a6023836143cc565b9150613d2582614b5e565b604082019050919050565b6000613d3d6024836143cc565b9150613d4882614bad565b604082019050919050565b6000613d60601a836143cc565b9150613d6b82614bfc565b602082019050919050565b6000613d836020836143cc565b9150613d8e82614c25565b602082019050919050565b600061020083016000830151613db260008601826139ff565b506020830151613dc56020860182613ef1565b506040830151613dd86040860182613ef1565b506060830151613deb6060860182613ef1565b506080830151613dfe6080860182613ef1565b5060a0830151613e1160a0860182613ef1565b5060c0830151613e2460c0860182613ef1565b5060e083015184820360e0860152613e3c8282613a3b565b915050610100830151613e536101008601826139ff565b50610120830151613e68610120860182613ef1565b50610140830151613e7d6101408601826139ff565b50610160830151613e926101608601826139ff565b50610180830151613ea7610180860182613ef1565b506101a0830151613ebc6101a0860182613a1d565b506101c0830151613ed16101c0860182613a1d565b506101e0830151613ee66101e0860182613a1d565b508091505092915050565b613efa81614543565b82525050565b613f0981614543565b82525050565b6000606082019050613f246000830186613a0e565b613f316020830185613a0e565b613f3e6040830184613f00565b949350505050565b600060a082019050613f5b6000830188613a0e565b613f686020830187613a0e565b613f756040830186613f00565b613f826060830185613a0e565b613f8f6080830184613f00565b9695505050505050565b6000604082019050613fae6000830185613a0e565b613fbb6020830184613f00565b9392505050565b6000602082019050613fd76000830184613a2c565b92915050565b60006020820190508181036000830152613ff681613a74565b9050919050565b6000602082019050818103600083015261401681613a97565b9050919050565b6000602082019050818103600083015261403681613aba565b9050919050565b6000602082019050818103600083015261405681613add565b9050919050565b6000602082019050818103600083015261407681613b00565b9050919050565b6000602082019050818103600083015261409681613b23565b9050919050565b600060208201905081810360008301526140b681613b46565b9050919050565b600060208201905081810360008301526140d681613b69565b9050919050565b600060208201905081810360008301526140f681613b8c565b9050919050565b6000602082019050818103600083015261411681613baf565b9050919050565b6000602082019050818103600083015261413681613bd2565b9050919050565b6000602082019050818103600083015261415681613bf5565b9050919050565b6000602082019050818103600083015261417681613c18565b9050919050565b6000602082019050818103600083015261419681613c3b565b9050919050565b600060208201905081810360008301526141b681613c5e565b9050919050565b600060208201905081810360008301526141d681613c81565b9050919050565b600060208201905081810360008301526141f681613ca4565b9050919050565b6000602082019050818103600083015261421681613cc7565b9050919050565b6000602082019050818103600083015261423681613cea565b9050919050565b6000602082019050818103600083015261425681613d0d565b9050919050565b6000602082019050818103600083015261427681613d30565b9050919050565b6000602082019050818103600083015261429681613d53565b9050919050565b600060208201905081810360008301526142b681613d76565b9050919050565b600060208201905081810360008301526142d78184613d99565b905092915050565b60006020820190506142f46000830184613f00565b92915050565b600060408201905061430f6000830185613f00565b61431c6020830184613a0e565b9392505050565b60006060820190506143386000830186613f00565b6143456020830185613a0e565b6143526040830184613f00565b949350505050565b6000614364614375565b905061437082826145d3565b919050565b6000604051905090565b600067ffffffffffffffff82111561439a57614399614709565b5b6143a382614738565b9050602081019050919050565b600081519050919050565b600082825260208201905092915050565b600082825260208201905092915050565b60006143e882614543565b91506143f383614543565b9250827fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff038211156144285761442761464d565b5b828201905092915050565b600061443e82614543565b915061444983614543565b9250826144595761445861467c565b5b828204905092915050565b600061446f82614543565b915061447a83614543565b9250817fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff04831182151516156144b3576144b261464d565b5b828202905092915050565b60006144c982614543565b91506144d483614543565b9250828210156144e7576144e661464d565b5b828203905092915050565b60006144fd82614523565b9050919050565b60008115159050919050565b600081905061451e82614c4e565b919050565b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b6000819050919050565b600061455882614510565b9050919050565b82818337600083830152505050565b60005b8381101561458c578082015181840152602081019050614571565b8381111561459b576000848401525b50505050565b600060028204905060018216806145b957607f821691505b602082108114156145cd576145cc6146da565b5b50919050565b6145dc82614738565b810181811067ffffffffffffffff821117156145fb576145fa614709565b5b80604052505050565b600061460f82614543565b91507fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8214156146425761464161464d565b5b600182019050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b6000601f19601f8301169050919050565b7f476976656e2063757272656e6379206973206e6f74206120636f6e7472616374600082015250565b7f41756374696f6e206973206e6f742066696e6973686564000000000000000000600082015250565b7f496e76616c69642062696420696e6372656d656e740000000000000000000000600082015250565b7f4973206e6f74206f776e6572206f662061737365740000000000000000000000600082015250565b7f41756374696f6e206973206e6f74206163746976650000000000000000000000600082015250565b7f42696420616d6f756e74206d757374206578636565642074686520686967686560008201527f73742062696420627920746865206d696e696d756d20696e6372656d656e742060208201527f70657263656e74616765206f72206d6f72652e00000000000000000000000000604082015250565b7f4661696c656420746f20706179206261636b0000000000000000000000000000600082015250565b7f496e76616c69642061756374696f6e20696e6372656d656e7400000000000000600082015250565b7f41756374696f6e20646f6573206e6f7420657869737400000000000000000000600082015250565b7f546865206c6f742062656c6f6e677320746f207468652077696e6e6572206f6660008201527f207468652061756374696f6e0000000000000000000000000000000000000000602082015250565b7f496e76616c696420737461727420707269636500000000000000000000000000600082015250565b7f427579696e6720696d6d6564696174656c79206973206e6f206c6f6e6765722060008201527f72656c6576616e74000000000000000000000000000000000000000000000000602082015250565b7f496e76616c69642061756374696f6e206475726174696f6e0000000000000000600082015250565b7f427579206e6f772070726963652073686f756c6420686967686572206f72206560008201527f7175616c20746f20737461727420707269636500000000000000000000000000602082015250565b7f4c6f74206973206e6f7420617070726f76656400000000000000000000000000600082015250565b7f5468652072657061796d656e742068617320616c7265616479206265656e207460008201527f72616e7366657272656400000000000000000000000000000000000000000000602082015250565b7f4661696c656420746f207472616e7366657220746f6b656e7320746f20626964600082015250565b7f5468652073656e646572206973206e6f7420616e2061756374696f6e2063726560008201527f61746f7200000000000000000000000000000000000000000000000000000000602082015250565b7f476976656e20746f6b656e206973206e6f74206120636f6e7472616374000000600082015250565b7f5468652053656e646572206973206e6f7420612061756374696f6e206372656160008201527f746f720000000000000000000000000000000000000000000000000000000000602082015250565b7f546865206c6f742068617320616c7265616479206265656e207472616e73666560008201527f7272656400000000000000000000000000000000000000000000000000000000602082015250565b7f5468652073656e646572206973206e6f7420612077696e6e6572000000000000600082015250565b7f4661696c656420746f207472616e73666572207468652072657061796d656e74600082015250565b60058110614c5f57614c5e6146ab565b5b50565b614c6b816144f2565b8114614c7657600080fd5b50565b614c8281614504565b8114614c8d57600080fd5b50565b614c9981614543565b8114614ca457600080fd5b5056fea2646970667358221220dc5a199c70bda089adff38d892607f35e5eabb3ff5bb358be67d870feacba91764736f6c63430008030033"
+// Deprecated: Use AuctionMetaData.Bin instead.
+var AuctionBin = AuctionMetaData.Bin
 
 // DeployAuction deploys a new Ethereum contract, binding an instance of Auction to it.
-func DeployAuction(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Auction, error) {
-	parsed, err := abi.JSON(strings.NewReader(AuctionABI))
+func DeployAuction(auth *bind.TransactOpts, backend bind.ContractBackend, _trustedForwarder common.Address) (common.Address, *types.Transaction, *Auction, error) {
+	parsed, err := AuctionMetaData.GetAbi()
 	if err != nil {
 		return common.Address{}, nil, nil, err
 	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
 
-	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(AuctionBin), backend)
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(AuctionBin), backend, _trustedForwarder)
 	if err != nil {
 		return common.Address{}, nil, nil, err
 	}
@@ -239,6 +252,99 @@ func (_Auction *AuctionCallerSession) CountOfAuctions() (*big.Int, error) {
 	return _Auction.Contract.CountOfAuctions(&_Auction.CallOpts)
 }
 
+// CountOfBidderAuctions is a free data retrieval call binding the contract method 0x490abbd0.
+//
+// Solidity: function countOfBidderAuctions(address _bidder) view returns(uint256)
+func (_Auction *AuctionCaller) CountOfBidderAuctions(opts *bind.CallOpts, _bidder common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "countOfBidderAuctions", _bidder)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CountOfBidderAuctions is a free data retrieval call binding the contract method 0x490abbd0.
+//
+// Solidity: function countOfBidderAuctions(address _bidder) view returns(uint256)
+func (_Auction *AuctionSession) CountOfBidderAuctions(_bidder common.Address) (*big.Int, error) {
+	return _Auction.Contract.CountOfBidderAuctions(&_Auction.CallOpts, _bidder)
+}
+
+// CountOfBidderAuctions is a free data retrieval call binding the contract method 0x490abbd0.
+//
+// Solidity: function countOfBidderAuctions(address _bidder) view returns(uint256)
+func (_Auction *AuctionCallerSession) CountOfBidderAuctions(_bidder common.Address) (*big.Int, error) {
+	return _Auction.Contract.CountOfBidderAuctions(&_Auction.CallOpts, _bidder)
+}
+
+// CountOfCreatorAuctions is a free data retrieval call binding the contract method 0x302619d1.
+//
+// Solidity: function countOfCreatorAuctions(address _creator) view returns(uint256)
+func (_Auction *AuctionCaller) CountOfCreatorAuctions(opts *bind.CallOpts, _creator common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "countOfCreatorAuctions", _creator)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CountOfCreatorAuctions is a free data retrieval call binding the contract method 0x302619d1.
+//
+// Solidity: function countOfCreatorAuctions(address _creator) view returns(uint256)
+func (_Auction *AuctionSession) CountOfCreatorAuctions(_creator common.Address) (*big.Int, error) {
+	return _Auction.Contract.CountOfCreatorAuctions(&_Auction.CallOpts, _creator)
+}
+
+// CountOfCreatorAuctions is a free data retrieval call binding the contract method 0x302619d1.
+//
+// Solidity: function countOfCreatorAuctions(address _creator) view returns(uint256)
+func (_Auction *AuctionCallerSession) CountOfCreatorAuctions(_creator common.Address) (*big.Int, error) {
+	return _Auction.Contract.CountOfCreatorAuctions(&_Auction.CallOpts, _creator)
+}
+
+// GetAdmin is a free data retrieval call binding the contract method 0x6e9960c3.
+//
+// Solidity: function getAdmin() view returns(address)
+func (_Auction *AuctionCaller) GetAdmin(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "getAdmin")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetAdmin is a free data retrieval call binding the contract method 0x6e9960c3.
+//
+// Solidity: function getAdmin() view returns(address)
+func (_Auction *AuctionSession) GetAdmin() (common.Address, error) {
+	return _Auction.Contract.GetAdmin(&_Auction.CallOpts)
+}
+
+// GetAdmin is a free data retrieval call binding the contract method 0x6e9960c3.
+//
+// Solidity: function getAdmin() view returns(address)
+func (_Auction *AuctionCallerSession) GetAdmin() (common.Address, error) {
+	return _Auction.Contract.GetAdmin(&_Auction.CallOpts)
+}
+
 // GetAuctionInfo is a free data retrieval call binding the contract method 0xfc3fc4ed.
 //
 // Solidity: function getAuctionInfo(uint256 _auctionId) view returns((address,uint256,uint256,uint256,uint256,uint256,uint256,string,address,uint256,address,address,uint256,bool,bool,bool))
@@ -270,6 +376,130 @@ func (_Auction *AuctionCallerSession) GetAuctionInfo(_auctionId *big.Int) (Aucti
 	return _Auction.Contract.GetAuctionInfo(&_Auction.CallOpts, _auctionId)
 }
 
+// GetAuctions is a free data retrieval call binding the contract method 0xceb6a22f.
+//
+// Solidity: function getAuctions(uint256 _offset, uint256 _limit) view returns((address,uint256,uint256,uint256,uint256,uint256,uint256,string,address,uint256,address,address,uint256,bool,bool,bool)[])
+func (_Auction *AuctionCaller) GetAuctions(opts *bind.CallOpts, _offset *big.Int, _limit *big.Int) ([]AuctionAuctionInfo, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "getAuctions", _offset, _limit)
+
+	if err != nil {
+		return *new([]AuctionAuctionInfo), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]AuctionAuctionInfo)).(*[]AuctionAuctionInfo)
+
+	return out0, err
+
+}
+
+// GetAuctions is a free data retrieval call binding the contract method 0xceb6a22f.
+//
+// Solidity: function getAuctions(uint256 _offset, uint256 _limit) view returns((address,uint256,uint256,uint256,uint256,uint256,uint256,string,address,uint256,address,address,uint256,bool,bool,bool)[])
+func (_Auction *AuctionSession) GetAuctions(_offset *big.Int, _limit *big.Int) ([]AuctionAuctionInfo, error) {
+	return _Auction.Contract.GetAuctions(&_Auction.CallOpts, _offset, _limit)
+}
+
+// GetAuctions is a free data retrieval call binding the contract method 0xceb6a22f.
+//
+// Solidity: function getAuctions(uint256 _offset, uint256 _limit) view returns((address,uint256,uint256,uint256,uint256,uint256,uint256,string,address,uint256,address,address,uint256,bool,bool,bool)[])
+func (_Auction *AuctionCallerSession) GetAuctions(_offset *big.Int, _limit *big.Int) ([]AuctionAuctionInfo, error) {
+	return _Auction.Contract.GetAuctions(&_Auction.CallOpts, _offset, _limit)
+}
+
+// GetBidderAuctions is a free data retrieval call binding the contract method 0xd999e5d4.
+//
+// Solidity: function getBidderAuctions(address _bidder, uint256 _offset, uint256 _limit) view returns(uint256[])
+func (_Auction *AuctionCaller) GetBidderAuctions(opts *bind.CallOpts, _bidder common.Address, _offset *big.Int, _limit *big.Int) ([]*big.Int, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "getBidderAuctions", _bidder, _offset, _limit)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetBidderAuctions is a free data retrieval call binding the contract method 0xd999e5d4.
+//
+// Solidity: function getBidderAuctions(address _bidder, uint256 _offset, uint256 _limit) view returns(uint256[])
+func (_Auction *AuctionSession) GetBidderAuctions(_bidder common.Address, _offset *big.Int, _limit *big.Int) ([]*big.Int, error) {
+	return _Auction.Contract.GetBidderAuctions(&_Auction.CallOpts, _bidder, _offset, _limit)
+}
+
+// GetBidderAuctions is a free data retrieval call binding the contract method 0xd999e5d4.
+//
+// Solidity: function getBidderAuctions(address _bidder, uint256 _offset, uint256 _limit) view returns(uint256[])
+func (_Auction *AuctionCallerSession) GetBidderAuctions(_bidder common.Address, _offset *big.Int, _limit *big.Int) ([]*big.Int, error) {
+	return _Auction.Contract.GetBidderAuctions(&_Auction.CallOpts, _bidder, _offset, _limit)
+}
+
+// GetCreatorAuctions is a free data retrieval call binding the contract method 0xd1fa406b.
+//
+// Solidity: function getCreatorAuctions(address _creator, uint256 _offset, uint256 _limit) view returns(uint256[])
+func (_Auction *AuctionCaller) GetCreatorAuctions(opts *bind.CallOpts, _creator common.Address, _offset *big.Int, _limit *big.Int) ([]*big.Int, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "getCreatorAuctions", _creator, _offset, _limit)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetCreatorAuctions is a free data retrieval call binding the contract method 0xd1fa406b.
+//
+// Solidity: function getCreatorAuctions(address _creator, uint256 _offset, uint256 _limit) view returns(uint256[])
+func (_Auction *AuctionSession) GetCreatorAuctions(_creator common.Address, _offset *big.Int, _limit *big.Int) ([]*big.Int, error) {
+	return _Auction.Contract.GetCreatorAuctions(&_Auction.CallOpts, _creator, _offset, _limit)
+}
+
+// GetCreatorAuctions is a free data retrieval call binding the contract method 0xd1fa406b.
+//
+// Solidity: function getCreatorAuctions(address _creator, uint256 _offset, uint256 _limit) view returns(uint256[])
+func (_Auction *AuctionCallerSession) GetCreatorAuctions(_creator common.Address, _offset *big.Int, _limit *big.Int) ([]*big.Int, error) {
+	return _Auction.Contract.GetCreatorAuctions(&_Auction.CallOpts, _creator, _offset, _limit)
+}
+
+// GetMaxBid is a free data retrieval call binding the contract method 0x617fbce9.
+//
+// Solidity: function getMaxBid(uint256 _auctionId) view returns(uint256)
+func (_Auction *AuctionCaller) GetMaxBid(opts *bind.CallOpts, _auctionId *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "getMaxBid", _auctionId)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMaxBid is a free data retrieval call binding the contract method 0x617fbce9.
+//
+// Solidity: function getMaxBid(uint256 _auctionId) view returns(uint256)
+func (_Auction *AuctionSession) GetMaxBid(_auctionId *big.Int) (*big.Int, error) {
+	return _Auction.Contract.GetMaxBid(&_Auction.CallOpts, _auctionId)
+}
+
+// GetMaxBid is a free data retrieval call binding the contract method 0x617fbce9.
+//
+// Solidity: function getMaxBid(uint256 _auctionId) view returns(uint256)
+func (_Auction *AuctionCallerSession) GetMaxBid(_auctionId *big.Int) (*big.Int, error) {
+	return _Auction.Contract.GetMaxBid(&_Auction.CallOpts, _auctionId)
+}
+
 // GetRaisingBid is a free data retrieval call binding the contract method 0xa2165920.
 //
 // Solidity: function getRaisingBid(uint256 _auctionId) view returns(uint256)
@@ -332,6 +562,130 @@ func (_Auction *AuctionCallerSession) GetStatus(_auctionId *big.Int) (uint8, err
 	return _Auction.Contract.GetStatus(&_Auction.CallOpts, _auctionId)
 }
 
+// GetTrustedForwarder is a free data retrieval call binding the contract method 0xce1b815f.
+//
+// Solidity: function getTrustedForwarder() view returns(address)
+func (_Auction *AuctionCaller) GetTrustedForwarder(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "getTrustedForwarder")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetTrustedForwarder is a free data retrieval call binding the contract method 0xce1b815f.
+//
+// Solidity: function getTrustedForwarder() view returns(address)
+func (_Auction *AuctionSession) GetTrustedForwarder() (common.Address, error) {
+	return _Auction.Contract.GetTrustedForwarder(&_Auction.CallOpts)
+}
+
+// GetTrustedForwarder is a free data retrieval call binding the contract method 0xce1b815f.
+//
+// Solidity: function getTrustedForwarder() view returns(address)
+func (_Auction *AuctionCallerSession) GetTrustedForwarder() (common.Address, error) {
+	return _Auction.Contract.GetTrustedForwarder(&_Auction.CallOpts)
+}
+
+// IsGuardian is a free data retrieval call binding the contract method 0x0c68ba21.
+//
+// Solidity: function isGuardian(address _account) view returns(bool)
+func (_Auction *AuctionCaller) IsGuardian(opts *bind.CallOpts, _account common.Address) (bool, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "isGuardian", _account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsGuardian is a free data retrieval call binding the contract method 0x0c68ba21.
+//
+// Solidity: function isGuardian(address _account) view returns(bool)
+func (_Auction *AuctionSession) IsGuardian(_account common.Address) (bool, error) {
+	return _Auction.Contract.IsGuardian(&_Auction.CallOpts, _account)
+}
+
+// IsGuardian is a free data retrieval call binding the contract method 0x0c68ba21.
+//
+// Solidity: function isGuardian(address _account) view returns(bool)
+func (_Auction *AuctionCallerSession) IsGuardian(_account common.Address) (bool, error) {
+	return _Auction.Contract.IsGuardian(&_Auction.CallOpts, _account)
+}
+
+// IsTrustedForwarder is a free data retrieval call binding the contract method 0x572b6c05.
+//
+// Solidity: function isTrustedForwarder(address _forwarder) view returns(bool)
+func (_Auction *AuctionCaller) IsTrustedForwarder(opts *bind.CallOpts, _forwarder common.Address) (bool, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "isTrustedForwarder", _forwarder)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsTrustedForwarder is a free data retrieval call binding the contract method 0x572b6c05.
+//
+// Solidity: function isTrustedForwarder(address _forwarder) view returns(bool)
+func (_Auction *AuctionSession) IsTrustedForwarder(_forwarder common.Address) (bool, error) {
+	return _Auction.Contract.IsTrustedForwarder(&_Auction.CallOpts, _forwarder)
+}
+
+// IsTrustedForwarder is a free data retrieval call binding the contract method 0x572b6c05.
+//
+// Solidity: function isTrustedForwarder(address _forwarder) view returns(bool)
+func (_Auction *AuctionCallerSession) IsTrustedForwarder(_forwarder common.Address) (bool, error) {
+	return _Auction.Contract.IsTrustedForwarder(&_Auction.CallOpts, _forwarder)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_Auction *AuctionCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_Auction *AuctionSession) Paused() (bool, error) {
+	return _Auction.Contract.Paused(&_Auction.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_Auction *AuctionCallerSession) Paused() (bool, error) {
+	return _Auction.Contract.Paused(&_Auction.CallOpts)
+}
+
 // Bid is a paid mutator transaction binding the contract method 0x598647f8.
 //
 // Solidity: function bid(uint256 _auctionId, uint256 _amount) returns()
@@ -353,6 +707,48 @@ func (_Auction *AuctionTransactorSession) Bid(_auctionId *big.Int, _amount *big.
 	return _Auction.Contract.Bid(&_Auction.TransactOpts, _auctionId, _amount)
 }
 
+// BidMax is a paid mutator transaction binding the contract method 0x93923d2e.
+//
+// Solidity: function bidMax(uint256 _auctionId, uint256 _maxAmount) returns()
+func (_Auction *AuctionTransactor) BidMax(opts *bind.TransactOpts, _auctionId *big.Int, _maxAmount *big.Int) (*types.Transaction, error) {
+	return _Auction.contract.Transact(opts, "bidMax", _auctionId, _maxAmount)
+}
+
+// BidMax is a paid mutator transaction binding the contract method 0x93923d2e.
+//
+// Solidity: function bidMax(uint256 _auctionId, uint256 _maxAmount) returns()
+func (_Auction *AuctionSession) BidMax(_auctionId *big.Int, _maxAmount *big.Int) (*types.Transaction, error) {
+	return _Auction.Contract.BidMax(&_Auction.TransactOpts, _auctionId, _maxAmount)
+}
+
+// BidMax is a paid mutator transaction binding the contract method 0x93923d2e.
+//
+// Solidity: function bidMax(uint256 _auctionId, uint256 _maxAmount) returns()
+func (_Auction *AuctionTransactorSession) BidMax(_auctionId *big.Int, _maxAmount *big.Int) (*types.Transaction, error) {
+	return _Auction.Contract.BidMax(&_Auction.TransactOpts, _auctionId, _maxAmount)
+}
+
+// BidWithPermit is a paid mutator transaction binding the contract method 0x92496337.
+//
+// Solidity: function bidWithPermit(uint256 _auctionId, uint256 _amount, uint256 _deadline, uint8 _v, bytes32 _r, bytes32 _s) returns()
+func (_Auction *AuctionTransactor) BidWithPermit(opts *bind.TransactOpts, _auctionId *big.Int, _amount *big.Int, _deadline *big.Int, _v uint8, _r [32]byte, _s [32]byte) (*types.Transaction, error) {
+	return _Auction.contract.Transact(opts, "bidWithPermit", _auctionId, _amount, _deadline, _v, _r, _s)
+}
+
+// BidWithPermit is a paid mutator transaction binding the contract method 0x92496337.
+//
+// Solidity: function bidWithPermit(uint256 _auctionId, uint256 _amount, uint256 _deadline, uint8 _v, bytes32 _r, bytes32 _s) returns()
+func (_Auction *AuctionSession) BidWithPermit(_auctionId *big.Int, _amount *big.Int, _deadline *big.Int, _v uint8, _r [32]byte, _s [32]byte) (*types.Transaction, error) {
+	return _Auction.Contract.BidWithPermit(&_Auction.TransactOpts, _auctionId, _amount, _deadline, _v, _r, _s)
+}
+
+// BidWithPermit is a paid mutator transaction binding the contract method 0x92496337.
+//
+// Solidity: function bidWithPermit(uint256 _auctionId, uint256 _amount, uint256 _deadline, uint8 _v, bytes32 _r, bytes32 _s) returns()
+func (_Auction *AuctionTransactorSession) BidWithPermit(_auctionId *big.Int, _amount *big.Int, _deadline *big.Int, _v uint8, _r [32]byte, _s [32]byte) (*types.Transaction, error) {
+	return _Auction.Contract.BidWithPermit(&_Auction.TransactOpts, _auctionId, _amount, _deadline, _v, _r, _s)
+}
+
 // BuyNow is a paid mutator transaction binding the contract method 0x08a0f32f.
 //
 // Solidity: function buyNow(uint256 _auctionId) returns()
@@ -430,37 +826,998 @@ func (_Auction *AuctionSession) CreateAuction(_tokenAddress common.Address, _tok
 	return _Auction.Contract.CreateAuction(&_Auction.TransactOpts, _tokenAddress, _tokenId, _currencyAddress, _startPrice, _buyNowPrice, _startTime, _duration, _durationIncrement, _bidIncrement, _description)
 }
 
-// CreateAuction is a paid mutator transaction binding the contract method 0x4bc28ede.
-//
-// Solidity: function createAuction(address _tokenAddress, uint256 _tokenId, address _currencyAddress, uint256 _startPrice, uint256 _buyNowPrice, uint256 _startTime, uint256 _duration, uint256 _durationIncrement, uint256 _bidIncrement, string _description) returns(uint256)
-func (_Auction *AuctionTransactorSession) CreateAuction(_tokenAddress common.Address, _tokenId *big.Int, _currencyAddress common.Address, _startPrice *big.Int, _buyNowPrice *big.Int, _startTime *big.Int, _duration *big.Int, _durationIncrement *big.Int, _bidIncrement *big.Int, _description string) (*types.Transaction, error) {
-	return _Auction.Contract.CreateAuction(&_Auction.TransactOpts, _tokenAddress, _tokenId, _currencyAddress, _startPrice, _buyNowPrice, _startTime, _duration, _durationIncrement, _bidIncrement, _description)
+// CreateAuction is a paid mutator transaction binding the contract method 0x4bc28ede.
+//
+// Solidity: function createAuction(address _tokenAddress, uint256 _tokenId, address _currencyAddress, uint256 _startPrice, uint256 _buyNowPrice, uint256 _startTime, uint256 _duration, uint256 _durationIncrement, uint256 _bidIncrement, string _description) returns(uint256)
+func (_Auction *AuctionTransactorSession) CreateAuction(_tokenAddress common.Address, _tokenId *big.Int, _currencyAddress common.Address, _startPrice *big.Int, _buyNowPrice *big.Int, _startTime *big.Int, _duration *big.Int, _durationIncrement *big.Int, _bidIncrement *big.Int, _description string) (*types.Transaction, error) {
+	return _Auction.Contract.CreateAuction(&_Auction.TransactOpts, _tokenAddress, _tokenId, _currencyAddress, _startPrice, _buyNowPrice, _startTime, _duration, _durationIncrement, _bidIncrement, _description)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x485cc955.
+//
+// Solidity: function initialize(address _trustedForwarder, address _admin) returns()
+func (_Auction *AuctionTransactor) Initialize(opts *bind.TransactOpts, _trustedForwarder common.Address, _admin common.Address) (*types.Transaction, error) {
+	return _Auction.contract.Transact(opts, "initialize", _trustedForwarder, _admin)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x485cc955.
+//
+// Solidity: function initialize(address _trustedForwarder, address _admin) returns()
+func (_Auction *AuctionSession) Initialize(_trustedForwarder common.Address, _admin common.Address) (*types.Transaction, error) {
+	return _Auction.Contract.Initialize(&_Auction.TransactOpts, _trustedForwarder, _admin)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x485cc955.
+//
+// Solidity: function initialize(address _trustedForwarder, address _admin) returns()
+func (_Auction *AuctionTransactorSession) Initialize(_trustedForwarder common.Address, _admin common.Address) (*types.Transaction, error) {
+	return _Auction.Contract.Initialize(&_Auction.TransactOpts, _trustedForwarder, _admin)
+}
+
+// InitializeAdmin is a paid mutator transaction binding the contract method 0x7553ee32.
+//
+// Solidity: function initializeAdmin(address _admin) returns()
+func (_Auction *AuctionTransactor) InitializeAdmin(opts *bind.TransactOpts, _admin common.Address) (*types.Transaction, error) {
+	return _Auction.contract.Transact(opts, "initializeAdmin", _admin)
+}
+
+// InitializeAdmin is a paid mutator transaction binding the contract method 0x7553ee32.
+//
+// Solidity: function initializeAdmin(address _admin) returns()
+func (_Auction *AuctionSession) InitializeAdmin(_admin common.Address) (*types.Transaction, error) {
+	return _Auction.Contract.InitializeAdmin(&_Auction.TransactOpts, _admin)
+}
+
+// InitializeAdmin is a paid mutator transaction binding the contract method 0x7553ee32.
+//
+// Solidity: function initializeAdmin(address _admin) returns()
+func (_Auction *AuctionTransactorSession) InitializeAdmin(_admin common.Address) (*types.Transaction, error) {
+	return _Auction.Contract.InitializeAdmin(&_Auction.TransactOpts, _admin)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_Auction *AuctionTransactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Auction.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_Auction *AuctionSession) Pause() (*types.Transaction, error) {
+	return _Auction.Contract.Pause(&_Auction.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_Auction *AuctionTransactorSession) Pause() (*types.Transaction, error) {
+	return _Auction.Contract.Pause(&_Auction.TransactOpts)
+}
+
+// RegainLot is a paid mutator transaction binding the contract method 0x1080f5c9.
+//
+// Solidity: function regainLot(uint256 _auctionId) returns()
+func (_Auction *AuctionTransactor) RegainLot(opts *bind.TransactOpts, _auctionId *big.Int) (*types.Transaction, error) {
+	return _Auction.contract.Transact(opts, "regainLot", _auctionId)
+}
+
+// RegainLot is a paid mutator transaction binding the contract method 0x1080f5c9.
+//
+// Solidity: function regainLot(uint256 _auctionId) returns()
+func (_Auction *AuctionSession) RegainLot(_auctionId *big.Int) (*types.Transaction, error) {
+	return _Auction.Contract.RegainLot(&_Auction.TransactOpts, _auctionId)
+}
+
+// RegainLot is a paid mutator transaction binding the contract method 0x1080f5c9.
+//
+// Solidity: function regainLot(uint256 _auctionId) returns()
+func (_Auction *AuctionTransactorSession) RegainLot(_auctionId *big.Int) (*types.Transaction, error) {
+	return _Auction.Contract.RegainLot(&_Auction.TransactOpts, _auctionId)
+}
+
+// SetGuardian is a paid mutator transaction binding the contract method 0x2b8a1c5a.
+//
+// Solidity: function setGuardian(address _guardian, bool _isGuardian) returns()
+func (_Auction *AuctionTransactor) SetGuardian(opts *bind.TransactOpts, _guardian common.Address, _isGuardian bool) (*types.Transaction, error) {
+	return _Auction.contract.Transact(opts, "setGuardian", _guardian, _isGuardian)
+}
+
+// SetGuardian is a paid mutator transaction binding the contract method 0x2b8a1c5a.
+//
+// Solidity: function setGuardian(address _guardian, bool _isGuardian) returns()
+func (_Auction *AuctionSession) SetGuardian(_guardian common.Address, _isGuardian bool) (*types.Transaction, error) {
+	return _Auction.Contract.SetGuardian(&_Auction.TransactOpts, _guardian, _isGuardian)
+}
+
+// SetGuardian is a paid mutator transaction binding the contract method 0x2b8a1c5a.
+//
+// Solidity: function setGuardian(address _guardian, bool _isGuardian) returns()
+func (_Auction *AuctionTransactorSession) SetGuardian(_guardian common.Address, _isGuardian bool) (*types.Transaction, error) {
+	return _Auction.Contract.SetGuardian(&_Auction.TransactOpts, _guardian, _isGuardian)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x8df82800.
+//
+// Solidity: function settle(uint256 _auctionId) returns()
+func (_Auction *AuctionTransactor) Settle(opts *bind.TransactOpts, _auctionId *big.Int) (*types.Transaction, error) {
+	return _Auction.contract.Transact(opts, "settle", _auctionId)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x8df82800.
+//
+// Solidity: function settle(uint256 _auctionId) returns()
+func (_Auction *AuctionSession) Settle(_auctionId *big.Int) (*types.Transaction, error) {
+	return _Auction.Contract.Settle(&_Auction.TransactOpts, _auctionId)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x8df82800.
+//
+// Solidity: function settle(uint256 _auctionId) returns()
+func (_Auction *AuctionTransactorSession) Settle(_auctionId *big.Int) (*types.Transaction, error) {
+	return _Auction.Contract.Settle(&_Auction.TransactOpts, _auctionId)
+}
+
+// TransferAdmin is a paid mutator transaction binding the contract method 0x75829def.
+//
+// Solidity: function transferAdmin(address _newAdmin) returns()
+func (_Auction *AuctionTransactor) TransferAdmin(opts *bind.TransactOpts, _newAdmin common.Address) (*types.Transaction, error) {
+	return _Auction.contract.Transact(opts, "transferAdmin", _newAdmin)
+}
+
+// TransferAdmin is a paid mutator transaction binding the contract method 0x75829def.
+//
+// Solidity: function transferAdmin(address _newAdmin) returns()
+func (_Auction *AuctionSession) TransferAdmin(_newAdmin common.Address) (*types.Transaction, error) {
+	return _Auction.Contract.TransferAdmin(&_Auction.TransactOpts, _newAdmin)
+}
+
+// TransferAdmin is a paid mutator transaction binding the contract method 0x75829def.
+//
+// Solidity: function transferAdmin(address _newAdmin) returns()
+func (_Auction *AuctionTransactorSession) TransferAdmin(_newAdmin common.Address) (*types.Transaction, error) {
+	return _Auction.Contract.TransferAdmin(&_Auction.TransactOpts, _newAdmin)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_Auction *AuctionTransactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Auction.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_Auction *AuctionSession) Unpause() (*types.Transaction, error) {
+	return _Auction.Contract.Unpause(&_Auction.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_Auction *AuctionTransactorSession) Unpause() (*types.Transaction, error) {
+	return _Auction.Contract.Unpause(&_Auction.TransactOpts)
+}
+
+// AuctionAdminTransferredIterator is returned from FilterAdminTransferred and is used to iterate over the raw logs and unpacked data for AdminTransferred events raised by the Auction contract.
+type AuctionAdminTransferredIterator struct {
+	Event *AuctionAdminTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionAdminTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionAdminTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionAdminTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionAdminTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionAdminTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionAdminTransferred represents a AdminTransferred event raised by the Auction contract.
+type AuctionAdminTransferred struct {
+	PreviousAdmin common.Address
+	NewAdmin      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterAdminTransferred is a free log retrieval operation binding the contract event 0xf8ccb027dfcd135e000e9d45e6cc2d662578a8825d4c45b5e32e0adf67e79ec6.
+//
+// Solidity: event AdminTransferred(address _previousAdmin, address _newAdmin)
+func (_Auction *AuctionFilterer) FilterAdminTransferred(opts *bind.FilterOpts) (*AuctionAdminTransferredIterator, error) {
+
+	logs, sub, err := _Auction.contract.FilterLogs(opts, "AdminTransferred")
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionAdminTransferredIterator{contract: _Auction.contract, event: "AdminTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchAdminTransferred is a free log subscription operation binding the contract event 0xf8ccb027dfcd135e000e9d45e6cc2d662578a8825d4c45b5e32e0adf67e79ec6.
+//
+// Solidity: event AdminTransferred(address _previousAdmin, address _newAdmin)
+func (_Auction *AuctionFilterer) WatchAdminTransferred(opts *bind.WatchOpts, sink chan<- *AuctionAdminTransferred) (event.Subscription, error) {
+
+	logs, sub, err := _Auction.contract.WatchLogs(opts, "AdminTransferred")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionAdminTransferred)
+				if err := _Auction.contract.UnpackLog(event, "AdminTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAdminTransferred is a log parse operation binding the contract event 0xf8ccb027dfcd135e000e9d45e6cc2d662578a8825d4c45b5e32e0adf67e79ec6.
+//
+// Solidity: event AdminTransferred(address _previousAdmin, address _newAdmin)
+func (_Auction *AuctionFilterer) ParseAdminTransferred(log types.Log) (*AuctionAdminTransferred, error) {
+	event := new(AuctionAdminTransferred)
+	if err := _Auction.contract.UnpackLog(event, "AdminTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AuctionAuctionBidIterator is returned from FilterAuctionBid and is used to iterate over the raw logs and unpacked data for AuctionBid events raised by the Auction contract.
+type AuctionAuctionBidIterator struct {
+	Event *AuctionAuctionBid // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionAuctionBidIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionAuctionBid)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionAuctionBid)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionAuctionBidIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionAuctionBidIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionAuctionBid represents a AuctionBid event raised by the Auction contract.
+type AuctionAuctionBid struct {
+	AuctionId *big.Int
+	Bidder    common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterAuctionBid is a free log retrieval operation binding the contract event 0xb8d756f2d1da4663767eb4d559780ace84f2f65a421a60ddcb47e8b2e5d2fd26.
+//
+// Solidity: event AuctionBid(uint256 _auctionId, address _bidder, uint256 _amount)
+func (_Auction *AuctionFilterer) FilterAuctionBid(opts *bind.FilterOpts) (*AuctionAuctionBidIterator, error) {
+
+	logs, sub, err := _Auction.contract.FilterLogs(opts, "AuctionBid")
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionAuctionBidIterator{contract: _Auction.contract, event: "AuctionBid", logs: logs, sub: sub}, nil
+}
+
+// WatchAuctionBid is a free log subscription operation binding the contract event 0xb8d756f2d1da4663767eb4d559780ace84f2f65a421a60ddcb47e8b2e5d2fd26.
+//
+// Solidity: event AuctionBid(uint256 _auctionId, address _bidder, uint256 _amount)
+func (_Auction *AuctionFilterer) WatchAuctionBid(opts *bind.WatchOpts, sink chan<- *AuctionAuctionBid) (event.Subscription, error) {
+
+	logs, sub, err := _Auction.contract.WatchLogs(opts, "AuctionBid")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionAuctionBid)
+				if err := _Auction.contract.UnpackLog(event, "AuctionBid", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAuctionBid is a log parse operation binding the contract event 0xb8d756f2d1da4663767eb4d559780ace84f2f65a421a60ddcb47e8b2e5d2fd26.
+//
+// Solidity: event AuctionBid(uint256 _auctionId, address _bidder, uint256 _amount)
+func (_Auction *AuctionFilterer) ParseAuctionBid(log types.Log) (*AuctionAuctionBid, error) {
+	event := new(AuctionAuctionBid)
+	if err := _Auction.contract.UnpackLog(event, "AuctionBid", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AuctionAuctionClosedIterator is returned from FilterAuctionClosed and is used to iterate over the raw logs and unpacked data for AuctionClosed events raised by the Auction contract.
+type AuctionAuctionClosedIterator struct {
+	Event *AuctionAuctionClosed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionAuctionClosedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionAuctionClosed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionAuctionClosed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionAuctionClosedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionAuctionClosedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionAuctionClosed represents a AuctionClosed event raised by the Auction contract.
+type AuctionAuctionClosed struct {
+	AuctionId *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterAuctionClosed is a free log retrieval operation binding the contract event 0xac4a907ec29adcc56774b757ecb1e1b4d597374fc9386107d05e2670259df7d3.
+//
+// Solidity: event AuctionClosed(uint256 _auctionId)
+func (_Auction *AuctionFilterer) FilterAuctionClosed(opts *bind.FilterOpts) (*AuctionAuctionClosedIterator, error) {
+
+	logs, sub, err := _Auction.contract.FilterLogs(opts, "AuctionClosed")
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionAuctionClosedIterator{contract: _Auction.contract, event: "AuctionClosed", logs: logs, sub: sub}, nil
+}
+
+// WatchAuctionClosed is a free log subscription operation binding the contract event 0xac4a907ec29adcc56774b757ecb1e1b4d597374fc9386107d05e2670259df7d3.
+//
+// Solidity: event AuctionClosed(uint256 _auctionId)
+func (_Auction *AuctionFilterer) WatchAuctionClosed(opts *bind.WatchOpts, sink chan<- *AuctionAuctionClosed) (event.Subscription, error) {
+
+	logs, sub, err := _Auction.contract.WatchLogs(opts, "AuctionClosed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionAuctionClosed)
+				if err := _Auction.contract.UnpackLog(event, "AuctionClosed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAuctionClosed is a log parse operation binding the contract event 0xac4a907ec29adcc56774b757ecb1e1b4d597374fc9386107d05e2670259df7d3.
+//
+// Solidity: event AuctionClosed(uint256 _auctionId)
+func (_Auction *AuctionFilterer) ParseAuctionClosed(log types.Log) (*AuctionAuctionClosed, error) {
+	event := new(AuctionAuctionClosed)
+	if err := _Auction.contract.UnpackLog(event, "AuctionClosed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AuctionAuctionCreatedIterator is returned from FilterAuctionCreated and is used to iterate over the raw logs and unpacked data for AuctionCreated events raised by the Auction contract.
+type AuctionAuctionCreatedIterator struct {
+	Event *AuctionAuctionCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionAuctionCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionAuctionCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionAuctionCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionAuctionCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionAuctionCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionAuctionCreated represents a AuctionCreated event raised by the Auction contract.
+type AuctionAuctionCreated struct {
+	Creator         common.Address
+	TokenAddress    common.Address
+	TokenId         *big.Int
+	CurrencyAddress common.Address
+	AuctionId       *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterAuctionCreated is a free log retrieval operation binding the contract event 0x03bb6e669c5d9d2143afb3599bda2cc92f483158549e37b474a6dc117f848b68.
+//
+// Solidity: event AuctionCreated(address _creator, address _tokenAddress, uint256 _tokenId, address _currencyAddress, uint256 _auctionId)
+func (_Auction *AuctionFilterer) FilterAuctionCreated(opts *bind.FilterOpts) (*AuctionAuctionCreatedIterator, error) {
+
+	logs, sub, err := _Auction.contract.FilterLogs(opts, "AuctionCreated")
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionAuctionCreatedIterator{contract: _Auction.contract, event: "AuctionCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchAuctionCreated is a free log subscription operation binding the contract event 0x03bb6e669c5d9d2143afb3599bda2cc92f483158549e37b474a6dc117f848b68.
+//
+// Solidity: event AuctionCreated(address _creator, address _tokenAddress, uint256 _tokenId, address _currencyAddress, uint256 _auctionId)
+func (_Auction *AuctionFilterer) WatchAuctionCreated(opts *bind.WatchOpts, sink chan<- *AuctionAuctionCreated) (event.Subscription, error) {
+
+	logs, sub, err := _Auction.contract.WatchLogs(opts, "AuctionCreated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionAuctionCreated)
+				if err := _Auction.contract.UnpackLog(event, "AuctionCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAuctionCreated is a log parse operation binding the contract event 0x03bb6e669c5d9d2143afb3599bda2cc92f483158549e37b474a6dc117f848b68.
+//
+// Solidity: event AuctionCreated(address _creator, address _tokenAddress, uint256 _tokenId, address _currencyAddress, uint256 _auctionId)
+func (_Auction *AuctionFilterer) ParseAuctionCreated(log types.Log) (*AuctionAuctionCreated, error) {
+	event := new(AuctionAuctionCreated)
+	if err := _Auction.contract.UnpackLog(event, "AuctionCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AuctionBidCounteredIterator is returned from FilterBidCountered and is used to iterate over the raw logs and unpacked data for BidCountered events raised by the Auction contract.
+type AuctionBidCounteredIterator struct {
+	Event *AuctionBidCountered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionBidCounteredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionBidCountered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionBidCountered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionBidCounteredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionBidCounteredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionBidCountered represents a BidCountered event raised by the Auction contract.
+type AuctionBidCountered struct {
+	AuctionId *big.Int
+	Bidder    common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterBidCountered is a free log retrieval operation binding the contract event 0xd271751b3fc329e4f543fc69c9f69c12b5152eabfe4f47a7661a397f7096c215.
+//
+// Solidity: event BidCountered(uint256 _auctionId, address _bidder, uint256 _amount)
+func (_Auction *AuctionFilterer) FilterBidCountered(opts *bind.FilterOpts) (*AuctionBidCounteredIterator, error) {
+
+	logs, sub, err := _Auction.contract.FilterLogs(opts, "BidCountered")
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionBidCounteredIterator{contract: _Auction.contract, event: "BidCountered", logs: logs, sub: sub}, nil
+}
+
+// WatchBidCountered is a free log subscription operation binding the contract event 0xd271751b3fc329e4f543fc69c9f69c12b5152eabfe4f47a7661a397f7096c215.
+//
+// Solidity: event BidCountered(uint256 _auctionId, address _bidder, uint256 _amount)
+func (_Auction *AuctionFilterer) WatchBidCountered(opts *bind.WatchOpts, sink chan<- *AuctionBidCountered) (event.Subscription, error) {
+
+	logs, sub, err := _Auction.contract.WatchLogs(opts, "BidCountered")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionBidCountered)
+				if err := _Auction.contract.UnpackLog(event, "BidCountered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBidCountered is a log parse operation binding the contract event 0xd271751b3fc329e4f543fc69c9f69c12b5152eabfe4f47a7661a397f7096c215.
+//
+// Solidity: event BidCountered(uint256 _auctionId, address _bidder, uint256 _amount)
+func (_Auction *AuctionFilterer) ParseBidCountered(log types.Log) (*AuctionBidCountered, error) {
+	event := new(AuctionBidCountered)
+	if err := _Auction.contract.UnpackLog(event, "BidCountered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AuctionGuardianChangedIterator is returned from FilterGuardianChanged and is used to iterate over the raw logs and unpacked data for GuardianChanged events raised by the Auction contract.
+type AuctionGuardianChangedIterator struct {
+	Event *AuctionGuardianChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionGuardianChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionGuardianChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionGuardianChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionGuardianChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionGuardianChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionGuardianChanged represents a GuardianChanged event raised by the Auction contract.
+type AuctionGuardianChanged struct {
+	Guardian   common.Address
+	IsGuardian bool
+	Raw        types.Log // Blockchain specific contextual infos
 }
 
-// RegainLot is a paid mutator transaction binding the contract method 0x1080f5c9.
+// FilterGuardianChanged is a free log retrieval operation binding the contract event 0xafef3d05547c718394a99a79aa641db2143708cd1114b68479af4740f173cc50.
 //
-// Solidity: function regainLot(uint256 _auctionId) returns()
-func (_Auction *AuctionTransactor) RegainLot(opts *bind.TransactOpts, _auctionId *big.Int) (*types.Transaction, error) {
-	return _Auction.contract.Transact(opts, "regainLot", _auctionId)
+// Solidity: event GuardianChanged(address _guardian, bool _isGuardian)
+func (_Auction *AuctionFilterer) FilterGuardianChanged(opts *bind.FilterOpts) (*AuctionGuardianChangedIterator, error) {
+
+	logs, sub, err := _Auction.contract.FilterLogs(opts, "GuardianChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionGuardianChangedIterator{contract: _Auction.contract, event: "GuardianChanged", logs: logs, sub: sub}, nil
 }
 
-// RegainLot is a paid mutator transaction binding the contract method 0x1080f5c9.
+// WatchGuardianChanged is a free log subscription operation binding the contract event 0xafef3d05547c718394a99a79aa641db2143708cd1114b68479af4740f173cc50.
 //
-// Solidity: function regainLot(uint256 _auctionId) returns()
-func (_Auction *AuctionSession) RegainLot(_auctionId *big.Int) (*types.Transaction, error) {
-	return _Auction.Contract.RegainLot(&_Auction.TransactOpts, _auctionId)
+// Solidity: event GuardianChanged(address _guardian, bool _isGuardian)
+func (_Auction *AuctionFilterer) WatchGuardianChanged(opts *bind.WatchOpts, sink chan<- *AuctionGuardianChanged) (event.Subscription, error) {
+
+	logs, sub, err := _Auction.contract.WatchLogs(opts, "GuardianChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionGuardianChanged)
+				if err := _Auction.contract.UnpackLog(event, "GuardianChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
 }
 
-// RegainLot is a paid mutator transaction binding the contract method 0x1080f5c9.
+// ParseGuardianChanged is a log parse operation binding the contract event 0xafef3d05547c718394a99a79aa641db2143708cd1114b68479af4740f173cc50.
 //
-// Solidity: function regainLot(uint256 _auctionId) returns()
-func (_Auction *AuctionTransactorSession) RegainLot(_auctionId *big.Int) (*types.Transaction, error) {
-	return _Auction.Contract.RegainLot(&_Auction.TransactOpts, _auctionId)
+// Solidity: event GuardianChanged(address _guardian, bool _isGuardian)
+func (_Auction *AuctionFilterer) ParseGuardianChanged(log types.Log) (*AuctionGuardianChanged, error) {
+	event := new(AuctionGuardianChanged)
+	if err := _Auction.contract.UnpackLog(event, "GuardianChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
 }
 
-// AuctionAuctionBidIterator is returned from FilterAuctionBid and is used to iterate over the raw logs and unpacked data for AuctionBid events raised by the Auction contract.
-type AuctionAuctionBidIterator struct {
-	Event *AuctionAuctionBid // Event containing the contract specifics and raw log
+// AuctionLotTransferredIterator is returned from FilterLotTransferred and is used to iterate over the raw logs and unpacked data for LotTransferred events raised by the Auction contract.
+type AuctionLotTransferredIterator struct {
+	Event *AuctionLotTransferred // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -474,7 +1831,7 @@ type AuctionAuctionBidIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *AuctionAuctionBidIterator) Next() bool {
+func (it *AuctionLotTransferredIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -483,7 +1840,7 @@ func (it *AuctionAuctionBidIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(AuctionAuctionBid)
+			it.Event = new(AuctionLotTransferred)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -498,7 +1855,7 @@ func (it *AuctionAuctionBidIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(AuctionAuctionBid)
+		it.Event = new(AuctionLotTransferred)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -514,43 +1871,42 @@ func (it *AuctionAuctionBidIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *AuctionAuctionBidIterator) Error() error {
+func (it *AuctionLotTransferredIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *AuctionAuctionBidIterator) Close() error {
+func (it *AuctionLotTransferredIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// AuctionAuctionBid represents a AuctionBid event raised by the Auction contract.
-type AuctionAuctionBid struct {
+// AuctionLotTransferred represents a LotTransferred event raised by the Auction contract.
+type AuctionLotTransferred struct {
 	AuctionId *big.Int
-	Bidder    common.Address
-	Amount    *big.Int
+	Winner    common.Address
 	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// FilterAuctionBid is a free log retrieval operation binding the contract event 0xb8d756f2d1da4663767eb4d559780ace84f2f65a421a60ddcb47e8b2e5d2fd26.
+// FilterLotTransferred is a free log retrieval operation binding the contract event 0x0e60401031695311e5192456b98ba458c20ba883eb86b101645de6252c6e9dd3.
 //
-// Solidity: event AuctionBid(uint256 _auctionId, address _bidder, uint256 _amount)
-func (_Auction *AuctionFilterer) FilterAuctionBid(opts *bind.FilterOpts) (*AuctionAuctionBidIterator, error) {
+// Solidity: event LotTransferred(uint256 _auctionId, address _winner)
+func (_Auction *AuctionFilterer) FilterLotTransferred(opts *bind.FilterOpts) (*AuctionLotTransferredIterator, error) {
 
-	logs, sub, err := _Auction.contract.FilterLogs(opts, "AuctionBid")
+	logs, sub, err := _Auction.contract.FilterLogs(opts, "LotTransferred")
 	if err != nil {
 		return nil, err
 	}
-	return &AuctionAuctionBidIterator{contract: _Auction.contract, event: "AuctionBid", logs: logs, sub: sub}, nil
+	return &AuctionLotTransferredIterator{contract: _Auction.contract, event: "LotTransferred", logs: logs, sub: sub}, nil
 }
 
-// WatchAuctionBid is a free log subscription operation binding the contract event 0xb8d756f2d1da4663767eb4d559780ace84f2f65a421a60ddcb47e8b2e5d2fd26.
+// WatchLotTransferred is a free log subscription operation binding the contract event 0x0e60401031695311e5192456b98ba458c20ba883eb86b101645de6252c6e9dd3.
 //
-// Solidity: event AuctionBid(uint256 _auctionId, address _bidder, uint256 _amount)
-func (_Auction *AuctionFilterer) WatchAuctionBid(opts *bind.WatchOpts, sink chan<- *AuctionAuctionBid) (event.Subscription, error) {
+// Solidity: event LotTransferred(uint256 _auctionId, address _winner)
+func (_Auction *AuctionFilterer) WatchLotTransferred(opts *bind.WatchOpts, sink chan<- *AuctionLotTransferred) (event.Subscription, error) {
 
-	logs, sub, err := _Auction.contract.WatchLogs(opts, "AuctionBid")
+	logs, sub, err := _Auction.contract.WatchLogs(opts, "LotTransferred")
 	if err != nil {
 		return nil, err
 	}
@@ -560,8 +1916,8 @@ func (_Auction *AuctionFilterer) WatchAuctionBid(opts *bind.WatchOpts, sink chan
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(AuctionAuctionBid)
-				if err := _Auction.contract.UnpackLog(event, "AuctionBid", log); err != nil {
+				event := new(AuctionLotTransferred)
+				if err := _Auction.contract.UnpackLog(event, "LotTransferred", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -582,21 +1938,21 @@ func (_Auction *AuctionFilterer) WatchAuctionBid(opts *bind.WatchOpts, sink chan
 	}), nil
 }
 
-// ParseAuctionBid is a log parse operation binding the contract event 0xb8d756f2d1da4663767eb4d559780ace84f2f65a421a60ddcb47e8b2e5d2fd26.
+// ParseLotTransferred is a log parse operation binding the contract event 0x0e60401031695311e5192456b98ba458c20ba883eb86b101645de6252c6e9dd3.
 //
-// Solidity: event AuctionBid(uint256 _auctionId, address _bidder, uint256 _amount)
-func (_Auction *AuctionFilterer) ParseAuctionBid(log types.Log) (*AuctionAuctionBid, error) {
-	event := new(AuctionAuctionBid)
-	if err := _Auction.contract.UnpackLog(event, "AuctionBid", log); err != nil {
+// Solidity: event LotTransferred(uint256 _auctionId, address _winner)
+func (_Auction *AuctionFilterer) ParseLotTransferred(log types.Log) (*AuctionLotTransferred, error) {
+	event := new(AuctionLotTransferred)
+	if err := _Auction.contract.UnpackLog(event, "LotTransferred", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// AuctionAuctionClosedIterator is returned from FilterAuctionClosed and is used to iterate over the raw logs and unpacked data for AuctionClosed events raised by the Auction contract.
-type AuctionAuctionClosedIterator struct {
-	Event *AuctionAuctionClosed // Event containing the contract specifics and raw log
+// AuctionPausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the Auction contract.
+type AuctionPausedIterator struct {
+	Event *AuctionPaused // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -610,7 +1966,7 @@ type AuctionAuctionClosedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *AuctionAuctionClosedIterator) Next() bool {
+func (it *AuctionPausedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -619,7 +1975,7 @@ func (it *AuctionAuctionClosedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(AuctionAuctionClosed)
+			it.Event = new(AuctionPaused)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -634,7 +1990,7 @@ func (it *AuctionAuctionClosedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(AuctionAuctionClosed)
+		it.Event = new(AuctionPaused)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -650,41 +2006,41 @@ func (it *AuctionAuctionClosedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *AuctionAuctionClosedIterator) Error() error {
+func (it *AuctionPausedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *AuctionAuctionClosedIterator) Close() error {
+func (it *AuctionPausedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// AuctionAuctionClosed represents a AuctionClosed event raised by the Auction contract.
-type AuctionAuctionClosed struct {
-	AuctionId *big.Int
-	Raw       types.Log // Blockchain specific contextual infos
+// AuctionPaused represents a Paused event raised by the Auction contract.
+type AuctionPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
 }
 
-// FilterAuctionClosed is a free log retrieval operation binding the contract event 0xac4a907ec29adcc56774b757ecb1e1b4d597374fc9386107d05e2670259df7d3.
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
 //
-// Solidity: event AuctionClosed(uint256 _auctionId)
-func (_Auction *AuctionFilterer) FilterAuctionClosed(opts *bind.FilterOpts) (*AuctionAuctionClosedIterator, error) {
+// Solidity: event Paused(address _account)
+func (_Auction *AuctionFilterer) FilterPaused(opts *bind.FilterOpts) (*AuctionPausedIterator, error) {
 
-	logs, sub, err := _Auction.contract.FilterLogs(opts, "AuctionClosed")
+	logs, sub, err := _Auction.contract.FilterLogs(opts, "Paused")
 	if err != nil {
 		return nil, err
 	}
-	return &AuctionAuctionClosedIterator{contract: _Auction.contract, event: "AuctionClosed", logs: logs, sub: sub}, nil
+	return &AuctionPausedIterator{contract: _Auction.contract, event: "Paused", logs: logs, sub: sub}, nil
 }
 
-// WatchAuctionClosed is a free log subscription operation binding the contract event 0xac4a907ec29adcc56774b757ecb1e1b4d597374fc9386107d05e2670259df7d3.
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
 //
-// Solidity: event AuctionClosed(uint256 _auctionId)
-func (_Auction *AuctionFilterer) WatchAuctionClosed(opts *bind.WatchOpts, sink chan<- *AuctionAuctionClosed) (event.Subscription, error) {
+// Solidity: event Paused(address _account)
+func (_Auction *AuctionFilterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *AuctionPaused) (event.Subscription, error) {
 
-	logs, sub, err := _Auction.contract.WatchLogs(opts, "AuctionClosed")
+	logs, sub, err := _Auction.contract.WatchLogs(opts, "Paused")
 	if err != nil {
 		return nil, err
 	}
@@ -694,8 +2050,8 @@ func (_Auction *AuctionFilterer) WatchAuctionClosed(opts *bind.WatchOpts, sink c
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(AuctionAuctionClosed)
-				if err := _Auction.contract.UnpackLog(event, "AuctionClosed", log); err != nil {
+				event := new(AuctionPaused)
+				if err := _Auction.contract.UnpackLog(event, "Paused", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -716,21 +2072,21 @@ func (_Auction *AuctionFilterer) WatchAuctionClosed(opts *bind.WatchOpts, sink c
 	}), nil
 }
 
-// ParseAuctionClosed is a log parse operation binding the contract event 0xac4a907ec29adcc56774b757ecb1e1b4d597374fc9386107d05e2670259df7d3.
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
 //
-// Solidity: event AuctionClosed(uint256 _auctionId)
-func (_Auction *AuctionFilterer) ParseAuctionClosed(log types.Log) (*AuctionAuctionClosed, error) {
-	event := new(AuctionAuctionClosed)
-	if err := _Auction.contract.UnpackLog(event, "AuctionClosed", log); err != nil {
+// Solidity: event Paused(address _account)
+func (_Auction *AuctionFilterer) ParsePaused(log types.Log) (*AuctionPaused, error) {
+	event := new(AuctionPaused)
+	if err := _Auction.contract.UnpackLog(event, "Paused", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// AuctionAuctionCreatedIterator is returned from FilterAuctionCreated and is used to iterate over the raw logs and unpacked data for AuctionCreated events raised by the Auction contract.
-type AuctionAuctionCreatedIterator struct {
-	Event *AuctionAuctionCreated // Event containing the contract specifics and raw log
+// AuctionRepaymentTransferredIterator is returned from FilterRepaymentTransferred and is used to iterate over the raw logs and unpacked data for RepaymentTransferred events raised by the Auction contract.
+type AuctionRepaymentTransferredIterator struct {
+	Event *AuctionRepaymentTransferred // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -744,7 +2100,7 @@ type AuctionAuctionCreatedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *AuctionAuctionCreatedIterator) Next() bool {
+func (it *AuctionRepaymentTransferredIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -753,7 +2109,7 @@ func (it *AuctionAuctionCreatedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(AuctionAuctionCreated)
+			it.Event = new(AuctionRepaymentTransferred)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -768,7 +2124,7 @@ func (it *AuctionAuctionCreatedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(AuctionAuctionCreated)
+		it.Event = new(AuctionRepaymentTransferred)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -784,45 +2140,42 @@ func (it *AuctionAuctionCreatedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *AuctionAuctionCreatedIterator) Error() error {
+func (it *AuctionRepaymentTransferredIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *AuctionAuctionCreatedIterator) Close() error {
+func (it *AuctionRepaymentTransferredIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// AuctionAuctionCreated represents a AuctionCreated event raised by the Auction contract.
-type AuctionAuctionCreated struct {
-	Creator         common.Address
-	TokenAddress    common.Address
-	TokenId         *big.Int
-	CurrencyAddress common.Address
-	AuctionId       *big.Int
-	Raw             types.Log // Blockchain specific contextual infos
+// AuctionRepaymentTransferred represents a RepaymentTransferred event raised by the Auction contract.
+type AuctionRepaymentTransferred struct {
+	AuctionId *big.Int
+	Creator   common.Address
+	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// FilterAuctionCreated is a free log retrieval operation binding the contract event 0x03bb6e669c5d9d2143afb3599bda2cc92f483158549e37b474a6dc117f848b68.
+// FilterRepaymentTransferred is a free log retrieval operation binding the contract event 0xcede1e8bc44e44a1be509c46755459a8fef702efbee4649158b5376942bc14b9.
 //
-// Solidity: event AuctionCreated(address _creator, address _tokenAddress, uint256 _tokenId, address _currencyAddress, uint256 _auctionId)
-func (_Auction *AuctionFilterer) FilterAuctionCreated(opts *bind.FilterOpts) (*AuctionAuctionCreatedIterator, error) {
+// Solidity: event RepaymentTransferred(uint256 _auctionId, address _creator)
+func (_Auction *AuctionFilterer) FilterRepaymentTransferred(opts *bind.FilterOpts) (*AuctionRepaymentTransferredIterator, error) {
 
-	logs, sub, err := _Auction.contract.FilterLogs(opts, "AuctionCreated")
+	logs, sub, err := _Auction.contract.FilterLogs(opts, "RepaymentTransferred")
 	if err != nil {
 		return nil, err
 	}
-	return &AuctionAuctionCreatedIterator{contract: _Auction.contract, event: "AuctionCreated", logs: logs, sub: sub}, nil
+	return &AuctionRepaymentTransferredIterator{contract: _Auction.contract, event: "RepaymentTransferred", logs: logs, sub: sub}, nil
 }
 
-// WatchAuctionCreated is a free log subscription operation binding the contract event 0x03bb6e669c5d9d2143afb3599bda2cc92f483158549e37b474a6dc117f848b68.
+// WatchRepaymentTransferred is a free log subscription operation binding the contract event 0xcede1e8bc44e44a1be509c46755459a8fef702efbee4649158b5376942bc14b9.
 //
-// Solidity: event AuctionCreated(address _creator, address _tokenAddress, uint256 _tokenId, address _currencyAddress, uint256 _auctionId)
-func (_Auction *AuctionFilterer) WatchAuctionCreated(opts *bind.WatchOpts, sink chan<- *AuctionAuctionCreated) (event.Subscription, error) {
+// Solidity: event RepaymentTransferred(uint256 _auctionId, address _creator)
+func (_Auction *AuctionFilterer) WatchRepaymentTransferred(opts *bind.WatchOpts, sink chan<- *AuctionRepaymentTransferred) (event.Subscription, error) {
 
-	logs, sub, err := _Auction.contract.WatchLogs(opts, "AuctionCreated")
+	logs, sub, err := _Auction.contract.WatchLogs(opts, "RepaymentTransferred")
 	if err != nil {
 		return nil, err
 	}
@@ -832,8 +2185,8 @@ func (_Auction *AuctionFilterer) WatchAuctionCreated(opts *bind.WatchOpts, sink
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(AuctionAuctionCreated)
-				if err := _Auction.contract.UnpackLog(event, "AuctionCreated", log); err != nil {
+				event := new(AuctionRepaymentTransferred)
+				if err := _Auction.contract.UnpackLog(event, "RepaymentTransferred", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -854,21 +2207,21 @@ func (_Auction *AuctionFilterer) WatchAuctionCreated(opts *bind.WatchOpts, sink
 	}), nil
 }
 
-// ParseAuctionCreated is a log parse operation binding the contract event 0x03bb6e669c5d9d2143afb3599bda2cc92f483158549e37b474a6dc117f848b68.
+// ParseRepaymentTransferred is a log parse operation binding the contract event 0xcede1e8bc44e44a1be509c46755459a8fef702efbee4649158b5376942bc14b9.
 //
-// Solidity: event AuctionCreated(address _creator, address _tokenAddress, uint256 _tokenId, address _currencyAddress, uint256 _auctionId)
-func (_Auction *AuctionFilterer) ParseAuctionCreated(log types.Log) (*AuctionAuctionCreated, error) {
-	event := new(AuctionAuctionCreated)
-	if err := _Auction.contract.UnpackLog(event, "AuctionCreated", log); err != nil {
+// Solidity: event RepaymentTransferred(uint256 _auctionId, address _creator)
+func (_Auction *AuctionFilterer) ParseRepaymentTransferred(log types.Log) (*AuctionRepaymentTransferred, error) {
+	event := new(AuctionRepaymentTransferred)
+	if err := _Auction.contract.UnpackLog(event, "RepaymentTransferred", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// AuctionLotTransferredIterator is returned from FilterLotTransferred and is used to iterate over the raw logs and unpacked data for LotTransferred events raised by the Auction contract.
-type AuctionLotTransferredIterator struct {
-	Event *AuctionLotTransferred // Event containing the contract specifics and raw log
+// AuctionTrustedForwarderChangedIterator is returned from FilterTrustedForwarderChanged and is used to iterate over the raw logs and unpacked data for TrustedForwarderChanged events raised by the Auction contract.
+type AuctionTrustedForwarderChangedIterator struct {
+	Event *AuctionTrustedForwarderChanged // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -882,7 +2235,7 @@ type AuctionLotTransferredIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *AuctionLotTransferredIterator) Next() bool {
+func (it *AuctionTrustedForwarderChangedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -891,7 +2244,7 @@ func (it *AuctionLotTransferredIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(AuctionLotTransferred)
+			it.Event = new(AuctionTrustedForwarderChanged)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -906,7 +2259,7 @@ func (it *AuctionLotTransferredIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(AuctionLotTransferred)
+		it.Event = new(AuctionTrustedForwarderChanged)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -922,42 +2275,41 @@ func (it *AuctionLotTransferredIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *AuctionLotTransferredIterator) Error() error {
+func (it *AuctionTrustedForwarderChangedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *AuctionLotTransferredIterator) Close() error {
+func (it *AuctionTrustedForwarderChangedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// AuctionLotTransferred represents a LotTransferred event raised by the Auction contract.
-type AuctionLotTransferred struct {
-	AuctionId *big.Int
-	Winner    common.Address
+// AuctionTrustedForwarderChanged represents a TrustedForwarderChanged event raised by the Auction contract.
+type AuctionTrustedForwarderChanged struct {
+	Forwarder common.Address
 	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// FilterLotTransferred is a free log retrieval operation binding the contract event 0x0e60401031695311e5192456b98ba458c20ba883eb86b101645de6252c6e9dd3.
+// FilterTrustedForwarderChanged is a free log retrieval operation binding the contract event 0x871264f4293af7d2865ae7eae628b228f4991c57cb45b39c99f0b774ebe29018.
 //
-// Solidity: event LotTransferred(uint256 _auctionId, address _winner)
-func (_Auction *AuctionFilterer) FilterLotTransferred(opts *bind.FilterOpts) (*AuctionLotTransferredIterator, error) {
+// Solidity: event TrustedForwarderChanged(address _forwarder)
+func (_Auction *AuctionFilterer) FilterTrustedForwarderChanged(opts *bind.FilterOpts) (*AuctionTrustedForwarderChangedIterator, error) {
 
-	logs, sub, err := _Auction.contract.FilterLogs(opts, "LotTransferred")
+	logs, sub, err := _Auction.contract.FilterLogs(opts, "TrustedForwarderChanged")
 	if err != nil {
 		return nil, err
 	}
-	return &AuctionLotTransferredIterator{contract: _Auction.contract, event: "LotTransferred", logs: logs, sub: sub}, nil
+	return &AuctionTrustedForwarderChangedIterator{contract: _Auction.contract, event: "TrustedForwarderChanged", logs: logs, sub: sub}, nil
 }
 
-// WatchLotTransferred is a free log subscription operation binding the contract event 0x0e60401031695311e5192456b98ba458c20ba883eb86b101645de6252c6e9dd3.
+// WatchTrustedForwarderChanged is a free log subscription operation binding the contract event 0x871264f4293af7d2865ae7eae628b228f4991c57cb45b39c99f0b774ebe29018.
 //
-// Solidity: event LotTransferred(uint256 _auctionId, address _winner)
-func (_Auction *AuctionFilterer) WatchLotTransferred(opts *bind.WatchOpts, sink chan<- *AuctionLotTransferred) (event.Subscription, error) {
+// Solidity: event TrustedForwarderChanged(address _forwarder)
+func (_Auction *AuctionFilterer) WatchTrustedForwarderChanged(opts *bind.WatchOpts, sink chan<- *AuctionTrustedForwarderChanged) (event.Subscription, error) {
 
-	logs, sub, err := _Auction.contract.WatchLogs(opts, "LotTransferred")
+	logs, sub, err := _Auction.contract.WatchLogs(opts, "TrustedForwarderChanged")
 	if err != nil {
 		return nil, err
 	}
@@ -967,8 +2319,8 @@ func (_Auction *AuctionFilterer) WatchLotTransferred(opts *bind.WatchOpts, sink
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(AuctionLotTransferred)
-				if err := _Auction.contract.UnpackLog(event, "LotTransferred", log); err != nil {
+				event := new(AuctionTrustedForwarderChanged)
+				if err := _Auction.contract.UnpackLog(event, "TrustedForwarderChanged", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -989,21 +2341,21 @@ func (_Auction *AuctionFilterer) WatchLotTransferred(opts *bind.WatchOpts, sink
 	}), nil
 }
 
-// ParseLotTransferred is a log parse operation binding the contract event 0x0e60401031695311e5192456b98ba458c20ba883eb86b101645de6252c6e9dd3.
+// ParseTrustedForwarderChanged is a log parse operation binding the contract event 0x871264f4293af7d2865ae7eae628b228f4991c57cb45b39c99f0b774ebe29018.
 //
-// Solidity: event LotTransferred(uint256 _auctionId, address _winner)
-func (_Auction *AuctionFilterer) ParseLotTransferred(log types.Log) (*AuctionLotTransferred, error) {
-	event := new(AuctionLotTransferred)
-	if err := _Auction.contract.UnpackLog(event, "LotTransferred", log); err != nil {
+// Solidity: event TrustedForwarderChanged(address _forwarder)
+func (_Auction *AuctionFilterer) ParseTrustedForwarderChanged(log types.Log) (*AuctionTrustedForwarderChanged, error) {
+	event := new(AuctionTrustedForwarderChanged)
+	if err := _Auction.contract.UnpackLog(event, "TrustedForwarderChanged", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// AuctionRepaymentTransferredIterator is returned from FilterRepaymentTransferred and is used to iterate over the raw logs and unpacked data for RepaymentTransferred events raised by the Auction contract.
-type AuctionRepaymentTransferredIterator struct {
-	Event *AuctionRepaymentTransferred // Event containing the contract specifics and raw log
+// AuctionUnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the Auction contract.
+type AuctionUnpausedIterator struct {
+	Event *AuctionUnpaused // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1017,7 +2369,7 @@ type AuctionRepaymentTransferredIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *AuctionRepaymentTransferredIterator) Next() bool {
+func (it *AuctionUnpausedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1026,7 +2378,7 @@ func (it *AuctionRepaymentTransferredIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(AuctionRepaymentTransferred)
+			it.Event = new(AuctionUnpaused)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1041,7 +2393,7 @@ func (it *AuctionRepaymentTransferredIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(AuctionRepaymentTransferred)
+		it.Event = new(AuctionUnpaused)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1057,42 +2409,41 @@ func (it *AuctionRepaymentTransferredIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *AuctionRepaymentTransferredIterator) Error() error {
+func (it *AuctionUnpausedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *AuctionRepaymentTransferredIterator) Close() error {
+func (it *AuctionUnpausedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// AuctionRepaymentTransferred represents a RepaymentTransferred event raised by the Auction contract.
-type AuctionRepaymentTransferred struct {
-	AuctionId *big.Int
-	Creator   common.Address
-	Raw       types.Log // Blockchain specific contextual infos
+// AuctionUnpaused represents a Unpaused event raised by the Auction contract.
+type AuctionUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
 }
 
-// FilterRepaymentTransferred is a free log retrieval operation binding the contract event 0xcede1e8bc44e44a1be509c46755459a8fef702efbee4649158b5376942bc14b9.
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
 //
-// Solidity: event RepaymentTransferred(uint256 _auctionId, address _creator)
-func (_Auction *AuctionFilterer) FilterRepaymentTransferred(opts *bind.FilterOpts) (*AuctionRepaymentTransferredIterator, error) {
+// Solidity: event Unpaused(address _account)
+func (_Auction *AuctionFilterer) FilterUnpaused(opts *bind.FilterOpts) (*AuctionUnpausedIterator, error) {
 
-	logs, sub, err := _Auction.contract.FilterLogs(opts, "RepaymentTransferred")
+	logs, sub, err := _Auction.contract.FilterLogs(opts, "Unpaused")
 	if err != nil {
 		return nil, err
 	}
-	return &AuctionRepaymentTransferredIterator{contract: _Auction.contract, event: "RepaymentTransferred", logs: logs, sub: sub}, nil
+	return &AuctionUnpausedIterator{contract: _Auction.contract, event: "Unpaused", logs: logs, sub: sub}, nil
 }
 
-// WatchRepaymentTransferred is a free log subscription operation binding the contract event 0xcede1e8bc44e44a1be509c46755459a8fef702efbee4649158b5376942bc14b9.
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
 //
-// Solidity: event RepaymentTransferred(uint256 _auctionId, address _creator)
-func (_Auction *AuctionFilterer) WatchRepaymentTransferred(opts *bind.WatchOpts, sink chan<- *AuctionRepaymentTransferred) (event.Subscription, error) {
+// Solidity: event Unpaused(address _account)
+func (_Auction *AuctionFilterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *AuctionUnpaused) (event.Subscription, error) {
 
-	logs, sub, err := _Auction.contract.WatchLogs(opts, "RepaymentTransferred")
+	logs, sub, err := _Auction.contract.WatchLogs(opts, "Unpaused")
 	if err != nil {
 		return nil, err
 	}
@@ -1102,8 +2453,8 @@ func (_Auction *AuctionFilterer) WatchRepaymentTransferred(opts *bind.WatchOpts,
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(AuctionRepaymentTransferred)
-				if err := _Auction.contract.UnpackLog(event, "RepaymentTransferred", log); err != nil {
+				event := new(AuctionUnpaused)
+				if err := _Auction.contract.UnpackLog(event, "Unpaused", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1124,12 +2475,12 @@ func (_Auction *AuctionFilterer) WatchRepaymentTransferred(opts *bind.WatchOpts,
 	}), nil
 }
 
-// ParseRepaymentTransferred is a log parse operation binding the contract event 0xcede1e8bc44e44a1be509c46755459a8fef702efbee4649158b5376942bc14b9.
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
 //
-// Solidity: event RepaymentTransferred(uint256 _auctionId, address _creator)
-func (_Auction *AuctionFilterer) ParseRepaymentTransferred(log types.Log) (*AuctionRepaymentTransferred, error) {
-	event := new(AuctionRepaymentTransferred)
-	if err := _Auction.contract.UnpackLog(event, "RepaymentTransferred", log); err != nil {
+// Solidity: event Unpaused(address _account)
+func (_Auction *AuctionFilterer) ParseUnpaused(log types.Log) (*AuctionUnpaused, error) {
+	event := new(AuctionUnpaused)
+	if err := _Auction.contract.UnpackLog(event, "Unpaused", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
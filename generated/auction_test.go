@@ -0,0 +1,360 @@
+package generated_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/one-click-platform/system-contracts/generated"
+)
+
+// The tests run the compiled contracts on a simulated chain. Every auction
+// starts at 100, can be bought for 10000 and raises by 10% per bid.
+var (
+	chainID      = big.NewInt(1337)
+	startPrice   = big.NewInt(100)
+	buyNowPrice  = big.NewInt(10000)
+	bidIncrement = new(big.Int).Exp(big.NewInt(10), big.NewInt(26), nil) // 10% of getDecimal()
+	duration     = big.NewInt(24 * 60 * 60)
+	funds        = big.NewInt(100000) // WETH minted to every bidder
+)
+
+type auctionEnv struct {
+	t       *testing.T
+	sim     *backends.SimulatedBackend
+	auction *generated.Auction
+	weth    *generated.WETH
+	token   *generated.WERC721
+
+	auctionAddr common.Address
+	creator     *bind.TransactOpts
+	alice       *bind.TransactOpts
+	bob         *bind.TransactOpts
+	id          *big.Int
+}
+
+// newAuctionEnv deploys WETH, WERC721 and the Auction, funds the bidders
+// and puts the token of the creator up for auction.
+func newAuctionEnv(t *testing.T) *auctionEnv {
+	t.Helper()
+
+	creator, alice, bob := newAccount(t), newAccount(t), newAccount(t)
+	balance := new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil)
+	sim := backends.NewSimulatedBackend(core.GenesisAlloc{
+		creator.From: {Balance: balance},
+		alice.From:   {Balance: balance},
+		bob.From:     {Balance: balance},
+	}, 30000000)
+	t.Cleanup(func() { sim.Close() })
+
+	e := &auctionEnv{t: t, sim: sim, creator: creator, alice: alice, bob: bob}
+
+	wethAddr, _, weth, err := generated.DeployWETH(creator, sim, "Wrapped Ether", "WETH", common.Address{})
+	if err != nil {
+		t.Fatalf("failed to deploy WETH: %v", err)
+	}
+	tokenAddr, _, token, err := generated.DeployWERC721(creator, sim, nil, "Lots", "LOT", common.Address{})
+	if err != nil {
+		t.Fatalf("failed to deploy WERC721: %v", err)
+	}
+	auctionAddr, _, auction, err := generated.DeployAuction(creator, sim, common.Address{})
+	if err != nil {
+		t.Fatalf("failed to deploy Auction: %v", err)
+	}
+	sim.Commit()
+	e.weth, e.token, e.auction, e.auctionAddr = weth, token, auction, auctionAddr
+
+	e.send(func() (*types.Transaction, error) {
+		return weth.SetMinter(creator, creator.From, new(big.Int).Mul(funds, big.NewInt(2)))
+	})
+	for _, bidder := range []*bind.TransactOpts{alice, bob} {
+		bidder := bidder
+		e.send(func() (*types.Transaction, error) { return weth.Mint(creator, bidder.From, funds) })
+		e.send(func() (*types.Transaction, error) { return weth.Approve(bidder, auctionAddr, funds) })
+	}
+
+	e.send(func() (*types.Transaction, error) { return token.Mint(creator, creator.From, "lot") })
+	e.send(func() (*types.Transaction, error) { return token.Approve(creator, auctionAddr, big.NewInt(1)) })
+
+	head, err := sim.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("failed to read head: %v", err)
+	}
+	e.id, err = auction.CountOfAuctions(nil)
+	if err != nil {
+		t.Fatalf("failed to read auction count: %v", err)
+	}
+	e.send(func() (*types.Transaction, error) {
+		return auction.CreateAuction(creator, tokenAddr, big.NewInt(1), wethAddr, startPrice, buyNowPrice,
+			new(big.Int).SetUint64(head.Time), duration, big.NewInt(60), bidIncrement, "lot")
+	})
+	return e
+}
+
+func newAccount(t *testing.T) *bind.TransactOpts {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return transactor(t, key)
+}
+
+func transactor(t *testing.T, key *ecdsa.PrivateKey) *bind.TransactOpts {
+	t.Helper()
+
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		t.Fatalf("failed to create transactor: %v", err)
+	}
+	return opts
+}
+
+// send mines the transaction built by tx and fails the test unless it
+// succeeds.
+func (e *auctionEnv) send(tx func() (*types.Transaction, error)) *types.Receipt {
+	e.t.Helper()
+
+	sent, err := tx()
+	if err != nil {
+		e.t.Fatalf("failed to send transaction: %v", err)
+	}
+	e.sim.Commit()
+	receipt, err := e.sim.TransactionReceipt(context.Background(), sent.Hash())
+	if err != nil {
+		e.t.Fatalf("failed to read receipt: %v", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		e.t.Fatalf("transaction %s reverted", sent.Hash().Hex())
+	}
+	return receipt
+}
+
+func (e *auctionEnv) bid(bidder *bind.TransactOpts, amount int64) *types.Receipt {
+	e.t.Helper()
+	return e.send(func() (*types.Transaction, error) { return e.auction.Bid(bidder, e.id, big.NewInt(amount)) })
+}
+
+func (e *auctionEnv) bidMax(bidder *bind.TransactOpts, maxAmount int64) *types.Receipt {
+	e.t.Helper()
+	return e.send(func() (*types.Transaction, error) { return e.auction.BidMax(bidder, e.id, big.NewInt(maxAmount)) })
+}
+
+// expectState checks the visible price, the leader and the max bid escrowed
+// for the leader.
+func (e *auctionEnv) expectState(highestBid int64, leader *bind.TransactOpts, maxBid int64) {
+	e.t.Helper()
+
+	info, err := e.auction.GetAuctionInfo(nil, e.id)
+	if err != nil {
+		e.t.Fatalf("failed to read auction: %v", err)
+	}
+	if info.HighestBid.Cmp(big.NewInt(highestBid)) != 0 {
+		e.t.Errorf("highest bid is %s, want %d", info.HighestBid, highestBid)
+	}
+	if info.CurrentBidder != leader.From {
+		e.t.Errorf("current bidder is %s, want %s", info.CurrentBidder.Hex(), leader.From.Hex())
+	}
+	max, err := e.auction.GetMaxBid(&bind.CallOpts{From: leader.From}, e.id)
+	if err != nil {
+		e.t.Fatalf("failed to read max bid: %v", err)
+	}
+	if max.Cmp(big.NewInt(maxBid)) != 0 {
+		e.t.Errorf("max bid is %s, want %d", max, maxBid)
+	}
+}
+
+// expectBalance checks the WETH balance of account.
+func (e *auctionEnv) expectBalance(name string, account common.Address, want *big.Int) {
+	e.t.Helper()
+
+	balance, err := e.weth.BalanceOf(nil, account)
+	if err != nil {
+		e.t.Fatalf("failed to read balance: %v", err)
+	}
+	if balance.Cmp(want) != 0 {
+		e.t.Errorf("balance of %s is %s, want %s", name, balance, want)
+	}
+}
+
+// expectSpent checks that bidder holds its funds less spent.
+func (e *auctionEnv) expectSpent(name string, bidder *bind.TransactOpts, spent int64) {
+	e.t.Helper()
+	e.expectBalance(name, bidder.From, new(big.Int).Sub(funds, big.NewInt(spent)))
+}
+
+// expectEscrow checks the WETH held by the auction.
+func (e *auctionEnv) expectEscrow(amount int64) {
+	e.t.Helper()
+	e.expectBalance("auction", e.auctionAddr, big.NewInt(amount))
+}
+
+func (e *auctionEnv) finish() {
+	e.t.Helper()
+
+	if err := e.sim.AdjustTime(time.Duration(duration.Int64()+3600) * time.Second); err != nil {
+		e.t.Fatalf("failed to adjust time: %v", err)
+	}
+	e.sim.Commit()
+}
+
+func TestPlaceBidCounteredByMaxBid(t *testing.T) {
+	e := newAuctionEnv(t)
+
+	// A first max bid only shows the start price.
+	e.bidMax(e.alice, 1000)
+	e.expectState(100, e.alice, 1000)
+
+	// Bob's bid stays within Alice's max bid, which counters it by the
+	// increment without taking Bob's funds.
+	receipt := e.bid(e.bob, 200)
+	e.expectState(220, e.alice, 1000)
+	e.expectSpent("bob", e.bob, 0)
+	e.expectSpent("alice", e.alice, 1000)
+	e.expectEscrow(1000)
+
+	countered := false
+	topic := crypto.Keccak256Hash([]byte("BidCountered(uint256,address,uint256)"))
+	for _, l := range receipt.Logs {
+		if len(l.Topics) == 0 || l.Topics[0] != topic {
+			continue
+		}
+		ev, err := e.auction.ParseBidCountered(*l)
+		if err != nil {
+			t.Fatalf("failed to parse BidCountered: %v", err)
+		}
+		countered = ev.Bidder == e.bob.From && ev.Amount.Cmp(big.NewInt(200)) == 0
+	}
+	if !countered {
+		t.Error("no BidCountered event for the bid of bob")
+	}
+
+	// A counter never goes above the max bid.
+	e.bid(e.bob, 950)
+	e.expectState(1000, e.alice, 1000)
+}
+
+func TestPlaceBidTieKeepsLeader(t *testing.T) {
+	e := newAuctionEnv(t)
+
+	e.bidMax(e.alice, 1000)
+
+	// An equal max bid loses to the earlier one, at the shared maximum.
+	e.bidMax(e.bob, 1000)
+	e.expectState(1000, e.alice, 1000)
+	e.expectSpent("bob", e.bob, 0)
+	e.expectEscrow(1000)
+
+	// Outbidding the max bid takes the lead and refunds it in full.
+	e.bidMax(e.bob, 1100)
+	e.expectState(1100, e.bob, 1100)
+	e.expectSpent("alice", e.alice, 0)
+	e.expectSpent("bob", e.bob, 1100)
+	e.expectEscrow(1100)
+}
+
+func TestPlaceBidOutbidsLowerMaxBid(t *testing.T) {
+	e := newAuctionEnv(t)
+
+	e.bidMax(e.alice, 1000)
+
+	// Only what beats the previous max bid by the increment is shown.
+	e.bidMax(e.bob, 5000)
+	e.expectState(1100, e.bob, 5000)
+	e.expectSpent("alice", e.alice, 0)
+	e.expectSpent("bob", e.bob, 5000)
+	e.expectEscrow(5000)
+}
+
+func TestPlaceBidRaisesOwnMaxBid(t *testing.T) {
+	e := newAuctionEnv(t)
+
+	e.bidMax(e.alice, 1000)
+
+	// Raising the own max bid keeps the visible price and escrows the
+	// difference.
+	e.bidMax(e.alice, 2000)
+	e.expectState(100, e.alice, 2000)
+	e.expectSpent("alice", e.alice, 2000)
+	e.expectEscrow(2000)
+
+	// Bob now needs more than the raised max bid to lead.
+	e.bid(e.bob, 1500)
+	e.expectState(1650, e.alice, 2000)
+	e.expectSpent("bob", e.bob, 0)
+}
+
+func TestSettleRefundsExcessEscrow(t *testing.T) {
+	e := newAuctionEnv(t)
+
+	e.bidMax(e.alice, 1000)
+	e.bid(e.bob, 200)
+	e.expectState(220, e.alice, 1000)
+
+	e.finish()
+	e.send(func() (*types.Transaction, error) { return e.auction.Settle(e.bob, e.id) })
+
+	// The creator gets the winning price, the winner the rest of its max bid.
+	e.expectBalance("creator", e.creator.From, big.NewInt(220))
+	e.expectSpent("alice", e.alice, 220)
+	e.expectEscrow(0)
+
+	owner, err := e.token.OwnerOf(nil, big.NewInt(1))
+	if err != nil {
+		t.Fatalf("failed to read lot owner: %v", err)
+	}
+	if owner != e.alice.From {
+		t.Errorf("lot is owned by %s, want the winner %s", owner.Hex(), e.alice.From.Hex())
+	}
+}
+
+func TestClaimRepaymentRefundsExcessEscrow(t *testing.T) {
+	e := newAuctionEnv(t)
+
+	e.bidMax(e.alice, 1000)
+	e.bid(e.bob, 500)
+	e.expectState(550, e.alice, 1000)
+
+	e.finish()
+	e.send(func() (*types.Transaction, error) { return e.auction.ClaimRepayment(e.creator, e.id) })
+
+	e.expectBalance("creator", e.creator.From, big.NewInt(550))
+	e.expectSpent("alice", e.alice, 550)
+	e.expectEscrow(0)
+}
+
+func TestBuyNowRefundsMaxBid(t *testing.T) {
+	e := newAuctionEnv(t)
+
+	e.bidMax(e.alice, 1000)
+
+	// Buying refunds the whole escrowed max bid, not the visible price.
+	e.send(func() (*types.Transaction, error) { return e.weth.Approve(e.bob, e.auctionAddr, buyNowPrice) })
+	e.send(func() (*types.Transaction, error) { return e.auction.BuyNow(e.bob, e.id) })
+	e.expectSpent("alice", e.alice, 0)
+	e.expectSpent("bob", e.bob, buyNowPrice.Int64())
+	e.expectEscrow(buyNowPrice.Int64())
+
+	owner, err := e.token.OwnerOf(nil, big.NewInt(1))
+	if err != nil {
+		t.Fatalf("failed to read lot owner: %v", err)
+	}
+	if owner != e.bob.From {
+		t.Errorf("lot is owned by %s, want the buyer %s", owner.Hex(), e.bob.From.Hex())
+	}
+
+	// The repayment pays the creator the price without an excess refund.
+	e.send(func() (*types.Transaction, error) { return e.auction.ClaimRepayment(e.creator, e.id) })
+	e.expectBalance("creator", e.creator.From, buyNowPrice)
+	e.expectEscrow(0)
+}
@@ -0,0 +1,525 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package generated
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// ForwarderForwardRequest is an auto generated low-level Go binding around an user-defined struct.
+type ForwarderForwardRequest struct {
+	From     common.Address
+	To       common.Address
+	Value    *big.Int
+	Gas      *big.Int
+	Nonce    *big.Int
+	Deadline *big.Int
+	Data     []byte
+}
+
+// ForwarderMetaData contains all meta data concerning the Forwarder contract.
+var ForwarderMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_from\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_nonce\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"_success\",\"type\":\"bool\"}],\"name\":\"Executed\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"FORWARD_REQUEST_TYPEHASH\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"domainSeparator\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"gas\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"nonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structForwarder.ForwardRequest\",\"name\":\"_request\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"_signature\",\"type\":\"bytes\"}],\"name\":\"execute\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_from\",\"type\":\"address\"}],\"name\":\"getNonce\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"gas\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"nonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structForwarder.ForwardRequest\",\"name\":\"_request\",\"type\":\"tuple\"}],\"name\":\"hashRequest\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"gas\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"nonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structForwarder.ForwardRequest\",\"name\":\"_request\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"_signature\",\"type\":\"bytes\"}],\"name\":\"verify\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	Bin: "0x61012060405234801561001157600080fd5b5060408051808201825260098152682337b93bb0b93232b960b91b60208083019182528351808501855260018152603160f81b908201529151902060c08181527fc89efdaa54c0f20c7adf612882df0950f5a951637e0307cdcb4c672f298b8bc660e08190524660a081815286517f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f818801819052818901969096526060810193909352608080840192909252308382015286518084039091018152919092019094528351939092019290922090526101005260805160a05160c05160e05161010051610b7461012860003960006106a1015260006106f0015260006106cb01526000610650015260006106780152610b746000f3fe6080604052600436106100555760003560e01c80630d9ede451461005a57806312342287146100845780632d0335ab146100b457806395630968146100f8578063ac1057fe1461012c578063f698da251461014c575b600080fd5b61006d6100683660046108ff565b610161565b60405161007b9291906109ba565b60405180910390f35b34801561009057600080fd5b506100a461009f3660046108ff565b6103d3565b604051901515815260200161007b565b3480156100c057600080fd5b506100ea6100cf3660046109f6565b6001600160a01b031660009081526020819052604090205490565b60405190815260200161007b565b34801561010457600080fd5b506100ea7fca55ce0307ac53917d02c1387bc157c21729fef42093fa6ec5e3cb506dd1fa8281565b34801561013857600080fd5b506100ea610147366004610a26565b610493565b34801561015857600080fd5b506100ea610574565b600060606101708585856103d3565b6101c15760405162461bcd60e51b815260206004820181905260248201527f5369676e617475726520646f6573206e6f74206d61746368207265717565737460448201526064015b60405180910390fd5b846040013534146102145760405162461bcd60e51b815260206004820152601c60248201527f56616c756520646f6573206e6f74206d6174636820726571756573740000000060448201526064016101b8565b61022360808601356001610a5b565b60008061023360208901896109f6565b6001600160a01b03166001600160a01b031681526020019081526020016000208190555060008086602001602081019061026d91906109f6565b6001600160a01b03166060880135604089013561028d60c08b018b610a7c565b61029a60208d018d6109f6565b6040516020016102ac93929190610aca565b60408051601f19818403018152908290526102c691610af0565b600060405180830381858888f193505050503d8060008114610304576040519150601f19603f3d011682016040523d82523d6000602084013e610309565b606091505b50909250905061031e603f6060890135610b0c565b5a1161036c5760405162461bcd60e51b815260206004820152601e60248201527f4e6f7420656e6f7567682067617320666f72207468652072657175657374000060448201526064016101b8565b7f8d164b427e1fdbcdd4488310c98a30b974353972048528fdd1c459fe0961b2c761039a60208901896109f6565b604080516001600160a01b03909216825260808a013560208301528415159082015260600160405180910390a190969095509350505050565b6000608084013581806103e960208801886109f6565b6001600160a01b03166001600160a01b031681526020019081526020016000205414801561041b5750428460a0013510155b801561048b575061042f60208501856109f6565b6001600160a01b031661048061044486610493565b85858080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061058392505050565b6001600160a01b0316145b949350505050565b600061056e7fca55ce0307ac53917d02c1387bc157c21729fef42093fa6ec5e3cb506dd1fa826104c660208501856109f6565b6104d660408601602087016109f6565b60408601356060870135608088013560a08901356104f760c08b018b610a7c565b604051610505929190610b2e565b6040805191829003822060208301999099526001600160a01b0397881690820152959094166060860152608085019290925260a084015260c083015260e082015261010081019190915261012001604051602081830303815290604052805190602001206105fe565b92915050565b600061057e61064c565b905090565b600081516041146105d65760405162461bcd60e51b815260206004820152601f60248201527f45434453413a20696e76616c6964207369676e6174757265206c656e6774680060448201526064016101b8565b60208201516040830151606084015160001a6105f48682858561073e565b9695505050505050565b600061056e61060b61064c565b8360405161190160f01b6020820152602281018390526042810182905260009060620160405160208183030381529060405280519060200120905092915050565b60007f0000000000000000000000000000000000000000000000000000000000000000460361069a57507f000000000000000000000000000000000000000000000000000000000000000090565b50604080517f00000000000000000000000000000000000000000000000000000000000000006020808301919091527f0000000000000000000000000000000000000000000000000000000000000000828401527f000000000000000000000000000000000000000000000000000000000000000060608301524660808301523060a0808401919091528351808403909101815260c0909201909252805191012090565b60007f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a08211156107bb5760405162461bcd60e51b815260206004820152602260248201527f45434453413a20696e76616c6964207369676e6174757265202773272076616c604482015261756560f01b60648201526084016101b8565b8360ff16601b14806107d057508360ff16601c145b6108275760405162461bcd60e51b815260206004820152602260248201527f45434453413a20696e76616c6964207369676e6174757265202776272076616c604482015261756560f01b60648201526084016101b8565b6040805160008082526020820180845288905260ff871692820192909252606081018590526080810184905260019060a0016020604051602081039080840390855afa15801561087b573d6000803e3d6000fd5b5050604051601f1901519150506001600160a01b0381166108de5760405162461bcd60e51b815260206004820152601860248201527f45434453413a20696e76616c6964207369676e6174757265000000000000000060448201526064016101b8565b95945050505050565b600060e082840312156108f957600080fd5b50919050565b60008060006040848603121561091457600080fd5b833567ffffffffffffffff8082111561092c57600080fd5b610938878388016108e7565b9450602086013591508082111561094e57600080fd5b818601915086601f83011261096257600080fd5b81358181111561097157600080fd5b87602082850101111561098357600080fd5b6020830194508093505050509250925092565b60005b838110156109b1578181015183820152602001610999565b50506000910152565b821515815260406020820152600082518060408401526109e1816060850160208701610996565b601f01601f1916919091016060019392505050565b600060208284031215610a0857600080fd5b81356001600160a01b0381168114610a1f57600080fd5b9392505050565b600060208284031215610a3857600080fd5b813567ffffffffffffffff811115610a4f57600080fd5b61048b848285016108e7565b8082018082111561056e57634e487b7160e01b600052601160045260246000fd5b6000808335601e19843603018112610a9357600080fd5b83018035915067ffffffffffffffff821115610aae57600080fd5b602001915036819003821315610ac357600080fd5b9250929050565b8284823760609190911b6bffffffffffffffffffffffff19169101908152601401919050565b60008251610b02818460208701610996565b9190910192915050565b600082610b2957634e487b7160e01b600052601260045260246000fd5b500490565b818382376000910190815291905056fea264697066735822122036d3b0acbb518e8db5bdd59a035b13ac3d857539f6fa579b126e21381e6f248464736f6c63430008150033",
+}
+
+// ForwarderABI is the input ABI used to generate the binding from.
+// Deprecated: Use ForwarderMetaData.ABI instead.
+var ForwarderABI = ForwarderMetaData.ABI
+
+// ForwarderBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ForwarderMetaData.Bin instead.
+var ForwarderBin = ForwarderMetaData.Bin
+
+// DeployForwarder deploys a new Ethereum contract, binding an instance of Forwarder to it.
+func DeployForwarder(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Forwarder, error) {
+	parsed, err := ForwarderMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ForwarderBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Forwarder{ForwarderCaller: ForwarderCaller{contract: contract}, ForwarderTransactor: ForwarderTransactor{contract: contract}, ForwarderFilterer: ForwarderFilterer{contract: contract}}, nil
+}
+
+// Forwarder is an auto generated Go binding around an Ethereum contract.
+type Forwarder struct {
+	ForwarderCaller     // Read-only binding to the contract
+	ForwarderTransactor // Write-only binding to the contract
+	ForwarderFilterer   // Log filterer for contract events
+}
+
+// ForwarderCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ForwarderCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ForwarderTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ForwarderTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ForwarderFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ForwarderFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ForwarderSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ForwarderSession struct {
+	Contract     *Forwarder        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ForwarderCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ForwarderCallerSession struct {
+	Contract *ForwarderCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// ForwarderTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ForwarderTransactorSession struct {
+	Contract     *ForwarderTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// ForwarderRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ForwarderRaw struct {
+	Contract *Forwarder // Generic contract binding to access the raw methods on
+}
+
+// ForwarderCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ForwarderCallerRaw struct {
+	Contract *ForwarderCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ForwarderTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ForwarderTransactorRaw struct {
+	Contract *ForwarderTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewForwarder creates a new instance of Forwarder, bound to a specific deployed contract.
+func NewForwarder(address common.Address, backend bind.ContractBackend) (*Forwarder, error) {
+	contract, err := bindForwarder(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Forwarder{ForwarderCaller: ForwarderCaller{contract: contract}, ForwarderTransactor: ForwarderTransactor{contract: contract}, ForwarderFilterer: ForwarderFilterer{contract: contract}}, nil
+}
+
+// NewForwarderCaller creates a new read-only instance of Forwarder, bound to a specific deployed contract.
+func NewForwarderCaller(address common.Address, caller bind.ContractCaller) (*ForwarderCaller, error) {
+	contract, err := bindForwarder(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ForwarderCaller{contract: contract}, nil
+}
+
+// NewForwarderTransactor creates a new write-only instance of Forwarder, bound to a specific deployed contract.
+func NewForwarderTransactor(address common.Address, transactor bind.ContractTransactor) (*ForwarderTransactor, error) {
+	contract, err := bindForwarder(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ForwarderTransactor{contract: contract}, nil
+}
+
+// NewForwarderFilterer creates a new log filterer instance of Forwarder, bound to a specific deployed contract.
+func NewForwarderFilterer(address common.Address, filterer bind.ContractFilterer) (*ForwarderFilterer, error) {
+	contract, err := bindForwarder(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ForwarderFilterer{contract: contract}, nil
+}
+
+// bindForwarder binds a generic wrapper to an already deployed contract.
+func bindForwarder(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ForwarderABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Forwarder *ForwarderRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Forwarder.Contract.ForwarderCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Forwarder *ForwarderRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Forwarder.Contract.ForwarderTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Forwarder *ForwarderRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Forwarder.Contract.ForwarderTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Forwarder *ForwarderCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Forwarder.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Forwarder *ForwarderTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Forwarder.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Forwarder *ForwarderTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Forwarder.Contract.contract.Transact(opts, method, params...)
+}
+
+// FORWARDREQUESTTYPEHASH is a free data retrieval call binding the contract method 0x95630968.
+//
+// Solidity: function FORWARD_REQUEST_TYPEHASH() view returns(bytes32)
+func (_Forwarder *ForwarderCaller) FORWARDREQUESTTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Forwarder.contract.Call(opts, &out, "FORWARD_REQUEST_TYPEHASH")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// FORWARDREQUESTTYPEHASH is a free data retrieval call binding the contract method 0x95630968.
+//
+// Solidity: function FORWARD_REQUEST_TYPEHASH() view returns(bytes32)
+func (_Forwarder *ForwarderSession) FORWARDREQUESTTYPEHASH() ([32]byte, error) {
+	return _Forwarder.Contract.FORWARDREQUESTTYPEHASH(&_Forwarder.CallOpts)
+}
+
+// FORWARDREQUESTTYPEHASH is a free data retrieval call binding the contract method 0x95630968.
+//
+// Solidity: function FORWARD_REQUEST_TYPEHASH() view returns(bytes32)
+func (_Forwarder *ForwarderCallerSession) FORWARDREQUESTTYPEHASH() ([32]byte, error) {
+	return _Forwarder.Contract.FORWARDREQUESTTYPEHASH(&_Forwarder.CallOpts)
+}
+
+// DomainSeparator is a free data retrieval call binding the contract method 0xf698da25.
+//
+// Solidity: function domainSeparator() view returns(bytes32)
+func (_Forwarder *ForwarderCaller) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Forwarder.contract.Call(opts, &out, "domainSeparator")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DomainSeparator is a free data retrieval call binding the contract method 0xf698da25.
+//
+// Solidity: function domainSeparator() view returns(bytes32)
+func (_Forwarder *ForwarderSession) DomainSeparator() ([32]byte, error) {
+	return _Forwarder.Contract.DomainSeparator(&_Forwarder.CallOpts)
+}
+
+// DomainSeparator is a free data retrieval call binding the contract method 0xf698da25.
+//
+// Solidity: function domainSeparator() view returns(bytes32)
+func (_Forwarder *ForwarderCallerSession) DomainSeparator() ([32]byte, error) {
+	return _Forwarder.Contract.DomainSeparator(&_Forwarder.CallOpts)
+}
+
+// GetNonce is a free data retrieval call binding the contract method 0x2d0335ab.
+//
+// Solidity: function getNonce(address _from) view returns(uint256)
+func (_Forwarder *ForwarderCaller) GetNonce(opts *bind.CallOpts, _from common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Forwarder.contract.Call(opts, &out, "getNonce", _from)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetNonce is a free data retrieval call binding the contract method 0x2d0335ab.
+//
+// Solidity: function getNonce(address _from) view returns(uint256)
+func (_Forwarder *ForwarderSession) GetNonce(_from common.Address) (*big.Int, error) {
+	return _Forwarder.Contract.GetNonce(&_Forwarder.CallOpts, _from)
+}
+
+// GetNonce is a free data retrieval call binding the contract method 0x2d0335ab.
+//
+// Solidity: function getNonce(address _from) view returns(uint256)
+func (_Forwarder *ForwarderCallerSession) GetNonce(_from common.Address) (*big.Int, error) {
+	return _Forwarder.Contract.GetNonce(&_Forwarder.CallOpts, _from)
+}
+
+// HashRequest is a free data retrieval call binding the contract method 0xac1057fe.
+//
+// Solidity: function hashRequest((address,address,uint256,uint256,uint256,uint256,bytes) _request) view returns(bytes32)
+func (_Forwarder *ForwarderCaller) HashRequest(opts *bind.CallOpts, _request ForwarderForwardRequest) ([32]byte, error) {
+	var out []interface{}
+	err := _Forwarder.contract.Call(opts, &out, "hashRequest", _request)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// HashRequest is a free data retrieval call binding the contract method 0xac1057fe.
+//
+// Solidity: function hashRequest((address,address,uint256,uint256,uint256,uint256,bytes) _request) view returns(bytes32)
+func (_Forwarder *ForwarderSession) HashRequest(_request ForwarderForwardRequest) ([32]byte, error) {
+	return _Forwarder.Contract.HashRequest(&_Forwarder.CallOpts, _request)
+}
+
+// HashRequest is a free data retrieval call binding the contract method 0xac1057fe.
+//
+// Solidity: function hashRequest((address,address,uint256,uint256,uint256,uint256,bytes) _request) view returns(bytes32)
+func (_Forwarder *ForwarderCallerSession) HashRequest(_request ForwarderForwardRequest) ([32]byte, error) {
+	return _Forwarder.Contract.HashRequest(&_Forwarder.CallOpts, _request)
+}
+
+// Verify is a free data retrieval call binding the contract method 0x12342287.
+//
+// Solidity: function verify((address,address,uint256,uint256,uint256,uint256,bytes) _request, bytes _signature) view returns(bool)
+func (_Forwarder *ForwarderCaller) Verify(opts *bind.CallOpts, _request ForwarderForwardRequest, _signature []byte) (bool, error) {
+	var out []interface{}
+	err := _Forwarder.contract.Call(opts, &out, "verify", _request, _signature)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Verify is a free data retrieval call binding the contract method 0x12342287.
+//
+// Solidity: function verify((address,address,uint256,uint256,uint256,uint256,bytes) _request, bytes _signature) view returns(bool)
+func (_Forwarder *ForwarderSession) Verify(_request ForwarderForwardRequest, _signature []byte) (bool, error) {
+	return _Forwarder.Contract.Verify(&_Forwarder.CallOpts, _request, _signature)
+}
+
+// Verify is a free data retrieval call binding the contract method 0x12342287.
+//
+// Solidity: function verify((address,address,uint256,uint256,uint256,uint256,bytes) _request, bytes _signature) view returns(bool)
+func (_Forwarder *ForwarderCallerSession) Verify(_request ForwarderForwardRequest, _signature []byte) (bool, error) {
+	return _Forwarder.Contract.Verify(&_Forwarder.CallOpts, _request, _signature)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0x0d9ede45.
+//
+// Solidity: function execute((address,address,uint256,uint256,uint256,uint256,bytes) _request, bytes _signature) payable returns(bool, bytes)
+func (_Forwarder *ForwarderTransactor) Execute(opts *bind.TransactOpts, _request ForwarderForwardRequest, _signature []byte) (*types.Transaction, error) {
+	return _Forwarder.contract.Transact(opts, "execute", _request, _signature)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0x0d9ede45.
+//
+// Solidity: function execute((address,address,uint256,uint256,uint256,uint256,bytes) _request, bytes _signature) payable returns(bool, bytes)
+func (_Forwarder *ForwarderSession) Execute(_request ForwarderForwardRequest, _signature []byte) (*types.Transaction, error) {
+	return _Forwarder.Contract.Execute(&_Forwarder.TransactOpts, _request, _signature)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0x0d9ede45.
+//
+// Solidity: function execute((address,address,uint256,uint256,uint256,uint256,bytes) _request, bytes _signature) payable returns(bool, bytes)
+func (_Forwarder *ForwarderTransactorSession) Execute(_request ForwarderForwardRequest, _signature []byte) (*types.Transaction, error) {
+	return _Forwarder.Contract.Execute(&_Forwarder.TransactOpts, _request, _signature)
+}
+
+// ForwarderExecutedIterator is returned from FilterExecuted and is used to iterate over the raw logs and unpacked data for Executed events raised by the Forwarder contract.
+type ForwarderExecutedIterator struct {
+	Event *ForwarderExecuted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ForwarderExecutedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ForwarderExecuted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ForwarderExecuted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ForwarderExecutedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ForwarderExecutedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ForwarderExecuted represents a Executed event raised by the Forwarder contract.
+type ForwarderExecuted struct {
+	From    common.Address
+	Nonce   *big.Int
+	Success bool
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterExecuted is a free log retrieval operation binding the contract event 0x8d164b427e1fdbcdd4488310c98a30b974353972048528fdd1c459fe0961b2c7.
+//
+// Solidity: event Executed(address _from, uint256 _nonce, bool _success)
+func (_Forwarder *ForwarderFilterer) FilterExecuted(opts *bind.FilterOpts) (*ForwarderExecutedIterator, error) {
+
+	logs, sub, err := _Forwarder.contract.FilterLogs(opts, "Executed")
+	if err != nil {
+		return nil, err
+	}
+	return &ForwarderExecutedIterator{contract: _Forwarder.contract, event: "Executed", logs: logs, sub: sub}, nil
+}
+
+// WatchExecuted is a free log subscription operation binding the contract event 0x8d164b427e1fdbcdd4488310c98a30b974353972048528fdd1c459fe0961b2c7.
+//
+// Solidity: event Executed(address _from, uint256 _nonce, bool _success)
+func (_Forwarder *ForwarderFilterer) WatchExecuted(opts *bind.WatchOpts, sink chan<- *ForwarderExecuted) (event.Subscription, error) {
+
+	logs, sub, err := _Forwarder.contract.WatchLogs(opts, "Executed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ForwarderExecuted)
+				if err := _Forwarder.contract.UnpackLog(event, "Executed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExecuted is a log parse operation binding the contract event 0x8d164b427e1fdbcdd4488310c98a30b974353972048528fdd1c459fe0961b2c7.
+//
+// Solidity: event Executed(address _from, uint256 _nonce, bool _success)
+func (_Forwarder *ForwarderFilterer) ParseExecuted(log types.Log) (*ForwarderExecuted, error) {
+	event := new(ForwarderExecuted)
+	if err := _Forwarder.contract.UnpackLog(event, "Executed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
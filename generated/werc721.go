@@ -4,6 +4,7 @@
 package generated
 
 import (
+	"errors"
 	"math/big"
 	"strings"
 
@@ -17,6 +18,7 @@ import (
 
 // Reference imports to suppress errors if they are not otherwise used.
 var (
+	_ = errors.New
 	_ = big.NewInt
 	_ = strings.NewReader
 	_ = ethereum.NotFound
@@ -26,20 +28,37 @@ var (
 	_ = event.NewSubscription
 )
 
+// WERC721WrappedToken is an auto generated low-level Go binding around an user-defined struct.
+type WERC721WrappedToken struct {
+	TokenAddress common.Address
+	TokenId      *big.Int
+}
+
+// WERC721MetaData contains all meta data concerning the WERC721 contract.
+var WERC721MetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_minters\",\"type\":\"address[]\"},{\"internalType\":\"string\",\"name\":\"_name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_symbol\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_trustedForwarder\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"AdminRoleOfferCancelled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_offeredBy\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"_handover\",\"type\":\"bool\"}],\"name\":\"AdminRoleOffered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_fromTokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_toTokenId\",\"type\":\"uint256\"}],\"name\":\"BatchMetadataUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"}],\"name\":\"MetadataUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferStarted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"_isExempt\",\"type\":\"bool\"}],\"name\":\"PauseExemptionChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Paused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"name\":\"RoleAdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_forwarder\",\"type\":\"address\"}],\"name\":\"TrustedForwarderChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Unpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_tokenAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_wrappedTokenId\",\"type\":\"uint256\"}],\"name\":\"Unwrapped\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_tokenAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_wrappedTokenId\",\"type\":\"uint256\"}],\"name\":\"Wrapped\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DEFAULT_ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GUARDIAN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MINTER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"acceptAdminRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"acceptOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"cancelAdminRoleOffer\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAdminRoleOffer\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"_offeredBy\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"_handover\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleAdmin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"getRoleMember\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleMemberCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_ownerOfTokens\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_offset\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_limit\",\"type\":\"uint256\"}],\"name\":\"getTokensOfOwner\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTrustedForwarder\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_wrappedTokenId\",\"type\":\"uint256\"}],\"name\":\"getWrappedToken\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"tokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"internalType\":\"structWERC721.WrappedToken\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_tokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"}],\"name\":\"getWrappedTokenId\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"grantRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"hasRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isGuardian\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isPauseExempt\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_forwarder\",\"type\":\"address\"}],\"name\":\"isTrustedForwarder\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_data\",\"type\":\"string\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_to\",\"type\":\"address[]\"},{\"internalType\":\"string[]\",\"name\":\"_data\",\"type\":\"string[]\"}],\"name\":\"mintBatch\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_from\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"name\":\"onERC721Received\",\"outputs\":[{\"internalType\":\"bytes4\",\"name\":\"\",\"type\":\"bytes4\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"paused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pendingOwner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"renounceRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"revokeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_newBaseURI\",\"type\":\"string\"}],\"name\":\"setBaseURI\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"_isExempt\",\"type\":\"bool\"}],\"name\":\"setPauseExempt\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"_data\",\"type\":\"string\"}],\"name\":\"setTokenData\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"_tokenURI\",\"type\":\"string\"}],\"name\":\"setTokenURI\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_forwarder\",\"type\":\"address\"}],\"name\":\"setTrustedForwarder\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"_interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"tokenByIndex\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"tokenOfOwnerByIndex\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"tokensData\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_ownerOfTokens\",\"type\":\"address\"}],\"name\":\"tokensOfOwner\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalMinted\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_newAdmin\",\"type\":\"address\"}],\"name\":\"transferAdminRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_wrappedTokenId\",\"type\":\"uint256\"}],\"name\":\"unwrap\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_tokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"}],\"name\":\"wrap\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x60806040523480156200001157600080fd5b50604051620052aa380380620052aa833981016040819052620000349162000565565b8083836200004b62000045620001a1565b620001b2565b60026200005983826200070e565b5060036200006882826200070e565b5050600e805460ff191690555062000080816200020c565b506200009c6000805160206200528a8339815191528062000268565b620000c66000805160206200526a8339815191526000805160206200528a83398151915262000268565b620001017f55435dd261a4b9b3364963f7738a7a662ad9c84396d64be3365284bb7f0a50416000805160206200528a83398151915262000268565b6200011c6000805160206200528a83398151915233620002bc565b620001376000805160206200526a83398151915233620002bc565b60005b84518110156200019657620001816000805160206200526a8339815191528683815181106200016d576200016d620007da565b6020026020010151620002bc60201b60201c565b806200018d81620007f0565b9150506200013a565b505050505062000818565b6000620001ad620002e7565b905090565b600080546001600160a01b038381166001600160a01b031980841682178555600180549091169055604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b600e8054610100600160a81b0319166101006001600160a01b038416908102919091179091556040519081527f871264f4293af7d2865ae7eae628b228f4991c57cb45b39c99f0b774ebe290189060200160405180910390a150565b6000828152600c6020526040902060010154819060405184907fbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff90600090a46000918252600c602052604090912060010155565b620002c882826200031a565b6000828152600d60205260409020620002e290826200032a565b505050565b6000620002f4336200034a565b801562000302575060143610155b1562000315575060131936013560601c90565b503390565b6200032682826200037a565b5050565b600062000341836001600160a01b03841662000420565b90505b92915050565b60006001600160a01b0382161580159062000344575050600e5461010090046001600160a01b0390811691161490565b6000828152600c602090815260408083206001600160a01b038516845290915290205460ff1662000326576000828152600c602090815260408083206001600160a01b03851684529091529020805460ff19166001179055620003dc620001a1565b6001600160a01b0316816001600160a01b0316837f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a45050565b6000818152600183016020526040812054620004695750815460018181018455600084815260208082209093018490558454848252828601909352604090209190915562000344565b50600062000344565b634e487b7160e01b600052604160045260246000fd5b604051601f8201601f191681016001600160401b0381118282101715620004b357620004b362000472565b604052919050565b80516001600160a01b0381168114620004d357600080fd5b919050565b600082601f830112620004ea57600080fd5b81516001600160401b0381111562000506576200050662000472565b60206200051c601f8301601f1916820162000488565b82815285828487010111156200053157600080fd5b60005b838110156200055157858101830151828201840152820162000534565b506000928101909101919091529392505050565b600080600080608085870312156200057c57600080fd5b84516001600160401b03808211156200059457600080fd5b818701915087601f830112620005a957600080fd5b8151602082821115620005c057620005c062000472565b8160051b620005d182820162000488565b928352848101820192828101908c851115620005ec57600080fd5b958301955b8487101562000615576200060587620004bb565b82529583019590830190620005f1565b928b0151929950919450505050808211156200063057600080fd5b6200063e88838901620004d8565b945060408701519150808211156200065557600080fd5b506200066487828801620004d8565b9250506200067560608601620004bb565b905092959194509250565b600181811c908216806200069557607f821691505b602082108103620006b657634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002e257600081815260208120601f850160051c81016020861015620006e55750805b601f850160051c820191505b818110156200070657828155600101620006f1565b505050505050565b81516001600160401b038111156200072a576200072a62000472565b62000742816200073b845462000680565b84620006bc565b602080601f8311600181146200077a5760008415620007615750858301515b600019600386901b1c1916600185901b17855562000706565b600085815260208120601f198616915b82811015620007ab578886015182559484019460019091019084016200078a565b5085821015620007ca5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b634e487b7160e01b600052603260045260246000fd5b6000600182016200081157634e487b7160e01b600052601160045260246000fd5b5060010190565b614a4280620008286000396000f3fe608060405234801561001057600080fd5b50600436106103a45760003560e01c806379c97f63116101e9578063b8cac62b1161010f578063d5391393116100ad578063e30c39781161007c578063e30c3978146108ee578063e985e9c5146108ff578063f2fde38b1461093b578063f61a26991461094e57600080fd5b8063d5391393146108a0578063d547741f146108b5578063da742228146108c8578063de0e9a3e146108db57600080fd5b8063ca15c873116100e9578063ca15c8731461082e578063ce1b815f14610841578063d0def52114610857578063d19d77701461086a57600080fd5b8063b8cac62b1461078f578063bf376c7a14610808578063c87b56dd1461081b57600080fd5b806391d1485411610187578063a22cb46511610156578063a22cb4651461074e578063a2309ff814610761578063ada8f91914610769578063b88d4fde1461077c57600080fd5b806391d1485414610718578063924cff6d1461072b57806395d89b411461073e578063a217fddf1461074657600080fd5b806389edc438116101c357806389edc438146106d95780638c3d7301146106ec5780638da5cb5b146106f45780639010d07c1461070557600080fd5b806379c97f631461069e5780638456cb59146106b15780638462151c146106b957600080fd5b80633f4ba83a116102ce578063572b6c051161026c57806370a082311161023b57806370a0823114610666578063715018a61461067957806375b238fc1461068157806379ba50971461069657600080fd5b8063572b6c0514610622578063599ed3ff146106355780635c975abb146106485780636352211e1461065357600080fd5b806342966c68116102a857806342966c68146105d657806343afb798146105e95780634f6ccce7146105fc57806355f804b31461060f57600080fd5b80633f4ba83a1461058f57806342842e0e146105975780634294dd2a146105aa57600080fd5b806318160ddd1161034657806326a704881161031557806326a70488146104e75780632f2ff15d146105565780632f745c591461056957806336568abe1461057c57600080fd5b806318160ddd1461047857806323b872dd1461048a578063248a9ca31461049d57806324ea54f4146104c057600080fd5b8063095ea7b311610382578063095ea7b3146104115780630c68ba2114610426578063150b7a0214610439578063162094c41461046557600080fd5b806301ffc9a7146103a957806306fdde03146103d1578063081812fc146103e6575b600080fd5b6103bc6103b7366004613da4565b610961565b60405190151581526020015b60405180910390f35b6103d961098c565b6040516103c89190613e11565b6103f96103f4366004613e24565b610a1e565b6040516001600160a01b0390911681526020016103c8565b61042461041f366004613e52565b610aab565b005b6103bc610434366004613e7e565b610bd2565b61044c610447366004613e9b565b610c1c565b6040516001600160e01b031990911681526020016103c8565b610424610473366004614004565b610e02565b600a545b6040519081526020016103c8565b61042461049836600461404a565b610eb5565b61047c6104ab366004613e24565b6000908152600c602052604090206001015490565b61047c7f55435dd261a4b9b3364963f7738a7a662ad9c84396d64be3365284bb7f0a504181565b6105376104f5366004613e7e565b6001600160a01b03908116600090815260166020908152604091829020825180840190935254928316808352600160a01b90930460ff16151591018190529091565b604080516001600160a01b0390931683529015156020830152016103c8565b61042461056436600461408b565b610eed565b61047c610577366004613e52565b610f1b565b61042461058a36600461408b565b610fb1565b61042461103a565b6104246105a536600461404a565b611081565b6103bc6105b8366004613e7e565b6001600160a01b031660009081526015602052604090205460ff1690565b6104246105e4366004613e24565b61109c565b6104246105f73660046140bb565b611195565b61047c61060a366004613e24565b61122d565b61042461061d3660046140ee565b6112c0565b6103bc610630366004613e7e565b61134c565b6103d9610643366004613e24565b61137b565b600e5460ff166103bc565b6103f9610661366004613e24565b611415565b61047c610674366004613e7e565b61148c565b610424611513565b61047c6000805160206149ed83398151915281565b610424611598565b6104246106ac366004613e7e565b61161f565b610424611715565b6106cc6106c7366004613e7e565b611768565b6040516103c89190614122565b6106cc6106e7366004614166565b611779565b61042461185d565b6000546001600160a01b03166103f9565b6103f961071336600461419b565b6119d6565b6103bc61072636600461408b565b6119ee565b61042461073936600461426a565b611a19565b6103d9611b13565b61047c600081565b61042461075c3660046140bb565b611b22565b60145461047c565b610424610777366004613e7e565b611c23565b61042461078a366004614321565b611c2e565b6107e461079d366004613e24565b604080518082019091526000808252602082015250600090815260116020908152604091829020825180840190935280546001600160a01b03168352600101549082015290565b6040805182516001600160a01b0316815260209283015192810192909252016103c8565b61047c610816366004613e52565b611c67565b6103d9610829366004613e24565b611d10565b61047c61083c366004613e24565b611fbd565b600e5461010090046001600160a01b03166103f9565b6104246108653660046143a0565b611fd4565b61047c610878366004613e52565b6001600160a01b03919091166000908152601260209081526040808320938352929052205490565b61047c6000805160206149cd83398151915281565b6104246108c336600461408b565b61201a565b6104246108d6366004613e7e565b6120a3565b6104246108e9366004613e24565b6120e8565b6001546001600160a01b03166103f9565b6103bc61090d3660046143d9565b6001600160a01b03918216600090815260076020908152604080832093909416825291909152205460ff1690565b610424610949366004613e7e565b612310565b61042461095c366004614004565b6123da565b60006001600160e01b03198216632483248360e11b1480610986575061098682612453565b92915050565b60606002805461099b90614407565b80601f01602080910402602001604051908101604052809291908181526020018280546109c790614407565b8015610a145780601f106109e957610100808354040283529160200191610a14565b820191906000526020600020905b8154815290600101906020018083116109f757829003601f168201915b5050505050905090565b6000610a2982612478565b610a8f5760405162461bcd60e51b815260206004820152602c60248201527f4552433732313a20617070726f76656420717565727920666f72206e6f6e657860448201526b34b9ba32b73a103a37b5b2b760a11b60648201526084015b60405180910390fd5b506000908152600660205260409020546001600160a01b031690565b6000610ab682611415565b9050806001600160a01b0316836001600160a01b031603610b235760405162461bcd60e51b815260206004820152602160248201527f4552433732313a20617070726f76616c20746f2063757272656e74206f776e656044820152603960f91b6064820152608401610a86565b806001600160a01b0316610b35612495565b6001600160a01b03161480610b515750610b518161090d612495565b610bc35760405162461bcd60e51b815260206004820152603860248201527f4552433732313a20617070726f76652063616c6c6572206973206e6f74206f7760448201527f6e6572206e6f7220617070726f76656420666f7220616c6c00000000000000006064820152608401610a86565b610bcd83836124a4565b505050565b6000610bfe7f55435dd261a4b9b3364963f7738a7a662ad9c84396d64be3365284bb7f0a5041836119ee565b8061098657506109866000805160206149ed833981519152836119ee565b6000303303610c775760405162461bcd60e51b815260206004820152602160248201527f5772617070656420746f6b656e732063616e206e6f74206265207772617070656044820152601960fa1b6064820152608401610a86565b6040516331a9108f60e11b81526004810185905230903390636352211e90602401602060405180830381865afa158015610cb5573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610cd99190614441565b6001600160a01b031614610d2f5760405162461bcd60e51b815260206004820152601e60248201527f546f6b656e20686173206e6f74206265656e207472616e7366657272656400006044820152606401610a86565b6000610d4a8660405180602001604052806000815250612512565b6040805180820182523380825260208083018a8152600086815260118352858120945185546001600160a01b0319166001600160a01b039182161786559151600190950194909455828452601282528484208b85528252928490208590558351928b168352820152908101879052606081018290529091507f9030e93f976e327ab5ef1166d3fe5cfb0820f381770421bbfef5bc656fa156879060800160405180910390a150630a85bd0160e11b9695505050505050565b610e0a612495565b610e226000805160206149ed833981519152826119ee565b610e3e5760405162461bcd60e51b8152600401610a869061445e565b610e4783612478565b610e635760405162461bcd60e51b8152600401610a8690614487565b6000838152601060205260409020610e7b8382614503565b506040518381527ff8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7906020015b60405180910390a1505050565b610ec6610ec0612495565b82612556565b610ee25760405162461bcd60e51b8152600401610a86906145c2565b610bcd838383612640565b6000805160206149ed8339815191528214610f1057610f0c82826127eb565b5050565b610f0c81600061280d565b6000610f268361148c565b8210610f885760405162461bcd60e51b815260206004820152602b60248201527f455243373231456e756d657261626c653a206f776e657220696e646578206f7560448201526a74206f6620626f756e647360a81b6064820152608401610a86565b506001600160a01b03919091166000908152600860209081526040808320938352929052205490565b6000805160206149ed83398151915282141580610fe457506001610fe26000805160206149ed833981519152611fbd565b115b6110305760405162461bcd60e51b815260206004820152601d60248201527f43616e206e6f742072656d6f766520746865206c6173742061646d696e0000006044820152606401610a86565b610f0c82826129d5565b611042612495565b61105a6000805160206149ed833981519152826119ee565b6110765760405162461bcd60e51b8152600401610a869061445e565b61107e6129f7565b50565b610bcd83838360405180602001604052806000815250611c2e565b6110a7610ec0612495565b6110ef5760405162461bcd60e51b8152602060048201526019602482015278125cc81b9bdd081bdddb995c881b9bdc88185c1c1c9bdd9959603a1b6044820152606401610a86565b6000818152601160205260409020546001600160a01b03161561115e5760405162461bcd60e51b815260206004820152602160248201527f5772617070656420746f6b656e2073686f756c6420626520756e7772617070656044820152601960fa1b6064820152608401610a86565b61116781612a90565b6000818152600f6020526040812061117e91613d40565b600081815260106020526040812061107e91613d40565b61119d612495565b6111b56000805160206149ed833981519152826119ee565b6111d15760405162461bcd60e51b8152600401610a869061445e565b6001600160a01b038316600081815260156020908152604091829020805460ff19168615159081179091558251938452908301527f20e39ebaeba8bdfdbd096d13c9b4e40d4629c6d6bc79cc82ad642fd131ce94369101610ea8565b6000611238600a5490565b821061129b5760405162461bcd60e51b815260206004820152602c60248201527f455243373231456e756d657261626c653a20676c6f62616c20696e646578206f60448201526b7574206f6620626f756e647360a01b6064820152608401610a86565b600a82815481106112ae576112ae614613565b90600052602060002001549050919050565b6112c8612495565b6112e06000805160206149ed833981519152826119ee565b6112fc5760405162461bcd60e51b8152600401610a869061445e565b60136113088382614503565b50601454604080516001815260208101929092527f6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c91015b60405180910390a15050565b60006001600160a01b03821615801590610986575050600e5461010090046001600160a01b0390811691161490565b600f602052600090815260409020805461139490614407565b80601f01602080910402602001604051908101604052809291908181526020018280546113c090614407565b801561140d5780601f106113e25761010080835404028352916020019161140d565b820191906000526020600020905b8154815290600101906020018083116113f057829003601f168201915b505050505081565b6000818152600460205260408120546001600160a01b0316806109865760405162461bcd60e51b815260206004820152602960248201527f4552433732313a206f776e657220717565727920666f72206e6f6e657869737460448201526832b73a103a37b5b2b760b91b6064820152608401610a86565b60006001600160a01b0382166114f75760405162461bcd60e51b815260206004820152602a60248201527f4552433732313a2062616c616e636520717565727920666f7220746865207a65604482015269726f206164647265737360b01b6064820152608401610a86565b506001600160a01b031660009081526005602052604090205490565b61151b612495565b6001600160a01b03166115366000546001600160a01b031690565b6001600160a01b03161461158c5760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65726044820152606401610a86565b6115966000612b37565b565b6115a0612495565b6001546001600160a01b0390811691161461160f5760405162461bcd60e51b815260206004820152602960248201527f4f776e61626c6532537465703a2063616c6c6572206973206e6f7420746865206044820152683732bb9037bbb732b960b91b6064820152608401610a86565b61159661161a612495565b612b37565b611627612495565b61163f6000805160206149ed833981519152826119ee565b61165b5760405162461bcd60e51b8152600401610a869061445e565b6001600160a01b03828116600090815260166020526040902054166116be5760405162461bcd60e51b815260206004820152601960248201527810591b5a5b881c9bdb19481a5cc81b9bdd081bd999995c9959603a1b6044820152606401610a86565b6001600160a01b03821660008181526016602090815260409182902080546001600160a81b031916905590519182527f92f1154ad235f0b053e836ac3ae5025b07c629b7bbf74a1c0884dc83343826709101611340565b611720610434612495565b6117605760405162461bcd60e51b815260206004820152601160248201527024b9903737ba10309033bab0b93234b0b760791b6044820152606401610a86565b611596612b91565b60606109868260006106e78561148c565b606060006117868561148c565b90508084106117a5575050604080516000815260208101909152611856565b6117af8185612c0d565b8311156117c3576117c08185612c0d565b92505b6000836001600160401b038111156117dd576117dd613f39565b604051908082528060200260200182016040528015611806578160200160208202803683370190505b50905060005b8481101561185157611822876105778884612c19565b82828151811061183457611834614613565b6020908102919091010152806118498161463f565b91505061180c565b509150505b9392505050565b60006016600061186b612495565b6001600160a01b0390811682526020808301939093526040918201600020825180840190935254908116808352600160a01b90910460ff1615159282019290925291506118f65760405162461bcd60e51b815260206004820152601960248201527810591b5a5b881c9bdb19481a5cc81b9bdd081bd999995c9959603a1b6044820152606401610a86565b6119126000805160206149ed83398151915282600001516119ee565b61195e5760405162461bcd60e51b815260206004820152601960248201527f41646d696e20726f6c65206f66666572206973207374616c65000000000000006044820152606401610a86565b6016600061196a612495565b6001600160a01b03168152602081019190915260400160002080546001600160a81b03191690556119b06000805160206149ed8339815191526119ab612495565b612c25565b80602001511561107e5761107e6000805160206149ed8339815191528260000151612c2f565b6000828152600d602052604081206118569083612c39565b6000918252600c602090815260408084206001600160a01b0393909316845291905290205460ff1690565b611a21612495565b611a396000805160206149cd833981519152826119ee565b611a555760405162461bcd60e51b8152600401610a8690614658565b8151835114611ab25760405162461bcd60e51b815260206004820152602360248201527f526563697069656e747320616e642064617461206c656e677468206d69736d616044820152620e8c6d60eb1b6064820152608401610a86565b60005b8351811015611b0d57611afa848281518110611ad357611ad3614613565b6020026020010151848381518110611aed57611aed614613565b6020026020010151612512565b5080611b058161463f565b915050611ab5565b50505050565b60606003805461099b90614407565b611b2a612495565b6001600160a01b0316826001600160a01b031603611b8a5760405162461bcd60e51b815260206004820152601960248201527f4552433732313a20617070726f766520746f2063616c6c6572000000000000006044820152606401610a86565b8060076000611b97612495565b6001600160a01b03908116825260208083019390935260409182016000908120918716808252919093529120805460ff191692151592909217909155611bdb612495565b6001600160a01b03167f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c3183604051611c17911515815260200190565b60405180910390a35050565b61107e81600161280d565b611c3f611c39612495565b83612556565b611c5b5760405162461bcd60e51b8152600401610a86906145c2565b611b0d84848484612c45565b6000826001600160a01b03166342842e0e611c80612495565b6040516001600160e01b031960e084901b1681526001600160a01b03909116600482015230602482015260448101859052606401600060405180830381600087803b158015611cce57600080fd5b505af1158015611ce2573d6000803e3d6000fd5b505050506001600160a01b039290921660009081526012602090815260408083209383529290522054919050565b6060611d1b82612478565b611d375760405162461bcd60e51b8152600401610a8690614487565b60008281526010602052604090208054611d5090614407565b159050611df55760008281526010602052604090208054611d7090614407565b80601f0160208091040260200160405190810160405280929190818152602001828054611d9c90614407565b8015611de95780601f10611dbe57610100808354040283529160200191611de9565b820191906000526020600020905b815481529060010190602001808311611dcc57829003601f168201915b50505050509050919050565b60138054611e0290614407565b159050611e3b576013611e1483612c78565b604051602001611e25929190614681565b6040516020818303038152906040529050919050565b600082815260116020908152604091829020825180840190935280546001600160a01b03168084526001909101549183019190915215611ef5578051602082015160405163c87b56dd60e01b81526001600160a01b039092169163c87b56dd91611eab9160040190815260200190565b600060405180830381865afa925050508015611ee957506040513d6000823e601f3d908101601f19168201604052611ee69190810190614708565b60015b15611ef5579392505050565b6000838152600f602052604090208054611f969190611f1390614407565b80601f0160208091040260200160405190810160405280929190818152602001828054611f3f90614407565b8015611f8c5780601f10611f6157610100808354040283529160200191611f8c565b820191906000526020600020905b815481529060010190602001808311611f6f57829003601f168201915b5050505050612d78565b604051602001611fa6919061477e565b604051602081830303815290604052915050919050565b6000818152600d60205260408120610986906130ce565b611fdc612495565b611ff46000805160206149cd833981519152826119ee565b6120105760405162461bcd60e51b8152600401610a8690614658565b611b0d8383612512565b6000805160206149ed8339815191528214158061204d5750600161204b6000805160206149ed833981519152611fbd565b115b6120995760405162461bcd60e51b815260206004820152601d60248201527f43616e206e6f742072656d6f766520746865206c6173742061646d696e0000006044820152606401610a86565b610f0c8282612c2f565b6120ab612495565b6120c36000805160206149ed833981519152826119ee565b6120df5760405162461bcd60e51b8152600401610a869061445e565b610f0c826130d8565b6120f3610ec0612495565b61213b5760405162461bcd60e51b8152602060048201526019602482015278125cc81b9bdd081bdddb995c881b9bdc88185c1c1c9bdd9959603a1b6044820152606401610a86565b600081815260116020908152604091829020825180840190935280546001600160a01b0316808452600190910154918301919091526121b35760405162461bcd60e51b8152602060048201526014602482015273151bdad95b881a5cc81b9bdd081ddc985c1c195960621b6044820152606401610a86565b6121bc82612a90565b6000828152600f602052604081206121d391613d40565b60008281526010602052604081206121ea91613d40565b600082815260116020908152604080832080546001600160a01b031916815560010183905583516001600160a01b039081168452601283528184208584015185529092528220919091558151166342842e0e30612245612495565b60208501516040516001600160e01b031960e086901b1681526001600160a01b0393841660048201529290911660248301526044820152606401600060405180830381600087803b15801561229957600080fd5b505af11580156122ad573d6000803e3d6000fd5b505050507e04d6f644fc2d087d5be8fde32a4db2f8c58d96f5bb217130b5ca6d5af8f21d6122d9612495565b8251602080850151604080516001600160a01b03958616815294909316918401919091529082015260608101849052608001611340565b612318612495565b6001600160a01b03166123336000546001600160a01b031690565b6001600160a01b0316146123895760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65726044820152606401610a86565b600180546001600160a01b0319166001600160a01b0383811691821790925560008054604051929316917f38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e227009190a350565b6123e2612495565b6123fa6000805160206149cd833981519152826119ee565b6124165760405162461bcd60e51b8152600401610a8690614658565b61241f83612478565b61243b5760405162461bcd60e51b8152600401610a8690614487565b6000838152600f60205260409020610e7b8382614503565b60006001600160e01b03198216635a05180f60e01b1480610986575061098682613134565b6000908152600460205260409020546001600160a01b0316151590565b600061249f613159565b905090565b600081815260066020526040902080546001600160a01b0319166001600160a01b03841690811790915581906124d982611415565b6001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92560405160405180910390a45050565b60008061252b6001601454612c1990919063ffffffff16565b60148190556000818152600f6020526040902090915061254b8482614503565b506118568482613188565b600061256182612478565b6125c25760405162461bcd60e51b815260206004820152602c60248201527f4552433732313a206f70657261746f7220717565727920666f72206e6f6e657860448201526b34b9ba32b73a103a37b5b2b760a11b6064820152608401610a86565b60006125cd83611415565b9050806001600160a01b0316846001600160a01b031614806126085750836001600160a01b03166125fd84610a1e565b6001600160a01b0316145b8061263857506001600160a01b0380821660009081526007602090815260408083209388168352929052205460ff165b949350505050565b826001600160a01b031661265382611415565b6001600160a01b0316146126bb5760405162461bcd60e51b815260206004820152602960248201527f4552433732313a207472616e73666572206f6620746f6b656e2074686174206960448201526839903737ba1037bbb760b91b6064820152608401610a86565b6001600160a01b03821661271d5760405162461bcd60e51b8152602060048201526024808201527f4552433732313a207472616e7366657220746f20746865207a65726f206164646044820152637265737360e01b6064820152608401610a86565b6127288383836131a2565b6127336000826124a4565b6001600160a01b038316600090815260056020526040812080546001929061275c9084906147c3565b90915550506001600160a01b038216600090815260056020526040812080546001929061278a9084906147d6565b909155505060008181526004602052604080822080546001600160a01b0319166001600160a01b0386811691821790925591518493918716917fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef91a4505050565b6127f58282613223565b6000828152600d60205260409020610bcd9082613250565b612815612495565b61282d6000805160206149ed833981519152826119ee565b6128495760405162461bcd60e51b8152600401610a869061445e565b6001600160a01b0383166128b95760405162461bcd60e51b815260206004820152603160248201527f41646d696e20726f6c652063616e206e6f74206265206f66666572656420746f60448201527020746865207a65726f206164647265737360781b6064820152608401610a86565b6128d16000805160206149ed833981519152846119ee565b1561291e5760405162461bcd60e51b815260206004820152601b60248201527f4163636f756e7420697320616c726561647920616e2061646d696e00000000006044820152606401610a86565b6040518060400160405280612931612495565b6001600160a01b039081168252841515602092830152858116600090815260168352604090208351815494909301511515600160a01b026001600160a81b031990941692909116919091179190911790557f5361567db258c455805facb30c8419432858c53df1e0ede0b3a0e2272602f26c836129ac612495565b604080516001600160a01b03938416815292909116602083015284151590820152606001610ea8565b6129df8282613265565b6000828152600d60205260409020610bcd90826132ef565b600e5460ff16612a405760405162461bcd60e51b815260206004820152601460248201527314185d5cd8589b194e881b9bdd081c185d5cd95960621b6044820152606401610a86565b600e805460ff191690557f5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa612a73612495565b6040516001600160a01b03909116815260200160405180910390a1565b6000612a9b82611415565b9050612aa9816000846131a2565b612ab46000836124a4565b6001600160a01b0381166000908152600560205260408120805460019290612add9084906147c3565b909155505060008281526004602052604080822080546001600160a01b0319169055518391906001600160a01b038416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef908390a45050565b600080546001600160a01b038381166001600160a01b031980841682178555600180549091169055604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b600e5460ff1615612bd75760405162461bcd60e51b815260206004820152601060248201526f14185d5cd8589b194e881c185d5cd95960821b6044820152606401610a86565b600e805460ff191660011790557f62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258612a73612495565b600061185682846147c3565b600061185682846147d6565b6127f58282613304565b6129df828261330e565b60006118568383613336565b612c50848484612640565b612c5c848484846133bc565b611b0d5760405162461bcd60e51b8152600401610a86906147e9565b606081600003612c9f5750506040805180820190915260018152600360fc1b602082015290565b8160005b8115612cc95780612cb38161463f565b9150612cc29050600a83614851565b9150612ca3565b6000816001600160401b03811115612ce357612ce3613f39565b6040519080825280601f01601f191660200182016040528015612d0d576020820181803683370190505b5090505b841561263857612d226001836147c3565b9150612d2f600a86614865565b612d3a9060306147d6565b60f81b818381518110612d4f57612d4f614613565b60200101906001600160f81b031916908160001a905350612d71600a86614851565b9450612d11565b60608151600003612d9757505060408051602081019091526000815290565b6000600383516002612da991906147d6565b612db39190614851565b612dbe906004614879565b6001600160401b03811115612dd557612dd5613f39565b6040519080825280601f01601f191660200182016040528015612dff576020820181803683370190505b5090506000805b84518110156130c55760006010868381518110612e2557612e25614613565b0160200151875160f89190911c90911b9150612e428360016147d6565b1015612e7257600886612e568460016147d6565b81518110612e6657612e66614613565b016020015160f81c901b175b8551612e7f8360026147d6565b1015612eab5785612e918360026147d6565b81518110612ea157612ea1614613565b016020015160f81c175b60405180606001604052806040815260200161498d60409139601282901c603f1681518110612edc57612edc614613565b01602001516001600160f81b0319168484612ef68161463f565b955081518110612f0857612f08614613565b60200101906001600160f81b031916908160001a90535060405180606001604052806040815260200161498d60409139600c82901c603f1681518110612f5057612f50614613565b01602001516001600160f81b0319168484612f6a8161463f565b955081518110612f7c57612f7c614613565b60200101906001600160f81b031916908160001a9053508551612fa08360016147d6565b10612faf57603d60f81b612ff0565b60405180606001604052806040815260200161498d60409139600682901c603f1681518110612fe057612fe0614613565b01602001516001600160f81b0319165b8484612ffb8161463f565b95508151811061300d5761300d614613565b60200101906001600160f81b031916908160001a90535085516130318360026147d6565b1061304057603d60f81b61307d565b60405180606001604052806040815260200161498d6040913981603f168151811061306d5761306d614613565b01602001516001600160f81b0319165b84846130888161463f565b95508151811061309a5761309a614613565b60200101906001600160f81b031916908160001a9053506130be90506003826147d6565b9050612e06565b50909392505050565b6000610986825490565b600e8054610100600160a81b0319166101006001600160a01b038416908102919091179091556040519081527f871264f4293af7d2865ae7eae628b228f4991c57cb45b39c99f0b774ebe290189060200160405180910390a150565b60006001600160e01b03198216637965db0b60e01b14806109865750610986826134c4565b60006131643361134c565b8015613171575060143610155b15613183575060131936013560601c90565b503390565b610f0c8282604051806020016040528060008152506134e9565b6131ad83838361351c565b600e5460ff1615806131d757506001600160a01b03831660009081526015602052604090205460ff165b610bcd5760405162461bcd60e51b815260206004820152601a60248201527f546f6b656e207472616e736665727320617265207061757365640000000000006044820152606401610a86565b6000828152600c602052604090206001015461324681613241612495565b6135d4565b610bcd8383613638565b6000611856836001600160a01b0384166136bf565b61326d612495565b6001600160a01b0316816001600160a01b0316146132e55760405162461bcd60e51b815260206004820152602f60248201527f416363657373436f6e74726f6c3a2063616e206f6e6c792072656e6f756e636560448201526e103937b632b9903337b91039b2b63360891b6064820152608401610a86565b610f0c828261370e565b6000611856836001600160a01b038416613793565b610f0c8282613638565b6000828152600c602052604090206001015461332c81613241612495565b610bcd838361370e565b815460009082106133945760405162461bcd60e51b815260206004820152602260248201527f456e756d657261626c655365743a20696e646578206f7574206f6620626f756e604482015261647360f01b6064820152608401610a86565b8260000182815481106133a9576133a9614613565b9060005260206000200154905092915050565b60006001600160a01b0384163b156134b957836001600160a01b031663150b7a026133e5612495565b8786866040518563ffffffff1660e01b81526004016134079493929190614890565b6020604051808303816000875af1925050508015613442575060408051601f3d908101601f1916820190925261343f918101906148cd565b60015b61349f573d808015613470576040519150601f19603f3d011682016040523d82523d6000602084013e613475565b606091505b5080516000036134975760405162461bcd60e51b8152600401610a86906147e9565b805181602001fd5b6001600160e01b031916630a85bd0160e11b149050612638565b506001949350505050565b60006001600160e01b0319821663780e9d6360e01b1480610986575061098682613886565b6134f383836138d6565b61350060008484846133bc565b610bcd5760405162461bcd60e51b8152600401610a86906147e9565b6001600160a01b0383166135775761357281600a80546000838152600b60205260408120829055600182018355919091527fc65a7bb8d6351c1cf70c95a316cc6a92839c986682d98bc35f958f4883f9d2a80155565b61359a565b816001600160a01b0316836001600160a01b03161461359a5761359a8382613a15565b6001600160a01b0382166135b157610bcd81613ab2565b826001600160a01b0316826001600160a01b031614610bcd57610bcd8282613b61565b6135de82826119ee565b610f0c576135f6816001600160a01b03166014613ba5565b613601836020613ba5565b6040516020016136129291906148ea565b60408051601f198184030181529082905262461bcd60e51b8252610a8691600401613e11565b61364282826119ee565b610f0c576000828152600c602090815260408083206001600160a01b03851684529091529020805460ff1916600117905561367b612495565b6001600160a01b0316816001600160a01b0316837f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a45050565b600081815260018301602052604081205461370657508154600181810184556000848152602080822090930184905584548482528286019093526040902091909155610986565b506000610986565b61371882826119ee565b15610f0c576000828152600c602090815260408083206001600160a01b03851684529091529020805460ff1916905561374f612495565b6001600160a01b0316816001600160a01b0316837ff6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b60405160405180910390a45050565b6000818152600183016020526040812054801561387c5760006137b76001836147c3565b85549091506000906137cb906001906147c3565b905060008660000182815481106137e4576137e4614613565b906000526020600020015490508087600001848154811061380757613807614613565b60009182526020909120015561381e8360016147d6565b600082815260018901602052604090205586548790806138405761384061495f565b60019003818190600052602060002001600090559055866001016000878152602001908152602001600020600090556001945050505050610986565b6000915050610986565b60006001600160e01b031982166380ac58cd60e01b14806138b757506001600160e01b03198216635b5e139f60e01b145b8061098657506301ffc9a760e01b6001600160e01b0319831614610986565b6001600160a01b03821661392c5760405162461bcd60e51b815260206004820181905260248201527f4552433732313a206d696e7420746f20746865207a65726f20616464726573736044820152606401610a86565b61393581612478565b156139825760405162461bcd60e51b815260206004820152601c60248201527f4552433732313a20746f6b656e20616c7265616479206d696e746564000000006044820152606401610a86565b61398e600083836131a2565b6001600160a01b03821660009081526005602052604081208054600192906139b79084906147d6565b909155505060008181526004602052604080822080546001600160a01b0319166001600160a01b03861690811790915590518392907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef908290a45050565b60006001613a228461148c565b613a2c91906147c3565b600083815260096020526040902054909150808214613a7f576001600160a01b03841660009081526008602090815260408083208584528252808320548484528184208190558352600990915290208190555b5060009182526009602090815260408084208490556001600160a01b039094168352600881528383209183525290812055565b600a54600090613ac4906001906147c3565b6000838152600b6020526040812054600a8054939450909284908110613aec57613aec614613565b9060005260206000200154905080600a8381548110613b0d57613b0d614613565b6000918252602080832090910192909255828152600b9091526040808220849055858252812055600a805480613b4557613b4561495f565b6001900381819060005260206000200160009055905550505050565b6000613b6c8361148c565b6001600160a01b039093166000908152600860209081526040808320868452825280832085905593825260099052919091209190915550565b60606000613bb4836002614879565b613bbf9060026147d6565b6001600160401b03811115613bd657613bd6613f39565b6040519080825280601f01601f191660200182016040528015613c00576020820181803683370190505b509050600360fc1b81600081518110613c1b57613c1b614613565b60200101906001600160f81b031916908160001a905350600f60fb1b81600181518110613c4a57613c4a614613565b60200101906001600160f81b031916908160001a9053506000613c6e846002614879565b613c799060016147d6565b90505b6001811115613cf1576f181899199a1a9b1b9c1cb0b131b232b360811b85600f1660108110613cad57613cad614613565b1a60f81b828281518110613cc357613cc3614613565b60200101906001600160f81b031916908160001a90535060049490941c93613cea81614975565b9050613c7c565b5083156118565760405162461bcd60e51b815260206004820181905260248201527f537472696e67733a20686578206c656e67746820696e73756666696369656e746044820152606401610a86565b508054613d4c90614407565b6000825580601f10613d5c575050565b601f01602090049060005260206000209081019061107e91905b80821115613d8a5760008155600101613d76565b5090565b6001600160e01b03198116811461107e57600080fd5b600060208284031215613db657600080fd5b813561185681613d8e565b60005b83811015613ddc578181015183820152602001613dc4565b50506000910152565b60008151808452613dfd816020860160208601613dc1565b601f01601f19169290920160200192915050565b6020815260006118566020830184613de5565b600060208284031215613e3657600080fd5b5035919050565b6001600160a01b038116811461107e57600080fd5b60008060408385031215613e6557600080fd5b8235613e7081613e3d565b946020939093013593505050565b600060208284031215613e9057600080fd5b813561185681613e3d565b600080600080600060808688031215613eb357600080fd5b8535613ebe81613e3d565b94506020860135613ece81613e3d565b93506040860135925060608601356001600160401b0380821115613ef157600080fd5b818801915088601f830112613f0557600080fd5b813581811115613f1457600080fd5b896020828501011115613f2657600080fd5b9699959850939650602001949392505050565b634e487b7160e01b600052604160045260246000fd5b604051601f8201601f191681016001600160401b0381118282101715613f7757613f77613f39565b604052919050565b60006001600160401b03821115613f9857613f98613f39565b50601f01601f191660200190565b6000613fb9613fb484613f7f565b613f4f565b9050828152838383011115613fcd57600080fd5b828260208301376000602084830101529392505050565b600082601f830112613ff557600080fd5b61185683833560208501613fa6565b6000806040838503121561401757600080fd5b8235915060208301356001600160401b0381111561403457600080fd5b61404085828601613fe4565b9150509250929050565b60008060006060848603121561405f57600080fd5b833561406a81613e3d565b9250602084013561407a81613e3d565b929592945050506040919091013590565b6000806040838503121561409e57600080fd5b8235915060208301356140b081613e3d565b809150509250929050565b600080604083850312156140ce57600080fd5b82356140d981613e3d565b9150602083013580151581146140b057600080fd5b60006020828403121561410057600080fd5b81356001600160401b0381111561411657600080fd5b61263884828501613fe4565b6020808252825182820181905260009190848201906040850190845b8181101561415a5783518352928401929184019160010161413e565b50909695505050505050565b60008060006060848603121561417b57600080fd5b833561418681613e3d565b95602085013595506040909401359392505050565b600080604083850312156141ae57600080fd5b50508035926020909101359150565b60006001600160401b038211156141d6576141d6613f39565b5060051b60200190565b600082601f8301126141f157600080fd5b81356020614201613fb4836141bd565b82815260059290921b8401810191818101908684111561422057600080fd5b8286015b8481101561425f5780356001600160401b038111156142435760008081fd5b6142518986838b0101613fe4565b845250918301918301614224565b509695505050505050565b6000806040838503121561427d57600080fd5b82356001600160401b038082111561429457600080fd5b818501915085601f8301126142a857600080fd5b813560206142b8613fb4836141bd565b82815260059290921b840181019181810190898411156142d757600080fd5b948201945b838610156142fe5785356142ef81613e3d565b825294820194908201906142dc565b9650508601359250508082111561431457600080fd5b50614040858286016141e0565b6000806000806080858703121561433757600080fd5b843561434281613e3d565b9350602085013561435281613e3d565b92506040850135915060608501356001600160401b0381111561437457600080fd5b8501601f8101871361438557600080fd5b61439487823560208401613fa6565b91505092959194509250565b600080604083850312156143b357600080fd5b82356143be81613e3d565b915060208301356001600160401b0381111561403457600080fd5b600080604083850312156143ec57600080fd5b82356143f781613e3d565b915060208301356140b081613e3d565b600181811c9082168061441b57607f821691505b60208210810361443b57634e487b7160e01b600052602260045260246000fd5b50919050565b60006020828403121561445357600080fd5b815161185681613e3d565b6020808252600f908201526e24b9903737ba1030b71030b236b4b760891b604082015260600190565b602080825260149082015273151bdad95b88191bd95cc81b9bdd08195e1a5cdd60621b604082015260600190565b601f821115610bcd57600081815260208120601f850160051c810160208610156144dc5750805b601f850160051c820191505b818110156144fb578281556001016144e8565b505050505050565b81516001600160401b0381111561451c5761451c613f39565b6145308161452a8454614407565b846144b5565b602080601f831160018114614565576000841561454d5750858301515b600019600386901b1c1916600185901b1785556144fb565b600085815260208120601f198616915b8281101561459457888601518255948401946001909101908401614575565b50858210156145b25787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b60208082526031908201527f4552433732313a207472616e736665722063616c6c6572206973206e6f74206f6040820152701ddb995c881b9bdc88185c1c1c9bdd9959607a1b606082015260800190565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b60006001820161465157614651614629565b5060010190565b6020808252600f908201526e24b9903737ba10309036b4b73a32b960891b604082015260600190565b600080845461468f81614407565b600182811680156146a757600181146146bc576146eb565b60ff19841687528215158302870194506146eb565b8860005260208060002060005b858110156146e25781548a8201529084019082016146c9565b50505082870194505b5050505083516146ff818360208801613dc1565b01949350505050565b60006020828403121561471a57600080fd5b81516001600160401b0381111561473057600080fd5b8201601f8101841361474157600080fd5b805161474f613fb482613f7f565b81815285602083850101111561476457600080fd5b614775826020830160208601613dc1565b95945050505050565b7f646174613a6170706c69636174696f6e2f6a736f6e3b6261736536342c0000008152600082516147b681601d850160208701613dc1565b91909101601d0192915050565b8181038181111561098657610986614629565b8082018082111561098657610986614629565b60208082526032908201527f4552433732313a207472616e7366657220746f206e6f6e20455243373231526560408201527131b2b4bb32b91034b6b83632b6b2b73a32b960711b606082015260800190565b634e487b7160e01b600052601260045260246000fd5b6000826148605761486061483b565b500490565b6000826148745761487461483b565b500690565b808202811582820484141761098657610986614629565b6001600160a01b03858116825284166020820152604081018390526080606082018190526000906148c390830184613de5565b9695505050505050565b6000602082840312156148df57600080fd5b815161185681613d8e565b7f416363657373436f6e74726f6c3a206163636f756e7420000000000000000000815260008351614922816017850160208801613dc1565b7001034b99036b4b9b9b4b733903937b6329607d1b6017918401918201528351614953816028840160208801613dc1565b01602801949350505050565b634e487b7160e01b600052603160045260246000fd5b60008161498457614984614629565b50600019019056fe4142434445464748494a4b4c4d4e4f505152535455565758595a6162636465666768696a6b6c6d6e6f707172737475767778797a303132333435363738392b2f9f2df0fed2c77648de5860a4cc508cd0818c85b8b8a1ab4ceeef8d981c8956a6a49807205ce4d355092ef5a8a18f56e8913cf4a201fbe287825b095693c21775a2646970667358221220bc0a7e394ab9bc68ebe29219f8b4b2c1b055dfc1987172eb9eb38cb3f64400b164736f6c634300081500339f2df0fed2c77648de5860a4cc508cd0818c85b8b8a1ab4ceeef8d981c8956a6a49807205ce4d355092ef5a8a18f56e8913cf4a201fbe287825b095693c21775",
+}
+
 // WERC721ABI is the input ABI used to generate the binding from.
-const WERC721ABI = "[{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_eligibleUsers\",\"type\":\"address[]\"},{\"internalType\":\"string\",\"name\":\"_name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_symbol\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_data\",\"type\":\"string\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_user\",\"type\":\"address\"}],\"name\":\"switchUserPermissions\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"tokensData\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_ownerOfTokens\",\"type\":\"address\"}],\"name\":\"tokensOfOwner\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+// Deprecated: Use WERC721MetaData.ABI instead.
+var WERC721ABI = WERC721MetaData.ABI
 
 // WERC721Bin is the compiled bytecode used for deploying new contracts.
-var WERC721Bin = "0x60806040523480156200001157600080fd5b5060405162003adf38038062003adf83398181016040528101906200003791906200041e565b818160006200004b6200023b60201b60201c565b9050806000806101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff1602179055508073ffffffffffffffffffffffffffffffffffffffff16600073ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a35081600190805190602001906200010192919062000243565b5080600290805190602001906200011a92919062000243565b5050506001600760003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff02191690831515021790555060005b83518110156200023157600160076000868481518110620001c2577f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b602002602001015173ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff021916908315150217905550808062000228906200062d565b91505062000178565b5050505062000733565b600033905090565b8280546200025190620005c1565b90600052602060002090601f016020900481019282620002755760008555620002c1565b82601f106200029057805160ff1916838001178555620002c1565b82800160010185558215620002c1579182015b82811115620002c0578251825591602001919060010190620002a3565b5b509050620002d09190620002d4565b5090565b5b80821115620002ef576000816000905550600101620002d5565b5090565b60006200030a6200030484620004e8565b620004bf565b905080838252602082019050828560208602820111156200032a57600080fd5b60005b858110156200035e5781620003438882620003ad565b8452602084019350602083019250506001810190506200032d565b5050509392505050565b60006200037f620003798462000517565b620004bf565b9050828152602081018484840111156200039857600080fd5b620003a58482856200058b565b509392505050565b600081519050620003be8162000719565b92915050565b600082601f830112620003d657600080fd5b8151620003e8848260208601620002f3565b91505092915050565b600082601f8301126200040357600080fd5b81516200041584826020860162000368565b91505092915050565b6000806000606084860312156200043457600080fd5b600084015167ffffffffffffffff8111156200044f57600080fd5b6200045d86828701620003c4565b935050602084015167ffffffffffffffff8111156200047b57600080fd5b6200048986828701620003f1565b925050604084015167ffffffffffffffff811115620004a757600080fd5b620004b586828701620003f1565b9150509250925092565b6000620004cb620004de565b9050620004d98282620005f7565b919050565b6000604051905090565b600067ffffffffffffffff821115620005065762000505620006d9565b5b602082029050602081019050919050565b600067ffffffffffffffff821115620005355762000534620006d9565b5b620005408262000708565b9050602081019050919050565b60006200055a8262000561565b9050919050565b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b6000819050919050565b60005b83811015620005ab5780820151818401526020810190506200058e565b83811115620005bb576000848401525b50505050565b60006002820490506001821680620005da57607f821691505b60208210811415620005f157620005f0620006aa565b5b50919050565b620006028262000708565b810181811067ffffffffffffffff82111715620006245762000623620006d9565b5b80604052505050565b60006200063a8262000581565b91507fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff82141562000670576200066f6200067b565b5b600182019050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b6000601f19601f8301169050919050565b62000724816200054d565b81146200073057600080fd5b50565b61339c80620007436000396000f3fe608060405234801561001057600080fd5b50600436106101375760003560e01c806370a08231116100b8578063a22cb4651161007c578063a22cb4651461034e578063b88d4fde1461036a578063c87b56dd14610386578063d0def521146103b6578063e985e9c5146103d2578063f2fde38b1461040257610137565b806370a08231146102a8578063715018a6146102d85780638462151c146102e25780638da5cb5b1461031257806395d89b411461033057610137565b806323b872dd116100ff57806323b872dd146101f457806342842e0e1461021057806348065fa61461022c578063599ed3ff146102485780636352211e1461027857610137565b806301ffc9a71461013c57806306fdde031461016c578063081812fc1461018a578063095ea7b3146101ba57806318160ddd146101d6575b600080fd5b61015660048036038101906101519190612323565b61041e565b60405161016391906127f3565b60405180910390f35b610174610500565b604051610181919061280e565b60405180910390f35b6101a4600480360381019061019f9190612375565b610592565b6040516101b1919061276a565b60405180910390f35b6101d460048036038101906101cf91906122e7565b610617565b005b6101de61072f565b6040516101eb9190612a50565b60405180910390f35b61020e6004803603810190610209919061218d565b610735565b005b61022a6004803603810190610225919061218d565b610795565b005b61024660048036038101906102419190612128565b6107b5565b005b610262600480360381019061025d9190612375565b6108d8565b60405161026f919061280e565b60405180910390f35b610292600480360381019061028d9190612375565b610978565b60405161029f919061276a565b60405180910390f35b6102c260048036038101906102bd9190612128565b610a2a565b6040516102cf9190612a50565b60405180910390f35b6102e0610ae2565b005b6102fc60048036038101906102f79190612128565b610c1c565b60405161030991906127d1565b60405180910390f35b61031a610de7565b604051610327919061276a565b60405180910390f35b610338610e10565b604051610345919061280e565b60405180910390f35b61036860048036038101906103639190612257565b610ea2565b005b610384600480360381019061037f91906121dc565b611023565b005b6103a0600480360381019061039b9190612375565b611085565b6040516103ad919061280e565b60405180910390f35b6103d060048036038101906103cb9190612293565b61112c565b005b6103ec60048036038101906103e79190612151565b611212565b6040516103f991906127f3565b60405180910390f35b61041c60048036038101906104179190612128565b6112a6565b005b60007f80ac58cd000000000000000000000000000000000000000000000000000000007bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916827bffffffffffffffffffffffffffffffffffffffffffffffffffffffff191614806104e957507f5b5e139f000000000000000000000000000000000000000000000000000000007bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916827bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916145b806104f957506104f88261144f565b5b9050919050565b60606001805461050f90612cdf565b80601f016020809104026020016040519081016040528092919081815260200182805461053b90612cdf565b80156105885780601f1061055d57610100808354040283529160200191610588565b820191906000526020600020905b81548152906001019060200180831161056b57829003601f168201915b5050505050905090565b600061059d826114b9565b6105dc576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016105d390612990565b60405180910390fd5b6005600083815260200190815260200160002060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff169050919050565b600061062282610978565b90508073ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff161415610693576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161068a90612a10565b60405180910390fd5b8073ffffffffffffffffffffffffffffffffffffffff166106b2611525565b73ffffffffffffffffffffffffffffffffffffffff1614806106e157506106e0816106db611525565b611212565b5b610720576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161071790612910565b60405180910390fd5b61072a838361152d565b505050565b60095481565b610746610740611525565b826115e6565b610785576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161077c90612a30565b60405180910390fd5b6107908383836116c4565b505050565b6107b083838360405180602001604052806000815250611023565b505050565b6107bd611525565b73ffffffffffffffffffffffffffffffffffffffff166107db610de7565b73ffffffffffffffffffffffffffffffffffffffff1614610831576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610828906129b0565b60405180910390fd5b600760008273ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060009054906101000a900460ff1615600760008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff02191690831515021790555050565b600860205280600052604060002060009150905080546108f790612cdf565b80601f016020809104026020016040519081016040528092919081815260200182805461092390612cdf565b80156109705780601f1061094557610100808354040283529160200191610970565b820191906000526020600020905b81548152906001019060200180831161095357829003601f168201915b505050505081565b6000806003600084815260200190815260200160002060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff169050600073ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff161415610a21576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610a1890612950565b60405180910390fd5b80915050919050565b60008073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff161415610a9b576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610a9290612930565b60405180910390fd5b600460008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020549050919050565b610aea611525565b73ffffffffffffffffffffffffffffffffffffffff16610b08610de7565b73ffffffffffffffffffffffffffffffffffffffff1614610b5e576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610b55906129b0565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff1660008054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a360008060006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550565b60606000610c2983610a2a565b90506000811415610cac57600067ffffffffffffffff811115610c75577f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b604051908082528060200260200182016040528015610ca35781602001602082028036833780820191505090505b50915050610de2565b60008167ffffffffffffffff811115610cee577f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b604051908082528060200260200182016040528015610d1c5781602001602082028036833780820191505090505b50905060006009549050600080600190505b828111610dd9578673ffffffffffffffffffffffffffffffffffffffff16610d5582610978565b73ffffffffffffffffffffffffffffffffffffffff161415610dc65780848381518110610dab577f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b6020026020010181815250508180610dc290612d42565b9250505b8080610dd190612d42565b915050610d2e565b50829450505050505b919050565b60008060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff16905090565b606060028054610e1f90612cdf565b80601f0160208091040260200160405190810160405280929190818152602001828054610e4b90612cdf565b8015610e985780601f10610e6d57610100808354040283529160200191610e98565b820191906000526020600020905b815481529060010190602001808311610e7b57829003601f168201915b5050505050905090565b610eaa611525565b73ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff161415610f18576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610f0f906128d0565b60405180910390fd5b8060066000610f25611525565b73ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff0219169083151502179055508173ffffffffffffffffffffffffffffffffffffffff16610fd2611525565b73ffffffffffffffffffffffffffffffffffffffff167f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c318360405161101791906127f3565b60405180910390a35050565b61103461102e611525565b836115e6565b611073576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161106a90612a30565b60405180910390fd5b61107f84848484611920565b50505050565b6060611090826114b9565b6110cf576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016110c6906129f0565b60405180910390fd5b60006110d961197c565b905060008151116110f95760405180602001604052806000815250611124565b8061110384611993565b604051602001611114929190612746565b6040516020818303038152906040525b915050919050565b33600760008273ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060009054906101000a900460ff166111b9576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016111b090612870565b60405180910390fd5b60006111d16001600954611b4090919063ffffffff16565b90508060098190555082600860008381526020019081526020016000209080519060200190611201929190611f4c565b5061120c8482611b56565b50505050565b6000600660008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060009054906101000a900460ff16905092915050565b6112ae611525565b73ffffffffffffffffffffffffffffffffffffffff166112cc610de7565b73ffffffffffffffffffffffffffffffffffffffff1614611322576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611319906129b0565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff161415611392576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161138990612850565b60405180910390fd5b8073ffffffffffffffffffffffffffffffffffffffff1660008054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a3806000806101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555050565b60007f01ffc9a7000000000000000000000000000000000000000000000000000000007bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916827bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916149050919050565b60008073ffffffffffffffffffffffffffffffffffffffff166003600084815260200190815260200160002060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1614159050919050565b600033905090565b816005600083815260200190815260200160002060006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550808273ffffffffffffffffffffffffffffffffffffffff166115a083610978565b73ffffffffffffffffffffffffffffffffffffffff167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92560405160405180910390a45050565b60006115f1826114b9565b611630576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611627906128f0565b60405180910390fd5b600061163b83610978565b90508073ffffffffffffffffffffffffffffffffffffffff168473ffffffffffffffffffffffffffffffffffffffff1614806116aa57508373ffffffffffffffffffffffffffffffffffffffff1661169284610592565b73ffffffffffffffffffffffffffffffffffffffff16145b806116bb57506116ba8185611212565b5b91505092915050565b8273ffffffffffffffffffffffffffffffffffffffff166116e482610978565b73ffffffffffffffffffffffffffffffffffffffff161461173a576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611731906129d0565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff1614156117aa576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016117a1906128b0565b60405180910390fd5b6117b5838383611b74565b6117c060008261152d565b6001600460008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008282546118109190612bf5565b925050819055506001600460008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008282546118679190612b6e565b92505081905550816003600083815260200190815260200160002060006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550808273ffffffffffffffffffffffffffffffffffffffff168473ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef60405160405180910390a4505050565b61192b8484846116c4565b61193784848484611b79565b611976576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161196d90612830565b60405180910390fd5b50505050565b606060405180602001604052806000815250905090565b606060008214156119db576040518060400160405280600181526020017f30000000000000000000000000000000000000000000000000000000000000008152509050611b3b565b600082905060005b60008214611a0d5780806119f690612d42565b915050600a82611a069190612bc4565b91506119e3565b60008167ffffffffffffffff811115611a4f577f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b6040519080825280601f01601f191660200182016040528015611a815781602001600182028036833780820191505090505b5090505b60008514611b3457600182611a9a9190612bf5565b9150600a85611aa99190612d8b565b6030611ab59190612b6e565b60f81b818381518110611af1577f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a905350600a85611b2d9190612bc4565b9450611a85565b8093505050505b919050565b60008183611b4e9190612b6e565b905092915050565b611b70828260405180602001604052806000815250611d10565b5050565b505050565b6000611b9a8473ffffffffffffffffffffffffffffffffffffffff16611d6b565b15611d03578373ffffffffffffffffffffffffffffffffffffffff1663150b7a02611bc3611525565b8786866040518563ffffffff1660e01b8152600401611be59493929190612785565b602060405180830381600087803b158015611bff57600080fd5b505af1925050508015611c3057506040513d601f19601f82011682018060405250810190611c2d919061234c565b60015b611cb3573d8060008114611c60576040519150601f19603f3d011682016040523d82523d6000602084013e611c65565b606091505b50600081511415611cab576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611ca290612830565b60405180910390fd5b805181602001fd5b63150b7a0260e01b7bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916817bffffffffffffffffffffffffffffffffffffffffffffffffffffffff191614915050611d08565b600190505b949350505050565b611d1a8383611d7e565b611d276000848484611b79565b611d66576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611d5d90612830565b60405180910390fd5b505050565b600080823b905060008111915050919050565b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff161415611dee576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611de590612970565b60405180910390fd5b611df7816114b9565b15611e37576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611e2e90612890565b60405180910390fd5b611e4360008383611b74565b6001600460008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000206000828254611e939190612b6e565b92505081905550816003600083815260200190815260200160002060006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550808273ffffffffffffffffffffffffffffffffffffffff16600073ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef60405160405180910390a45050565b828054611f5890612cdf565b90600052602060002090601f016020900481019282611f7a5760008555611fc1565b82601f10611f9357805160ff1916838001178555611fc1565b82800160010185558215611fc1579182015b82811115611fc0578251825591602001919060010190611fa5565b5b509050611fce9190611fd2565b5090565b5b80821115611feb576000816000905550600101611fd3565b5090565b6000612002611ffd84612a90565b612a6b565b90508281526020810184848401111561201a57600080fd5b612025848285612c9d565b509392505050565b600061204061203b84612ac1565b612a6b565b90508281526020810184848401111561205857600080fd5b612063848285612c9d565b509392505050565b60008135905061207a8161330a565b92915050565b60008135905061208f81613321565b92915050565b6000813590506120a481613338565b92915050565b6000815190506120b981613338565b92915050565b600082601f8301126120d057600080fd5b81356120e0848260208601611fef565b91505092915050565b600082601f8301126120fa57600080fd5b813561210a84826020860161202d565b91505092915050565b6000813590506121228161334f565b92915050565b60006020828403121561213a57600080fd5b60006121488482850161206b565b91505092915050565b6000806040838503121561216457600080fd5b60006121728582860161206b565b92505060206121838582860161206b565b9150509250929050565b6000806000606084860312156121a257600080fd5b60006121b08682870161206b565b93505060206121c18682870161206b565b92505060406121d286828701612113565b9150509250925092565b600080600080608085870312156121f257600080fd5b60006122008782880161206b565b94505060206122118782880161206b565b935050604061222287828801612113565b925050606085013567ffffffffffffffff81111561223f57600080fd5b61224b878288016120bf565b91505092959194509250565b6000806040838503121561226a57600080fd5b60006122788582860161206b565b925050602061228985828601612080565b9150509250929050565b600080604083850312156122a657600080fd5b60006122b48582860161206b565b925050602083013567ffffffffffffffff8111156122d157600080fd5b6122dd858286016120e9565b9150509250929050565b600080604083850312156122fa57600080fd5b60006123088582860161206b565b925050602061231985828601612113565b9150509250929050565b60006020828403121561233557600080fd5b600061234384828501612095565b91505092915050565b60006020828403121561235e57600080fd5b600061236c848285016120aa565b91505092915050565b60006020828403121561238757600080fd5b600061239584828501612113565b91505092915050565b60006123aa8383612728565b60208301905092915050565b6123bf81612c29565b82525050565b60006123d082612b02565b6123da8185612b30565b93506123e583612af2565b8060005b838110156124165781516123fd888261239e565b975061240883612b23565b9250506001810190506123e9565b5085935050505092915050565b61242c81612c3b565b82525050565b600061243d82612b0d565b6124478185612b41565b9350612457818560208601612cac565b61246081612e78565b840191505092915050565b600061247682612b18565b6124808185612b52565b9350612490818560208601612cac565b61249981612e78565b840191505092915050565b60006124af82612b18565b6124b98185612b63565b93506124c9818560208601612cac565b80840191505092915050565b60006124e2603283612b52565b91506124ed82612e89565b604082019050919050565b6000612505602683612b52565b915061251082612ed8565b604082019050919050565b6000612528601483612b52565b915061253382612f27565b602082019050919050565b600061254b601c83612b52565b915061255682612f50565b602082019050919050565b600061256e602483612b52565b915061257982612f79565b604082019050919050565b6000612591601983612b52565b915061259c82612fc8565b602082019050919050565b60006125b4602c83612b52565b91506125bf82612ff1565b604082019050919050565b60006125d7603883612b52565b91506125e282613040565b604082019050919050565b60006125fa602a83612b52565b91506126058261308f565b604082019050919050565b600061261d602983612b52565b9150612628826130de565b604082019050919050565b6000612640602083612b52565b915061264b8261312d565b602082019050919050565b6000612663602c83612b52565b915061266e82613156565b604082019050919050565b6000612686602083612b52565b9150612691826131a5565b602082019050919050565b60006126a9602983612b52565b91506126b4826131ce565b604082019050919050565b60006126cc602f83612b52565b91506126d78261321d565b604082019050919050565b60006126ef602183612b52565b91506126fa8261326c565b604082019050919050565b6000612712603183612b52565b915061271d826132bb565b604082019050919050565b61273181612c93565b82525050565b61274081612c93565b82525050565b600061275282856124a4565b915061275e82846124a4565b91508190509392505050565b600060208201905061277f60008301846123b6565b92915050565b600060808201905061279a60008301876123b6565b6127a760208301866123b6565b6127b46040830185612737565b81810360608301526127c68184612432565b905095945050505050565b600060208201905081810360008301526127eb81846123c5565b905092915050565b60006020820190506128086000830184612423565b92915050565b60006020820190508181036000830152612828818461246b565b905092915050565b60006020820190508181036000830152612849816124d5565b9050919050565b60006020820190508181036000830152612869816124f8565b9050919050565b600060208201905081810360008301526128898161251b565b9050919050565b600060208201905081810360008301526128a98161253e565b9050919050565b600060208201905081810360008301526128c981612561565b9050919050565b600060208201905081810360008301526128e981612584565b9050919050565b60006020820190508181036000830152612909816125a7565b9050919050565b60006020820190508181036000830152612929816125ca565b9050919050565b60006020820190508181036000830152612949816125ed565b9050919050565b6000602082019050818103600083015261296981612610565b9050919050565b6000602082019050818103600083015261298981612633565b9050919050565b600060208201905081810360008301526129a981612656565b9050919050565b600060208201905081810360008301526129c981612679565b9050919050565b600060208201905081810360008301526129e98161269c565b9050919050565b60006020820190508181036000830152612a09816126bf565b9050919050565b60006020820190508181036000830152612a29816126e2565b9050919050565b60006020820190508181036000830152612a4981612705565b9050919050565b6000602082019050612a656000830184612737565b92915050565b6000612a75612a86565b9050612a818282612d11565b919050565b6000604051905090565b600067ffffffffffffffff821115612aab57612aaa612e49565b5b612ab482612e78565b9050602081019050919050565b600067ffffffffffffffff821115612adc57612adb612e49565b5b612ae582612e78565b9050602081019050919050565b6000819050602082019050919050565b600081519050919050565b600081519050919050565b600081519050919050565b6000602082019050919050565b600082825260208201905092915050565b600082825260208201905092915050565b600082825260208201905092915050565b600081905092915050565b6000612b7982612c93565b9150612b8483612c93565b9250827fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff03821115612bb957612bb8612dbc565b5b828201905092915050565b6000612bcf82612c93565b9150612bda83612c93565b925082612bea57612be9612deb565b5b828204905092915050565b6000612c0082612c93565b9150612c0b83612c93565b925082821015612c1e57612c1d612dbc565b5b828203905092915050565b6000612c3482612c73565b9050919050565b60008115159050919050565b60007fffffffff0000000000000000000000000000000000000000000000000000000082169050919050565b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b6000819050919050565b82818337600083830152505050565b60005b83811015612cca578082015181840152602081019050612caf565b83811115612cd9576000848401525b50505050565b60006002820490506001821680612cf757607f821691505b60208210811415612d0b57612d0a612e1a565b5b50919050565b612d1a82612e78565b810181811067ffffffffffffffff82111715612d3957612d38612e49565b5b80604052505050565b6000612d4d82612c93565b91507fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff821415612d8057612d7f612dbc565b5b600182019050919050565b6000612d9682612c93565b9150612da183612c93565b925082612db157612db0612deb565b5b828206905092915050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b6000601f19601f8301169050919050565b7f4552433732313a207472616e7366657220746f206e6f6e20455243373231526560008201527f63656976657220696d706c656d656e7465720000000000000000000000000000602082015250565b7f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160008201527f6464726573730000000000000000000000000000000000000000000000000000602082015250565b7f4973206e6f7420656c696769626c652075736572000000000000000000000000600082015250565b7f4552433732313a20746f6b656e20616c7265616479206d696e74656400000000600082015250565b7f4552433732313a207472616e7366657220746f20746865207a65726f2061646460008201527f7265737300000000000000000000000000000000000000000000000000000000602082015250565b7f4552433732313a20617070726f766520746f2063616c6c657200000000000000600082015250565b7f4552433732313a206f70657261746f7220717565727920666f72206e6f6e657860008201527f697374656e7420746f6b656e0000000000000000000000000000000000000000602082015250565b7f4552433732313a20617070726f76652063616c6c6572206973206e6f74206f7760008201527f6e6572206e6f7220617070726f76656420666f7220616c6c0000000000000000602082015250565b7f4552433732313a2062616c616e636520717565727920666f7220746865207a6560008201527f726f206164647265737300000000000000000000000000000000000000000000602082015250565b7f4552433732313a206f776e657220717565727920666f72206e6f6e657869737460008201527f656e7420746f6b656e0000000000000000000000000000000000000000000000602082015250565b7f4552433732313a206d696e7420746f20746865207a65726f2061646472657373600082015250565b7f4552433732313a20617070726f76656420717565727920666f72206e6f6e657860008201527f697374656e7420746f6b656e0000000000000000000000000000000000000000602082015250565b7f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572600082015250565b7f4552433732313a207472616e73666572206f6620746f6b656e2074686174206960008201527f73206e6f74206f776e0000000000000000000000000000000000000000000000602082015250565b7f4552433732314d657461646174613a2055524920717565727920666f72206e6f60008201527f6e6578697374656e7420746f6b656e0000000000000000000000000000000000602082015250565b7f4552433732313a20617070726f76616c20746f2063757272656e74206f776e6560008201527f7200000000000000000000000000000000000000000000000000000000000000602082015250565b7f4552433732313a207472616e736665722063616c6c6572206973206e6f74206f60008201527f776e6572206e6f7220617070726f766564000000000000000000000000000000602082015250565b61331381612c29565b811461331e57600080fd5b50565b61332a81612c3b565b811461333557600080fd5b50565b61334181612c47565b811461334c57600080fd5b50565b61335881612c93565b811461336357600080fd5b5056fea264697066735822122098f2b7555dde25506c226e5e706704009cbe8c680ae49e3c4797276d437933c364736f6c63430008030033"
+// Deprecated: Use WERC721MetaData.Bin instead.
+var WERC721Bin = WERC721MetaData.Bin
 
 // DeployWERC721 deploys a new Ethereum contract, binding an instance of WERC721 to it.
-func DeployWERC721(auth *bind.TransactOpts, backend bind.ContractBackend, _eligibleUsers []common.Address, _name string, _symbol string) (common.Address, *types.Transaction, *WERC721, error) {
-	parsed, err := abi.JSON(strings.NewReader(WERC721ABI))
+func DeployWERC721(auth *bind.TransactOpts, backend bind.ContractBackend, _minters []common.Address, _name string, _symbol string, _trustedForwarder common.Address) (common.Address, *types.Transaction, *WERC721, error) {
+	parsed, err := WERC721MetaData.GetAbi()
 	if err != nil {
 		return common.Address{}, nil, nil, err
 	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
 
-	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(WERC721Bin), backend, _eligibleUsers, _name, _symbol)
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(WERC721Bin), backend, _minters, _name, _symbol, _trustedForwarder)
 	if err != nil {
 		return common.Address{}, nil, nil, err
 	}
@@ -188,6 +207,130 @@ func (_WERC721 *WERC721TransactorRaw) Transact(opts *bind.TransactOpts, method s
 	return _WERC721.Contract.contract.Transact(opts, method, params...)
 }
 
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_WERC721 *WERC721Caller) ADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_WERC721 *WERC721Session) ADMINROLE() ([32]byte, error) {
+	return _WERC721.Contract.ADMINROLE(&_WERC721.CallOpts)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_WERC721 *WERC721CallerSession) ADMINROLE() ([32]byte, error) {
+	return _WERC721.Contract.ADMINROLE(&_WERC721.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_WERC721 *WERC721Caller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_WERC721 *WERC721Session) DEFAULTADMINROLE() ([32]byte, error) {
+	return _WERC721.Contract.DEFAULTADMINROLE(&_WERC721.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_WERC721 *WERC721CallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _WERC721.Contract.DEFAULTADMINROLE(&_WERC721.CallOpts)
+}
+
+// GUARDIANROLE is a free data retrieval call binding the contract method 0x24ea54f4.
+//
+// Solidity: function GUARDIAN_ROLE() view returns(bytes32)
+func (_WERC721 *WERC721Caller) GUARDIANROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "GUARDIAN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GUARDIANROLE is a free data retrieval call binding the contract method 0x24ea54f4.
+//
+// Solidity: function GUARDIAN_ROLE() view returns(bytes32)
+func (_WERC721 *WERC721Session) GUARDIANROLE() ([32]byte, error) {
+	return _WERC721.Contract.GUARDIANROLE(&_WERC721.CallOpts)
+}
+
+// GUARDIANROLE is a free data retrieval call binding the contract method 0x24ea54f4.
+//
+// Solidity: function GUARDIAN_ROLE() view returns(bytes32)
+func (_WERC721 *WERC721CallerSession) GUARDIANROLE() ([32]byte, error) {
+	return _WERC721.Contract.GUARDIANROLE(&_WERC721.CallOpts)
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_WERC721 *WERC721Caller) MINTERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "MINTER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_WERC721 *WERC721Session) MINTERROLE() ([32]byte, error) {
+	return _WERC721.Contract.MINTERROLE(&_WERC721.CallOpts)
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_WERC721 *WERC721CallerSession) MINTERROLE() ([32]byte, error) {
+	return _WERC721.Contract.MINTERROLE(&_WERC721.CallOpts)
+}
+
 // BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
 //
 // Solidity: function balanceOf(address owner) view returns(uint256)
@@ -219,6 +362,51 @@ func (_WERC721 *WERC721CallerSession) BalanceOf(owner common.Address) (*big.Int,
 	return _WERC721.Contract.BalanceOf(&_WERC721.CallOpts, owner)
 }
 
+// GetAdminRoleOffer is a free data retrieval call binding the contract method 0x26a70488.
+//
+// Solidity: function getAdminRoleOffer(address _account) view returns(address _offeredBy, bool _handover)
+func (_WERC721 *WERC721Caller) GetAdminRoleOffer(opts *bind.CallOpts, _account common.Address) (struct {
+	OfferedBy common.Address
+	Handover  bool
+}, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "getAdminRoleOffer", _account)
+
+	outstruct := new(struct {
+		OfferedBy common.Address
+		Handover  bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.OfferedBy = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Handover = *abi.ConvertType(out[1], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// GetAdminRoleOffer is a free data retrieval call binding the contract method 0x26a70488.
+//
+// Solidity: function getAdminRoleOffer(address _account) view returns(address _offeredBy, bool _handover)
+func (_WERC721 *WERC721Session) GetAdminRoleOffer(_account common.Address) (struct {
+	OfferedBy common.Address
+	Handover  bool
+}, error) {
+	return _WERC721.Contract.GetAdminRoleOffer(&_WERC721.CallOpts, _account)
+}
+
+// GetAdminRoleOffer is a free data retrieval call binding the contract method 0x26a70488.
+//
+// Solidity: function getAdminRoleOffer(address _account) view returns(address _offeredBy, bool _handover)
+func (_WERC721 *WERC721CallerSession) GetAdminRoleOffer(_account common.Address) (struct {
+	OfferedBy common.Address
+	Handover  bool
+}, error) {
+	return _WERC721.Contract.GetAdminRoleOffer(&_WERC721.CallOpts, _account)
+}
+
 // GetApproved is a free data retrieval call binding the contract method 0x081812fc.
 //
 // Solidity: function getApproved(uint256 tokenId) view returns(address)
@@ -250,508 +438,3460 @@ func (_WERC721 *WERC721CallerSession) GetApproved(tokenId *big.Int) (common.Addr
 	return _WERC721.Contract.GetApproved(&_WERC721.CallOpts, tokenId)
 }
 
-// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
 //
-// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
-func (_WERC721 *WERC721Caller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_WERC721 *WERC721Caller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
 	var out []interface{}
-	err := _WERC721.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+	err := _WERC721.contract.Call(opts, &out, "getRoleAdmin", role)
 
 	if err != nil {
-		return *new(bool), err
+		return *new([32]byte), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
 
 	return out0, err
 
 }
 
-// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
 //
-// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
-func (_WERC721 *WERC721Session) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
-	return _WERC721.Contract.IsApprovedForAll(&_WERC721.CallOpts, owner, operator)
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_WERC721 *WERC721Session) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _WERC721.Contract.GetRoleAdmin(&_WERC721.CallOpts, role)
 }
 
-// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
 //
-// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
-func (_WERC721 *WERC721CallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
-	return _WERC721.Contract.IsApprovedForAll(&_WERC721.CallOpts, owner, operator)
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_WERC721 *WERC721CallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _WERC721.Contract.GetRoleAdmin(&_WERC721.CallOpts, role)
 }
 
-// Name is a free data retrieval call binding the contract method 0x06fdde03.
+// GetRoleMember is a free data retrieval call binding the contract method 0x9010d07c.
 //
-// Solidity: function name() view returns(string)
-func (_WERC721 *WERC721Caller) Name(opts *bind.CallOpts) (string, error) {
+// Solidity: function getRoleMember(bytes32 role, uint256 index) view returns(address)
+func (_WERC721 *WERC721Caller) GetRoleMember(opts *bind.CallOpts, role [32]byte, index *big.Int) (common.Address, error) {
 	var out []interface{}
-	err := _WERC721.contract.Call(opts, &out, "name")
+	err := _WERC721.contract.Call(opts, &out, "getRoleMember", role, index)
 
 	if err != nil {
-		return *new(string), err
+		return *new(common.Address), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
 
 	return out0, err
 
 }
 
-// Name is a free data retrieval call binding the contract method 0x06fdde03.
+// GetRoleMember is a free data retrieval call binding the contract method 0x9010d07c.
 //
-// Solidity: function name() view returns(string)
-func (_WERC721 *WERC721Session) Name() (string, error) {
-	return _WERC721.Contract.Name(&_WERC721.CallOpts)
+// Solidity: function getRoleMember(bytes32 role, uint256 index) view returns(address)
+func (_WERC721 *WERC721Session) GetRoleMember(role [32]byte, index *big.Int) (common.Address, error) {
+	return _WERC721.Contract.GetRoleMember(&_WERC721.CallOpts, role, index)
 }
 
-// Name is a free data retrieval call binding the contract method 0x06fdde03.
+// GetRoleMember is a free data retrieval call binding the contract method 0x9010d07c.
 //
-// Solidity: function name() view returns(string)
-func (_WERC721 *WERC721CallerSession) Name() (string, error) {
-	return _WERC721.Contract.Name(&_WERC721.CallOpts)
+// Solidity: function getRoleMember(bytes32 role, uint256 index) view returns(address)
+func (_WERC721 *WERC721CallerSession) GetRoleMember(role [32]byte, index *big.Int) (common.Address, error) {
+	return _WERC721.Contract.GetRoleMember(&_WERC721.CallOpts, role, index)
 }
 
-// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+// GetRoleMemberCount is a free data retrieval call binding the contract method 0xca15c873.
 //
-// Solidity: function owner() view returns(address)
-func (_WERC721 *WERC721Caller) Owner(opts *bind.CallOpts) (common.Address, error) {
+// Solidity: function getRoleMemberCount(bytes32 role) view returns(uint256)
+func (_WERC721 *WERC721Caller) GetRoleMemberCount(opts *bind.CallOpts, role [32]byte) (*big.Int, error) {
 	var out []interface{}
-	err := _WERC721.contract.Call(opts, &out, "owner")
+	err := _WERC721.contract.Call(opts, &out, "getRoleMemberCount", role)
 
 	if err != nil {
-		return *new(common.Address), err
+		return *new(*big.Int), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
 
 	return out0, err
 
 }
 
-// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+// GetRoleMemberCount is a free data retrieval call binding the contract method 0xca15c873.
 //
-// Solidity: function owner() view returns(address)
-func (_WERC721 *WERC721Session) Owner() (common.Address, error) {
-	return _WERC721.Contract.Owner(&_WERC721.CallOpts)
+// Solidity: function getRoleMemberCount(bytes32 role) view returns(uint256)
+func (_WERC721 *WERC721Session) GetRoleMemberCount(role [32]byte) (*big.Int, error) {
+	return _WERC721.Contract.GetRoleMemberCount(&_WERC721.CallOpts, role)
 }
 
-// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+// GetRoleMemberCount is a free data retrieval call binding the contract method 0xca15c873.
 //
-// Solidity: function owner() view returns(address)
-func (_WERC721 *WERC721CallerSession) Owner() (common.Address, error) {
-	return _WERC721.Contract.Owner(&_WERC721.CallOpts)
+// Solidity: function getRoleMemberCount(bytes32 role) view returns(uint256)
+func (_WERC721 *WERC721CallerSession) GetRoleMemberCount(role [32]byte) (*big.Int, error) {
+	return _WERC721.Contract.GetRoleMemberCount(&_WERC721.CallOpts, role)
 }
 
-// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+// GetTokensOfOwner is a free data retrieval call binding the contract method 0x89edc438.
 //
-// Solidity: function ownerOf(uint256 tokenId) view returns(address)
-func (_WERC721 *WERC721Caller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+// Solidity: function getTokensOfOwner(address _ownerOfTokens, uint256 _offset, uint256 _limit) view returns(uint256[])
+func (_WERC721 *WERC721Caller) GetTokensOfOwner(opts *bind.CallOpts, _ownerOfTokens common.Address, _offset *big.Int, _limit *big.Int) ([]*big.Int, error) {
 	var out []interface{}
-	err := _WERC721.contract.Call(opts, &out, "ownerOf", tokenId)
+	err := _WERC721.contract.Call(opts, &out, "getTokensOfOwner", _ownerOfTokens, _offset, _limit)
 
 	if err != nil {
-		return *new(common.Address), err
+		return *new([]*big.Int), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
 
 	return out0, err
 
 }
 
-// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+// GetTokensOfOwner is a free data retrieval call binding the contract method 0x89edc438.
 //
-// Solidity: function ownerOf(uint256 tokenId) view returns(address)
-func (_WERC721 *WERC721Session) OwnerOf(tokenId *big.Int) (common.Address, error) {
-	return _WERC721.Contract.OwnerOf(&_WERC721.CallOpts, tokenId)
+// Solidity: function getTokensOfOwner(address _ownerOfTokens, uint256 _offset, uint256 _limit) view returns(uint256[])
+func (_WERC721 *WERC721Session) GetTokensOfOwner(_ownerOfTokens common.Address, _offset *big.Int, _limit *big.Int) ([]*big.Int, error) {
+	return _WERC721.Contract.GetTokensOfOwner(&_WERC721.CallOpts, _ownerOfTokens, _offset, _limit)
 }
 
-// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+// GetTokensOfOwner is a free data retrieval call binding the contract method 0x89edc438.
 //
-// Solidity: function ownerOf(uint256 tokenId) view returns(address)
-func (_WERC721 *WERC721CallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
-	return _WERC721.Contract.OwnerOf(&_WERC721.CallOpts, tokenId)
+// Solidity: function getTokensOfOwner(address _ownerOfTokens, uint256 _offset, uint256 _limit) view returns(uint256[])
+func (_WERC721 *WERC721CallerSession) GetTokensOfOwner(_ownerOfTokens common.Address, _offset *big.Int, _limit *big.Int) ([]*big.Int, error) {
+	return _WERC721.Contract.GetTokensOfOwner(&_WERC721.CallOpts, _ownerOfTokens, _offset, _limit)
 }
 
-// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+// GetTrustedForwarder is a free data retrieval call binding the contract method 0xce1b815f.
 //
-// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
-func (_WERC721 *WERC721Caller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+// Solidity: function getTrustedForwarder() view returns(address)
+func (_WERC721 *WERC721Caller) GetTrustedForwarder(opts *bind.CallOpts) (common.Address, error) {
 	var out []interface{}
-	err := _WERC721.contract.Call(opts, &out, "supportsInterface", interfaceId)
+	err := _WERC721.contract.Call(opts, &out, "getTrustedForwarder")
 
 	if err != nil {
-		return *new(bool), err
+		return *new(common.Address), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
 
 	return out0, err
 
 }
 
-// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+// GetTrustedForwarder is a free data retrieval call binding the contract method 0xce1b815f.
 //
-// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
-func (_WERC721 *WERC721Session) SupportsInterface(interfaceId [4]byte) (bool, error) {
-	return _WERC721.Contract.SupportsInterface(&_WERC721.CallOpts, interfaceId)
+// Solidity: function getTrustedForwarder() view returns(address)
+func (_WERC721 *WERC721Session) GetTrustedForwarder() (common.Address, error) {
+	return _WERC721.Contract.GetTrustedForwarder(&_WERC721.CallOpts)
 }
 
-// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+// GetTrustedForwarder is a free data retrieval call binding the contract method 0xce1b815f.
 //
-// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
-func (_WERC721 *WERC721CallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
-	return _WERC721.Contract.SupportsInterface(&_WERC721.CallOpts, interfaceId)
+// Solidity: function getTrustedForwarder() view returns(address)
+func (_WERC721 *WERC721CallerSession) GetTrustedForwarder() (common.Address, error) {
+	return _WERC721.Contract.GetTrustedForwarder(&_WERC721.CallOpts)
 }
 
-// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+// GetWrappedToken is a free data retrieval call binding the contract method 0xb8cac62b.
 //
-// Solidity: function symbol() view returns(string)
-func (_WERC721 *WERC721Caller) Symbol(opts *bind.CallOpts) (string, error) {
+// Solidity: function getWrappedToken(uint256 _wrappedTokenId) view returns((address,uint256))
+func (_WERC721 *WERC721Caller) GetWrappedToken(opts *bind.CallOpts, _wrappedTokenId *big.Int) (WERC721WrappedToken, error) {
 	var out []interface{}
-	err := _WERC721.contract.Call(opts, &out, "symbol")
+	err := _WERC721.contract.Call(opts, &out, "getWrappedToken", _wrappedTokenId)
 
 	if err != nil {
-		return *new(string), err
+		return *new(WERC721WrappedToken), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	out0 := *abi.ConvertType(out[0], new(WERC721WrappedToken)).(*WERC721WrappedToken)
 
 	return out0, err
 
 }
 
-// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+// GetWrappedToken is a free data retrieval call binding the contract method 0xb8cac62b.
 //
-// Solidity: function symbol() view returns(string)
-func (_WERC721 *WERC721Session) Symbol() (string, error) {
-	return _WERC721.Contract.Symbol(&_WERC721.CallOpts)
+// Solidity: function getWrappedToken(uint256 _wrappedTokenId) view returns((address,uint256))
+func (_WERC721 *WERC721Session) GetWrappedToken(_wrappedTokenId *big.Int) (WERC721WrappedToken, error) {
+	return _WERC721.Contract.GetWrappedToken(&_WERC721.CallOpts, _wrappedTokenId)
 }
 
-// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+// GetWrappedToken is a free data retrieval call binding the contract method 0xb8cac62b.
 //
-// Solidity: function symbol() view returns(string)
-func (_WERC721 *WERC721CallerSession) Symbol() (string, error) {
-	return _WERC721.Contract.Symbol(&_WERC721.CallOpts)
+// Solidity: function getWrappedToken(uint256 _wrappedTokenId) view returns((address,uint256))
+func (_WERC721 *WERC721CallerSession) GetWrappedToken(_wrappedTokenId *big.Int) (WERC721WrappedToken, error) {
+	return _WERC721.Contract.GetWrappedToken(&_WERC721.CallOpts, _wrappedTokenId)
 }
 
-// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+// GetWrappedTokenId is a free data retrieval call binding the contract method 0xd19d7770.
 //
-// Solidity: function tokenURI(uint256 tokenId) view returns(string)
-func (_WERC721 *WERC721Caller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+// Solidity: function getWrappedTokenId(address _tokenAddress, uint256 _tokenId) view returns(uint256)
+func (_WERC721 *WERC721Caller) GetWrappedTokenId(opts *bind.CallOpts, _tokenAddress common.Address, _tokenId *big.Int) (*big.Int, error) {
 	var out []interface{}
-	err := _WERC721.contract.Call(opts, &out, "tokenURI", tokenId)
+	err := _WERC721.contract.Call(opts, &out, "getWrappedTokenId", _tokenAddress, _tokenId)
 
 	if err != nil {
-		return *new(string), err
+		return *new(*big.Int), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
 
 	return out0, err
 
 }
 
-// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+// GetWrappedTokenId is a free data retrieval call binding the contract method 0xd19d7770.
 //
-// Solidity: function tokenURI(uint256 tokenId) view returns(string)
-func (_WERC721 *WERC721Session) TokenURI(tokenId *big.Int) (string, error) {
-	return _WERC721.Contract.TokenURI(&_WERC721.CallOpts, tokenId)
+// Solidity: function getWrappedTokenId(address _tokenAddress, uint256 _tokenId) view returns(uint256)
+func (_WERC721 *WERC721Session) GetWrappedTokenId(_tokenAddress common.Address, _tokenId *big.Int) (*big.Int, error) {
+	return _WERC721.Contract.GetWrappedTokenId(&_WERC721.CallOpts, _tokenAddress, _tokenId)
 }
 
-// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+// GetWrappedTokenId is a free data retrieval call binding the contract method 0xd19d7770.
 //
-// Solidity: function tokenURI(uint256 tokenId) view returns(string)
-func (_WERC721 *WERC721CallerSession) TokenURI(tokenId *big.Int) (string, error) {
-	return _WERC721.Contract.TokenURI(&_WERC721.CallOpts, tokenId)
+// Solidity: function getWrappedTokenId(address _tokenAddress, uint256 _tokenId) view returns(uint256)
+func (_WERC721 *WERC721CallerSession) GetWrappedTokenId(_tokenAddress common.Address, _tokenId *big.Int) (*big.Int, error) {
+	return _WERC721.Contract.GetWrappedTokenId(&_WERC721.CallOpts, _tokenAddress, _tokenId)
 }
 
-// TokensData is a free data retrieval call binding the contract method 0x599ed3ff.
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
 //
-// Solidity: function tokensData(uint256 ) view returns(string)
-func (_WERC721 *WERC721Caller) TokensData(opts *bind.CallOpts, arg0 *big.Int) (string, error) {
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_WERC721 *WERC721Caller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
 	var out []interface{}
-	err := _WERC721.contract.Call(opts, &out, "tokensData", arg0)
+	err := _WERC721.contract.Call(opts, &out, "hasRole", role, account)
 
 	if err != nil {
-		return *new(string), err
+		return *new(bool), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
 
 	return out0, err
 
 }
 
-// TokensData is a free data retrieval call binding the contract method 0x599ed3ff.
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
 //
-// Solidity: function tokensData(uint256 ) view returns(string)
-func (_WERC721 *WERC721Session) TokensData(arg0 *big.Int) (string, error) {
-	return _WERC721.Contract.TokensData(&_WERC721.CallOpts, arg0)
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_WERC721 *WERC721Session) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _WERC721.Contract.HasRole(&_WERC721.CallOpts, role, account)
 }
 
-// TokensData is a free data retrieval call binding the contract method 0x599ed3ff.
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
 //
-// Solidity: function tokensData(uint256 ) view returns(string)
-func (_WERC721 *WERC721CallerSession) TokensData(arg0 *big.Int) (string, error) {
-	return _WERC721.Contract.TokensData(&_WERC721.CallOpts, arg0)
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_WERC721 *WERC721CallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _WERC721.Contract.HasRole(&_WERC721.CallOpts, role, account)
 }
 
-// TokensOfOwner is a free data retrieval call binding the contract method 0x8462151c.
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
 //
-// Solidity: function tokensOfOwner(address _ownerOfTokens) view returns(uint256[])
-func (_WERC721 *WERC721Caller) TokensOfOwner(opts *bind.CallOpts, _ownerOfTokens common.Address) ([]*big.Int, error) {
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_WERC721 *WERC721Caller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
 	var out []interface{}
-	err := _WERC721.contract.Call(opts, &out, "tokensOfOwner", _ownerOfTokens)
+	err := _WERC721.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
 
 	if err != nil {
-		return *new([]*big.Int), err
+		return *new(bool), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
 
 	return out0, err
 
 }
 
-// TokensOfOwner is a free data retrieval call binding the contract method 0x8462151c.
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
 //
-// Solidity: function tokensOfOwner(address _ownerOfTokens) view returns(uint256[])
-func (_WERC721 *WERC721Session) TokensOfOwner(_ownerOfTokens common.Address) ([]*big.Int, error) {
-	return _WERC721.Contract.TokensOfOwner(&_WERC721.CallOpts, _ownerOfTokens)
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_WERC721 *WERC721Session) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _WERC721.Contract.IsApprovedForAll(&_WERC721.CallOpts, owner, operator)
 }
 
-// TokensOfOwner is a free data retrieval call binding the contract method 0x8462151c.
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
 //
-// Solidity: function tokensOfOwner(address _ownerOfTokens) view returns(uint256[])
-func (_WERC721 *WERC721CallerSession) TokensOfOwner(_ownerOfTokens common.Address) ([]*big.Int, error) {
-	return _WERC721.Contract.TokensOfOwner(&_WERC721.CallOpts, _ownerOfTokens)
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_WERC721 *WERC721CallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _WERC721.Contract.IsApprovedForAll(&_WERC721.CallOpts, owner, operator)
 }
 
-// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+// IsGuardian is a free data retrieval call binding the contract method 0x0c68ba21.
 //
-// Solidity: function totalSupply() view returns(uint256)
-func (_WERC721 *WERC721Caller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+// Solidity: function isGuardian(address _account) view returns(bool)
+func (_WERC721 *WERC721Caller) IsGuardian(opts *bind.CallOpts, _account common.Address) (bool, error) {
 	var out []interface{}
-	err := _WERC721.contract.Call(opts, &out, "totalSupply")
+	err := _WERC721.contract.Call(opts, &out, "isGuardian", _account)
 
 	if err != nil {
-		return *new(*big.Int), err
+		return *new(bool), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
 
 	return out0, err
 
 }
 
-// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+// IsGuardian is a free data retrieval call binding the contract method 0x0c68ba21.
 //
-// Solidity: function totalSupply() view returns(uint256)
-func (_WERC721 *WERC721Session) TotalSupply() (*big.Int, error) {
-	return _WERC721.Contract.TotalSupply(&_WERC721.CallOpts)
+// Solidity: function isGuardian(address _account) view returns(bool)
+func (_WERC721 *WERC721Session) IsGuardian(_account common.Address) (bool, error) {
+	return _WERC721.Contract.IsGuardian(&_WERC721.CallOpts, _account)
 }
 
-// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+// IsGuardian is a free data retrieval call binding the contract method 0x0c68ba21.
 //
-// Solidity: function totalSupply() view returns(uint256)
-func (_WERC721 *WERC721CallerSession) TotalSupply() (*big.Int, error) {
-	return _WERC721.Contract.TotalSupply(&_WERC721.CallOpts)
+// Solidity: function isGuardian(address _account) view returns(bool)
+func (_WERC721 *WERC721CallerSession) IsGuardian(_account common.Address) (bool, error) {
+	return _WERC721.Contract.IsGuardian(&_WERC721.CallOpts, _account)
 }
 
-// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+// IsPauseExempt is a free data retrieval call binding the contract method 0x4294dd2a.
 //
-// Solidity: function approve(address to, uint256 tokenId) returns()
-func (_WERC721 *WERC721Transactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
-	return _WERC721.contract.Transact(opts, "approve", to, tokenId)
+// Solidity: function isPauseExempt(address _account) view returns(bool)
+func (_WERC721 *WERC721Caller) IsPauseExempt(opts *bind.CallOpts, _account common.Address) (bool, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "isPauseExempt", _account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
 }
 
-// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+// IsPauseExempt is a free data retrieval call binding the contract method 0x4294dd2a.
 //
-// Solidity: function approve(address to, uint256 tokenId) returns()
-func (_WERC721 *WERC721Session) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
-	return _WERC721.Contract.Approve(&_WERC721.TransactOpts, to, tokenId)
+// Solidity: function isPauseExempt(address _account) view returns(bool)
+func (_WERC721 *WERC721Session) IsPauseExempt(_account common.Address) (bool, error) {
+	return _WERC721.Contract.IsPauseExempt(&_WERC721.CallOpts, _account)
 }
 
-// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+// IsPauseExempt is a free data retrieval call binding the contract method 0x4294dd2a.
 //
-// Solidity: function approve(address to, uint256 tokenId) returns()
-func (_WERC721 *WERC721TransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
-	return _WERC721.Contract.Approve(&_WERC721.TransactOpts, to, tokenId)
+// Solidity: function isPauseExempt(address _account) view returns(bool)
+func (_WERC721 *WERC721CallerSession) IsPauseExempt(_account common.Address) (bool, error) {
+	return _WERC721.Contract.IsPauseExempt(&_WERC721.CallOpts, _account)
 }
 
-// Mint is a paid mutator transaction binding the contract method 0xd0def521.
+// IsTrustedForwarder is a free data retrieval call binding the contract method 0x572b6c05.
 //
-// Solidity: function mint(address _to, string _data) returns()
-func (_WERC721 *WERC721Transactor) Mint(opts *bind.TransactOpts, _to common.Address, _data string) (*types.Transaction, error) {
-	return _WERC721.contract.Transact(opts, "mint", _to, _data)
+// Solidity: function isTrustedForwarder(address _forwarder) view returns(bool)
+func (_WERC721 *WERC721Caller) IsTrustedForwarder(opts *bind.CallOpts, _forwarder common.Address) (bool, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "isTrustedForwarder", _forwarder)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
 }
 
-// Mint is a paid mutator transaction binding the contract method 0xd0def521.
+// IsTrustedForwarder is a free data retrieval call binding the contract method 0x572b6c05.
 //
-// Solidity: function mint(address _to, string _data) returns()
-func (_WERC721 *WERC721Session) Mint(_to common.Address, _data string) (*types.Transaction, error) {
-	return _WERC721.Contract.Mint(&_WERC721.TransactOpts, _to, _data)
+// Solidity: function isTrustedForwarder(address _forwarder) view returns(bool)
+func (_WERC721 *WERC721Session) IsTrustedForwarder(_forwarder common.Address) (bool, error) {
+	return _WERC721.Contract.IsTrustedForwarder(&_WERC721.CallOpts, _forwarder)
 }
 
-// Mint is a paid mutator transaction binding the contract method 0xd0def521.
+// IsTrustedForwarder is a free data retrieval call binding the contract method 0x572b6c05.
 //
-// Solidity: function mint(address _to, string _data) returns()
-func (_WERC721 *WERC721TransactorSession) Mint(_to common.Address, _data string) (*types.Transaction, error) {
-	return _WERC721.Contract.Mint(&_WERC721.TransactOpts, _to, _data)
+// Solidity: function isTrustedForwarder(address _forwarder) view returns(bool)
+func (_WERC721 *WERC721CallerSession) IsTrustedForwarder(_forwarder common.Address) (bool, error) {
+	return _WERC721.Contract.IsTrustedForwarder(&_WERC721.CallOpts, _forwarder)
 }
 
-// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
 //
-// Solidity: function renounceOwnership() returns()
-func (_WERC721 *WERC721Transactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _WERC721.contract.Transact(opts, "renounceOwnership")
+// Solidity: function name() view returns(string)
+func (_WERC721 *WERC721Caller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
 }
 
-// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
 //
-// Solidity: function renounceOwnership() returns()
-func (_WERC721 *WERC721Session) RenounceOwnership() (*types.Transaction, error) {
-	return _WERC721.Contract.RenounceOwnership(&_WERC721.TransactOpts)
+// Solidity: function name() view returns(string)
+func (_WERC721 *WERC721Session) Name() (string, error) {
+	return _WERC721.Contract.Name(&_WERC721.CallOpts)
 }
 
-// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
 //
-// Solidity: function renounceOwnership() returns()
-func (_WERC721 *WERC721TransactorSession) RenounceOwnership() (*types.Transaction, error) {
-	return _WERC721.Contract.RenounceOwnership(&_WERC721.TransactOpts)
+// Solidity: function name() view returns(string)
+func (_WERC721 *WERC721CallerSession) Name() (string, error) {
+	return _WERC721.Contract.Name(&_WERC721.CallOpts)
 }
 
-// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
 //
-// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
-func (_WERC721 *WERC721Transactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
-	return _WERC721.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+// Solidity: function owner() view returns(address)
+func (_WERC721 *WERC721Caller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
 }
 
-// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
 //
-// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
-func (_WERC721 *WERC721Session) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
-	return _WERC721.Contract.SafeTransferFrom(&_WERC721.TransactOpts, from, to, tokenId)
+// Solidity: function owner() view returns(address)
+func (_WERC721 *WERC721Session) Owner() (common.Address, error) {
+	return _WERC721.Contract.Owner(&_WERC721.CallOpts)
 }
 
-// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
 //
-// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
-func (_WERC721 *WERC721TransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
-	return _WERC721.Contract.SafeTransferFrom(&_WERC721.TransactOpts, from, to, tokenId)
+// Solidity: function owner() view returns(address)
+func (_WERC721 *WERC721CallerSession) Owner() (common.Address, error) {
+	return _WERC721.Contract.Owner(&_WERC721.CallOpts)
 }
 
-// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
 //
-// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
-func (_WERC721 *WERC721Transactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
-	return _WERC721.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, _data)
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_WERC721 *WERC721Caller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
 }
 
-// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
 //
-// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
-func (_WERC721 *WERC721Session) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
-	return _WERC721.Contract.SafeTransferFrom0(&_WERC721.TransactOpts, from, to, tokenId, _data)
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_WERC721 *WERC721Session) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _WERC721.Contract.OwnerOf(&_WERC721.CallOpts, tokenId)
 }
 
-// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
 //
-// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
-func (_WERC721 *WERC721TransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
-	return _WERC721.Contract.SafeTransferFrom0(&_WERC721.TransactOpts, from, to, tokenId, _data)
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_WERC721 *WERC721CallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _WERC721.Contract.OwnerOf(&_WERC721.CallOpts, tokenId)
 }
 
-// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
 //
-// Solidity: function setApprovalForAll(address operator, bool approved) returns()
-func (_WERC721 *WERC721Transactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
-	return _WERC721.contract.Transact(opts, "setApprovalForAll", operator, approved)
+// Solidity: function paused() view returns(bool)
+func (_WERC721 *WERC721Caller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
 }
 
-// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
 //
-// Solidity: function setApprovalForAll(address operator, bool approved) returns()
-func (_WERC721 *WERC721Session) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
-	return _WERC721.Contract.SetApprovalForAll(&_WERC721.TransactOpts, operator, approved)
+// Solidity: function paused() view returns(bool)
+func (_WERC721 *WERC721Session) Paused() (bool, error) {
+	return _WERC721.Contract.Paused(&_WERC721.CallOpts)
 }
 
-// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
 //
-// Solidity: function setApprovalForAll(address operator, bool approved) returns()
-func (_WERC721 *WERC721TransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
-	return _WERC721.Contract.SetApprovalForAll(&_WERC721.TransactOpts, operator, approved)
+// Solidity: function paused() view returns(bool)
+func (_WERC721 *WERC721CallerSession) Paused() (bool, error) {
+	return _WERC721.Contract.Paused(&_WERC721.CallOpts)
+}
+
+// PendingOwner is a free data retrieval call binding the contract method 0xe30c3978.
+//
+// Solidity: function pendingOwner() view returns(address)
+func (_WERC721 *WERC721Caller) PendingOwner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "pendingOwner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
 }
 
-// SwitchUserPermissions is a paid mutator transaction binding the contract method 0x48065fa6.
+// PendingOwner is a free data retrieval call binding the contract method 0xe30c3978.
 //
-// Solidity: function switchUserPermissions(address _user) returns()
-func (_WERC721 *WERC721Transactor) SwitchUserPermissions(opts *bind.TransactOpts, _user common.Address) (*types.Transaction, error) {
-	return _WERC721.contract.Transact(opts, "switchUserPermissions", _user)
+// Solidity: function pendingOwner() view returns(address)
+func (_WERC721 *WERC721Session) PendingOwner() (common.Address, error) {
+	return _WERC721.Contract.PendingOwner(&_WERC721.CallOpts)
 }
 
-// SwitchUserPermissions is a paid mutator transaction binding the contract method 0x48065fa6.
+// PendingOwner is a free data retrieval call binding the contract method 0xe30c3978.
 //
-// Solidity: function switchUserPermissions(address _user) returns()
-func (_WERC721 *WERC721Session) SwitchUserPermissions(_user common.Address) (*types.Transaction, error) {
-	return _WERC721.Contract.SwitchUserPermissions(&_WERC721.TransactOpts, _user)
+// Solidity: function pendingOwner() view returns(address)
+func (_WERC721 *WERC721CallerSession) PendingOwner() (common.Address, error) {
+	return _WERC721.Contract.PendingOwner(&_WERC721.CallOpts)
 }
 
-// SwitchUserPermissions is a paid mutator transaction binding the contract method 0x48065fa6.
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
 //
-// Solidity: function switchUserPermissions(address _user) returns()
-func (_WERC721 *WERC721TransactorSession) SwitchUserPermissions(_user common.Address) (*types.Transaction, error) {
-	return _WERC721.Contract.SwitchUserPermissions(&_WERC721.TransactOpts, _user)
+// Solidity: function supportsInterface(bytes4 _interfaceId) view returns(bool)
+func (_WERC721 *WERC721Caller) SupportsInterface(opts *bind.CallOpts, _interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "supportsInterface", _interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
 }
 
-// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
 //
-// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
-func (_WERC721 *WERC721Transactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
-	return _WERC721.contract.Transact(opts, "transferFrom", from, to, tokenId)
+// Solidity: function supportsInterface(bytes4 _interfaceId) view returns(bool)
+func (_WERC721 *WERC721Session) SupportsInterface(_interfaceId [4]byte) (bool, error) {
+	return _WERC721.Contract.SupportsInterface(&_WERC721.CallOpts, _interfaceId)
 }
 
-// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
 //
-// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
-func (_WERC721 *WERC721Session) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
-	return _WERC721.Contract.TransferFrom(&_WERC721.TransactOpts, from, to, tokenId)
+// Solidity: function supportsInterface(bytes4 _interfaceId) view returns(bool)
+func (_WERC721 *WERC721CallerSession) SupportsInterface(_interfaceId [4]byte) (bool, error) {
+	return _WERC721.Contract.SupportsInterface(&_WERC721.CallOpts, _interfaceId)
 }
 
-// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
 //
-// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
-func (_WERC721 *WERC721TransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
-	return _WERC721.Contract.TransferFrom(&_WERC721.TransactOpts, from, to, tokenId)
+// Solidity: function symbol() view returns(string)
+func (_WERC721 *WERC721Caller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_WERC721 *WERC721Session) Symbol() (string, error) {
+	return _WERC721.Contract.Symbol(&_WERC721.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_WERC721 *WERC721CallerSession) Symbol() (string, error) {
+	return _WERC721.Contract.Symbol(&_WERC721.CallOpts)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_WERC721 *WERC721Caller) TokenByIndex(opts *bind.CallOpts, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "tokenByIndex", index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_WERC721 *WERC721Session) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _WERC721.Contract.TokenByIndex(&_WERC721.CallOpts, index)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_WERC721 *WERC721CallerSession) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _WERC721.Contract.TokenByIndex(&_WERC721.CallOpts, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_WERC721 *WERC721Caller) TokenOfOwnerByIndex(opts *bind.CallOpts, owner common.Address, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "tokenOfOwnerByIndex", owner, index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_WERC721 *WERC721Session) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _WERC721.Contract.TokenOfOwnerByIndex(&_WERC721.CallOpts, owner, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_WERC721 *WERC721CallerSession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _WERC721.Contract.TokenOfOwnerByIndex(&_WERC721.CallOpts, owner, index)
 }
 
-// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
-//
-// Solidity: function transferOwnership(address newOwner) returns()
-func (_WERC721 *WERC721Transactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
-	return _WERC721.contract.Transact(opts, "transferOwnership", newOwner)
-}
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 _tokenId) view returns(string)
+func (_WERC721 *WERC721Caller) TokenURI(opts *bind.CallOpts, _tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "tokenURI", _tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 _tokenId) view returns(string)
+func (_WERC721 *WERC721Session) TokenURI(_tokenId *big.Int) (string, error) {
+	return _WERC721.Contract.TokenURI(&_WERC721.CallOpts, _tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 _tokenId) view returns(string)
+func (_WERC721 *WERC721CallerSession) TokenURI(_tokenId *big.Int) (string, error) {
+	return _WERC721.Contract.TokenURI(&_WERC721.CallOpts, _tokenId)
+}
+
+// TokensData is a free data retrieval call binding the contract method 0x599ed3ff.
+//
+// Solidity: function tokensData(uint256 ) view returns(string)
+func (_WERC721 *WERC721Caller) TokensData(opts *bind.CallOpts, arg0 *big.Int) (string, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "tokensData", arg0)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokensData is a free data retrieval call binding the contract method 0x599ed3ff.
+//
+// Solidity: function tokensData(uint256 ) view returns(string)
+func (_WERC721 *WERC721Session) TokensData(arg0 *big.Int) (string, error) {
+	return _WERC721.Contract.TokensData(&_WERC721.CallOpts, arg0)
+}
+
+// TokensData is a free data retrieval call binding the contract method 0x599ed3ff.
+//
+// Solidity: function tokensData(uint256 ) view returns(string)
+func (_WERC721 *WERC721CallerSession) TokensData(arg0 *big.Int) (string, error) {
+	return _WERC721.Contract.TokensData(&_WERC721.CallOpts, arg0)
+}
+
+// TokensOfOwner is a free data retrieval call binding the contract method 0x8462151c.
+//
+// Solidity: function tokensOfOwner(address _ownerOfTokens) view returns(uint256[])
+func (_WERC721 *WERC721Caller) TokensOfOwner(opts *bind.CallOpts, _ownerOfTokens common.Address) ([]*big.Int, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "tokensOfOwner", _ownerOfTokens)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// TokensOfOwner is a free data retrieval call binding the contract method 0x8462151c.
+//
+// Solidity: function tokensOfOwner(address _ownerOfTokens) view returns(uint256[])
+func (_WERC721 *WERC721Session) TokensOfOwner(_ownerOfTokens common.Address) ([]*big.Int, error) {
+	return _WERC721.Contract.TokensOfOwner(&_WERC721.CallOpts, _ownerOfTokens)
+}
+
+// TokensOfOwner is a free data retrieval call binding the contract method 0x8462151c.
+//
+// Solidity: function tokensOfOwner(address _ownerOfTokens) view returns(uint256[])
+func (_WERC721 *WERC721CallerSession) TokensOfOwner(_ownerOfTokens common.Address) ([]*big.Int, error) {
+	return _WERC721.Contract.TokensOfOwner(&_WERC721.CallOpts, _ownerOfTokens)
+}
+
+// TotalMinted is a free data retrieval call binding the contract method 0xa2309ff8.
+//
+// Solidity: function totalMinted() view returns(uint256)
+func (_WERC721 *WERC721Caller) TotalMinted(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "totalMinted")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalMinted is a free data retrieval call binding the contract method 0xa2309ff8.
+//
+// Solidity: function totalMinted() view returns(uint256)
+func (_WERC721 *WERC721Session) TotalMinted() (*big.Int, error) {
+	return _WERC721.Contract.TotalMinted(&_WERC721.CallOpts)
+}
+
+// TotalMinted is a free data retrieval call binding the contract method 0xa2309ff8.
+//
+// Solidity: function totalMinted() view returns(uint256)
+func (_WERC721 *WERC721CallerSession) TotalMinted() (*big.Int, error) {
+	return _WERC721.Contract.TotalMinted(&_WERC721.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_WERC721 *WERC721Caller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _WERC721.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_WERC721 *WERC721Session) TotalSupply() (*big.Int, error) {
+	return _WERC721.Contract.TotalSupply(&_WERC721.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_WERC721 *WERC721CallerSession) TotalSupply() (*big.Int, error) {
+	return _WERC721.Contract.TotalSupply(&_WERC721.CallOpts)
+}
+
+// AcceptAdminRole is a paid mutator transaction binding the contract method 0x8c3d7301.
+//
+// Solidity: function acceptAdminRole() returns()
+func (_WERC721 *WERC721Transactor) AcceptAdminRole(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "acceptAdminRole")
+}
+
+// AcceptAdminRole is a paid mutator transaction binding the contract method 0x8c3d7301.
+//
+// Solidity: function acceptAdminRole() returns()
+func (_WERC721 *WERC721Session) AcceptAdminRole() (*types.Transaction, error) {
+	return _WERC721.Contract.AcceptAdminRole(&_WERC721.TransactOpts)
+}
+
+// AcceptAdminRole is a paid mutator transaction binding the contract method 0x8c3d7301.
+//
+// Solidity: function acceptAdminRole() returns()
+func (_WERC721 *WERC721TransactorSession) AcceptAdminRole() (*types.Transaction, error) {
+	return _WERC721.Contract.AcceptAdminRole(&_WERC721.TransactOpts)
+}
+
+// AcceptOwnership is a paid mutator transaction binding the contract method 0x79ba5097.
+//
+// Solidity: function acceptOwnership() returns()
+func (_WERC721 *WERC721Transactor) AcceptOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "acceptOwnership")
+}
+
+// AcceptOwnership is a paid mutator transaction binding the contract method 0x79ba5097.
+//
+// Solidity: function acceptOwnership() returns()
+func (_WERC721 *WERC721Session) AcceptOwnership() (*types.Transaction, error) {
+	return _WERC721.Contract.AcceptOwnership(&_WERC721.TransactOpts)
+}
+
+// AcceptOwnership is a paid mutator transaction binding the contract method 0x79ba5097.
+//
+// Solidity: function acceptOwnership() returns()
+func (_WERC721 *WERC721TransactorSession) AcceptOwnership() (*types.Transaction, error) {
+	return _WERC721.Contract.AcceptOwnership(&_WERC721.TransactOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_WERC721 *WERC721Transactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_WERC721 *WERC721Session) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.Contract.Approve(&_WERC721.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_WERC721 *WERC721TransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.Contract.Approve(&_WERC721.TransactOpts, to, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 _tokenId) returns()
+func (_WERC721 *WERC721Transactor) Burn(opts *bind.TransactOpts, _tokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "burn", _tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 _tokenId) returns()
+func (_WERC721 *WERC721Session) Burn(_tokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.Contract.Burn(&_WERC721.TransactOpts, _tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 _tokenId) returns()
+func (_WERC721 *WERC721TransactorSession) Burn(_tokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.Contract.Burn(&_WERC721.TransactOpts, _tokenId)
+}
+
+// CancelAdminRoleOffer is a paid mutator transaction binding the contract method 0x79c97f63.
+//
+// Solidity: function cancelAdminRoleOffer(address _account) returns()
+func (_WERC721 *WERC721Transactor) CancelAdminRoleOffer(opts *bind.TransactOpts, _account common.Address) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "cancelAdminRoleOffer", _account)
+}
+
+// CancelAdminRoleOffer is a paid mutator transaction binding the contract method 0x79c97f63.
+//
+// Solidity: function cancelAdminRoleOffer(address _account) returns()
+func (_WERC721 *WERC721Session) CancelAdminRoleOffer(_account common.Address) (*types.Transaction, error) {
+	return _WERC721.Contract.CancelAdminRoleOffer(&_WERC721.TransactOpts, _account)
+}
+
+// CancelAdminRoleOffer is a paid mutator transaction binding the contract method 0x79c97f63.
+//
+// Solidity: function cancelAdminRoleOffer(address _account) returns()
+func (_WERC721 *WERC721TransactorSession) CancelAdminRoleOffer(_account common.Address) (*types.Transaction, error) {
+	return _WERC721.Contract.CancelAdminRoleOffer(&_WERC721.TransactOpts, _account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 _role, address _account) returns()
+func (_WERC721 *WERC721Transactor) GrantRole(opts *bind.TransactOpts, _role [32]byte, _account common.Address) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "grantRole", _role, _account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 _role, address _account) returns()
+func (_WERC721 *WERC721Session) GrantRole(_role [32]byte, _account common.Address) (*types.Transaction, error) {
+	return _WERC721.Contract.GrantRole(&_WERC721.TransactOpts, _role, _account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 _role, address _account) returns()
+func (_WERC721 *WERC721TransactorSession) GrantRole(_role [32]byte, _account common.Address) (*types.Transaction, error) {
+	return _WERC721.Contract.GrantRole(&_WERC721.TransactOpts, _role, _account)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0xd0def521.
+//
+// Solidity: function mint(address _to, string _data) returns()
+func (_WERC721 *WERC721Transactor) Mint(opts *bind.TransactOpts, _to common.Address, _data string) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "mint", _to, _data)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0xd0def521.
+//
+// Solidity: function mint(address _to, string _data) returns()
+func (_WERC721 *WERC721Session) Mint(_to common.Address, _data string) (*types.Transaction, error) {
+	return _WERC721.Contract.Mint(&_WERC721.TransactOpts, _to, _data)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0xd0def521.
+//
+// Solidity: function mint(address _to, string _data) returns()
+func (_WERC721 *WERC721TransactorSession) Mint(_to common.Address, _data string) (*types.Transaction, error) {
+	return _WERC721.Contract.Mint(&_WERC721.TransactOpts, _to, _data)
+}
+
+// MintBatch is a paid mutator transaction binding the contract method 0x924cff6d.
+//
+// Solidity: function mintBatch(address[] _to, string[] _data) returns()
+func (_WERC721 *WERC721Transactor) MintBatch(opts *bind.TransactOpts, _to []common.Address, _data []string) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "mintBatch", _to, _data)
+}
+
+// MintBatch is a paid mutator transaction binding the contract method 0x924cff6d.
+//
+// Solidity: function mintBatch(address[] _to, string[] _data) returns()
+func (_WERC721 *WERC721Session) MintBatch(_to []common.Address, _data []string) (*types.Transaction, error) {
+	return _WERC721.Contract.MintBatch(&_WERC721.TransactOpts, _to, _data)
+}
+
+// MintBatch is a paid mutator transaction binding the contract method 0x924cff6d.
+//
+// Solidity: function mintBatch(address[] _to, string[] _data) returns()
+func (_WERC721 *WERC721TransactorSession) MintBatch(_to []common.Address, _data []string) (*types.Transaction, error) {
+	return _WERC721.Contract.MintBatch(&_WERC721.TransactOpts, _to, _data)
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address _from, uint256 _tokenId, bytes ) returns(bytes4)
+func (_WERC721 *WERC721Transactor) OnERC721Received(opts *bind.TransactOpts, arg0 common.Address, _from common.Address, _tokenId *big.Int, arg3 []byte) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "onERC721Received", arg0, _from, _tokenId, arg3)
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address _from, uint256 _tokenId, bytes ) returns(bytes4)
+func (_WERC721 *WERC721Session) OnERC721Received(arg0 common.Address, _from common.Address, _tokenId *big.Int, arg3 []byte) (*types.Transaction, error) {
+	return _WERC721.Contract.OnERC721Received(&_WERC721.TransactOpts, arg0, _from, _tokenId, arg3)
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address _from, uint256 _tokenId, bytes ) returns(bytes4)
+func (_WERC721 *WERC721TransactorSession) OnERC721Received(arg0 common.Address, _from common.Address, _tokenId *big.Int, arg3 []byte) (*types.Transaction, error) {
+	return _WERC721.Contract.OnERC721Received(&_WERC721.TransactOpts, arg0, _from, _tokenId, arg3)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_WERC721 *WERC721Transactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_WERC721 *WERC721Session) Pause() (*types.Transaction, error) {
+	return _WERC721.Contract.Pause(&_WERC721.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_WERC721 *WERC721TransactorSession) Pause() (*types.Transaction, error) {
+	return _WERC721.Contract.Pause(&_WERC721.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_WERC721 *WERC721Transactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_WERC721 *WERC721Session) RenounceOwnership() (*types.Transaction, error) {
+	return _WERC721.Contract.RenounceOwnership(&_WERC721.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_WERC721 *WERC721TransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _WERC721.Contract.RenounceOwnership(&_WERC721.TransactOpts)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 _role, address _account) returns()
+func (_WERC721 *WERC721Transactor) RenounceRole(opts *bind.TransactOpts, _role [32]byte, _account common.Address) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "renounceRole", _role, _account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 _role, address _account) returns()
+func (_WERC721 *WERC721Session) RenounceRole(_role [32]byte, _account common.Address) (*types.Transaction, error) {
+	return _WERC721.Contract.RenounceRole(&_WERC721.TransactOpts, _role, _account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 _role, address _account) returns()
+func (_WERC721 *WERC721TransactorSession) RenounceRole(_role [32]byte, _account common.Address) (*types.Transaction, error) {
+	return _WERC721.Contract.RenounceRole(&_WERC721.TransactOpts, _role, _account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 _role, address _account) returns()
+func (_WERC721 *WERC721Transactor) RevokeRole(opts *bind.TransactOpts, _role [32]byte, _account common.Address) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "revokeRole", _role, _account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 _role, address _account) returns()
+func (_WERC721 *WERC721Session) RevokeRole(_role [32]byte, _account common.Address) (*types.Transaction, error) {
+	return _WERC721.Contract.RevokeRole(&_WERC721.TransactOpts, _role, _account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 _role, address _account) returns()
+func (_WERC721 *WERC721TransactorSession) RevokeRole(_role [32]byte, _account common.Address) (*types.Transaction, error) {
+	return _WERC721.Contract.RevokeRole(&_WERC721.TransactOpts, _role, _account)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_WERC721 *WERC721Transactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_WERC721 *WERC721Session) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.Contract.SafeTransferFrom(&_WERC721.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_WERC721 *WERC721TransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.Contract.SafeTransferFrom(&_WERC721.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
+func (_WERC721 *WERC721Transactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, _data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
+func (_WERC721 *WERC721Session) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _WERC721.Contract.SafeTransferFrom0(&_WERC721.TransactOpts, from, to, tokenId, _data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
+func (_WERC721 *WERC721TransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _WERC721.Contract.SafeTransferFrom0(&_WERC721.TransactOpts, from, to, tokenId, _data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_WERC721 *WERC721Transactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_WERC721 *WERC721Session) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _WERC721.Contract.SetApprovalForAll(&_WERC721.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_WERC721 *WERC721TransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _WERC721.Contract.SetApprovalForAll(&_WERC721.TransactOpts, operator, approved)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string _newBaseURI) returns()
+func (_WERC721 *WERC721Transactor) SetBaseURI(opts *bind.TransactOpts, _newBaseURI string) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "setBaseURI", _newBaseURI)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string _newBaseURI) returns()
+func (_WERC721 *WERC721Session) SetBaseURI(_newBaseURI string) (*types.Transaction, error) {
+	return _WERC721.Contract.SetBaseURI(&_WERC721.TransactOpts, _newBaseURI)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string _newBaseURI) returns()
+func (_WERC721 *WERC721TransactorSession) SetBaseURI(_newBaseURI string) (*types.Transaction, error) {
+	return _WERC721.Contract.SetBaseURI(&_WERC721.TransactOpts, _newBaseURI)
+}
+
+// SetPauseExempt is a paid mutator transaction binding the contract method 0x43afb798.
+//
+// Solidity: function setPauseExempt(address _account, bool _isExempt) returns()
+func (_WERC721 *WERC721Transactor) SetPauseExempt(opts *bind.TransactOpts, _account common.Address, _isExempt bool) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "setPauseExempt", _account, _isExempt)
+}
+
+// SetPauseExempt is a paid mutator transaction binding the contract method 0x43afb798.
+//
+// Solidity: function setPauseExempt(address _account, bool _isExempt) returns()
+func (_WERC721 *WERC721Session) SetPauseExempt(_account common.Address, _isExempt bool) (*types.Transaction, error) {
+	return _WERC721.Contract.SetPauseExempt(&_WERC721.TransactOpts, _account, _isExempt)
+}
+
+// SetPauseExempt is a paid mutator transaction binding the contract method 0x43afb798.
+//
+// Solidity: function setPauseExempt(address _account, bool _isExempt) returns()
+func (_WERC721 *WERC721TransactorSession) SetPauseExempt(_account common.Address, _isExempt bool) (*types.Transaction, error) {
+	return _WERC721.Contract.SetPauseExempt(&_WERC721.TransactOpts, _account, _isExempt)
+}
+
+// SetTokenData is a paid mutator transaction binding the contract method 0xf61a2699.
+//
+// Solidity: function setTokenData(uint256 _tokenId, string _data) returns()
+func (_WERC721 *WERC721Transactor) SetTokenData(opts *bind.TransactOpts, _tokenId *big.Int, _data string) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "setTokenData", _tokenId, _data)
+}
+
+// SetTokenData is a paid mutator transaction binding the contract method 0xf61a2699.
+//
+// Solidity: function setTokenData(uint256 _tokenId, string _data) returns()
+func (_WERC721 *WERC721Session) SetTokenData(_tokenId *big.Int, _data string) (*types.Transaction, error) {
+	return _WERC721.Contract.SetTokenData(&_WERC721.TransactOpts, _tokenId, _data)
+}
+
+// SetTokenData is a paid mutator transaction binding the contract method 0xf61a2699.
+//
+// Solidity: function setTokenData(uint256 _tokenId, string _data) returns()
+func (_WERC721 *WERC721TransactorSession) SetTokenData(_tokenId *big.Int, _data string) (*types.Transaction, error) {
+	return _WERC721.Contract.SetTokenData(&_WERC721.TransactOpts, _tokenId, _data)
+}
+
+// SetTokenURI is a paid mutator transaction binding the contract method 0x162094c4.
+//
+// Solidity: function setTokenURI(uint256 _tokenId, string _tokenURI) returns()
+func (_WERC721 *WERC721Transactor) SetTokenURI(opts *bind.TransactOpts, _tokenId *big.Int, _tokenURI string) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "setTokenURI", _tokenId, _tokenURI)
+}
+
+// SetTokenURI is a paid mutator transaction binding the contract method 0x162094c4.
+//
+// Solidity: function setTokenURI(uint256 _tokenId, string _tokenURI) returns()
+func (_WERC721 *WERC721Session) SetTokenURI(_tokenId *big.Int, _tokenURI string) (*types.Transaction, error) {
+	return _WERC721.Contract.SetTokenURI(&_WERC721.TransactOpts, _tokenId, _tokenURI)
+}
+
+// SetTokenURI is a paid mutator transaction binding the contract method 0x162094c4.
+//
+// Solidity: function setTokenURI(uint256 _tokenId, string _tokenURI) returns()
+func (_WERC721 *WERC721TransactorSession) SetTokenURI(_tokenId *big.Int, _tokenURI string) (*types.Transaction, error) {
+	return _WERC721.Contract.SetTokenURI(&_WERC721.TransactOpts, _tokenId, _tokenURI)
+}
+
+// SetTrustedForwarder is a paid mutator transaction binding the contract method 0xda742228.
+//
+// Solidity: function setTrustedForwarder(address _forwarder) returns()
+func (_WERC721 *WERC721Transactor) SetTrustedForwarder(opts *bind.TransactOpts, _forwarder common.Address) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "setTrustedForwarder", _forwarder)
+}
+
+// SetTrustedForwarder is a paid mutator transaction binding the contract method 0xda742228.
+//
+// Solidity: function setTrustedForwarder(address _forwarder) returns()
+func (_WERC721 *WERC721Session) SetTrustedForwarder(_forwarder common.Address) (*types.Transaction, error) {
+	return _WERC721.Contract.SetTrustedForwarder(&_WERC721.TransactOpts, _forwarder)
+}
+
+// SetTrustedForwarder is a paid mutator transaction binding the contract method 0xda742228.
+//
+// Solidity: function setTrustedForwarder(address _forwarder) returns()
+func (_WERC721 *WERC721TransactorSession) SetTrustedForwarder(_forwarder common.Address) (*types.Transaction, error) {
+	return _WERC721.Contract.SetTrustedForwarder(&_WERC721.TransactOpts, _forwarder)
+}
+
+// TransferAdminRole is a paid mutator transaction binding the contract method 0xada8f919.
+//
+// Solidity: function transferAdminRole(address _newAdmin) returns()
+func (_WERC721 *WERC721Transactor) TransferAdminRole(opts *bind.TransactOpts, _newAdmin common.Address) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "transferAdminRole", _newAdmin)
+}
+
+// TransferAdminRole is a paid mutator transaction binding the contract method 0xada8f919.
+//
+// Solidity: function transferAdminRole(address _newAdmin) returns()
+func (_WERC721 *WERC721Session) TransferAdminRole(_newAdmin common.Address) (*types.Transaction, error) {
+	return _WERC721.Contract.TransferAdminRole(&_WERC721.TransactOpts, _newAdmin)
+}
+
+// TransferAdminRole is a paid mutator transaction binding the contract method 0xada8f919.
+//
+// Solidity: function transferAdminRole(address _newAdmin) returns()
+func (_WERC721 *WERC721TransactorSession) TransferAdminRole(_newAdmin common.Address) (*types.Transaction, error) {
+	return _WERC721.Contract.TransferAdminRole(&_WERC721.TransactOpts, _newAdmin)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_WERC721 *WERC721Transactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_WERC721 *WERC721Session) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.Contract.TransferFrom(&_WERC721.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_WERC721 *WERC721TransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.Contract.TransferFrom(&_WERC721.TransactOpts, from, to, tokenId)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address _newOwner) returns()
+func (_WERC721 *WERC721Transactor) TransferOwnership(opts *bind.TransactOpts, _newOwner common.Address) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "transferOwnership", _newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address _newOwner) returns()
+func (_WERC721 *WERC721Session) TransferOwnership(_newOwner common.Address) (*types.Transaction, error) {
+	return _WERC721.Contract.TransferOwnership(&_WERC721.TransactOpts, _newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address _newOwner) returns()
+func (_WERC721 *WERC721TransactorSession) TransferOwnership(_newOwner common.Address) (*types.Transaction, error) {
+	return _WERC721.Contract.TransferOwnership(&_WERC721.TransactOpts, _newOwner)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_WERC721 *WERC721Transactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_WERC721 *WERC721Session) Unpause() (*types.Transaction, error) {
+	return _WERC721.Contract.Unpause(&_WERC721.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_WERC721 *WERC721TransactorSession) Unpause() (*types.Transaction, error) {
+	return _WERC721.Contract.Unpause(&_WERC721.TransactOpts)
+}
+
+// Unwrap is a paid mutator transaction binding the contract method 0xde0e9a3e.
+//
+// Solidity: function unwrap(uint256 _wrappedTokenId) returns()
+func (_WERC721 *WERC721Transactor) Unwrap(opts *bind.TransactOpts, _wrappedTokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "unwrap", _wrappedTokenId)
+}
+
+// Unwrap is a paid mutator transaction binding the contract method 0xde0e9a3e.
+//
+// Solidity: function unwrap(uint256 _wrappedTokenId) returns()
+func (_WERC721 *WERC721Session) Unwrap(_wrappedTokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.Contract.Unwrap(&_WERC721.TransactOpts, _wrappedTokenId)
+}
+
+// Unwrap is a paid mutator transaction binding the contract method 0xde0e9a3e.
+//
+// Solidity: function unwrap(uint256 _wrappedTokenId) returns()
+func (_WERC721 *WERC721TransactorSession) Unwrap(_wrappedTokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.Contract.Unwrap(&_WERC721.TransactOpts, _wrappedTokenId)
+}
+
+// Wrap is a paid mutator transaction binding the contract method 0xbf376c7a.
+//
+// Solidity: function wrap(address _tokenAddress, uint256 _tokenId) returns(uint256)
+func (_WERC721 *WERC721Transactor) Wrap(opts *bind.TransactOpts, _tokenAddress common.Address, _tokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.contract.Transact(opts, "wrap", _tokenAddress, _tokenId)
+}
+
+// Wrap is a paid mutator transaction binding the contract method 0xbf376c7a.
+//
+// Solidity: function wrap(address _tokenAddress, uint256 _tokenId) returns(uint256)
+func (_WERC721 *WERC721Session) Wrap(_tokenAddress common.Address, _tokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.Contract.Wrap(&_WERC721.TransactOpts, _tokenAddress, _tokenId)
+}
+
+// Wrap is a paid mutator transaction binding the contract method 0xbf376c7a.
+//
+// Solidity: function wrap(address _tokenAddress, uint256 _tokenId) returns(uint256)
+func (_WERC721 *WERC721TransactorSession) Wrap(_tokenAddress common.Address, _tokenId *big.Int) (*types.Transaction, error) {
+	return _WERC721.Contract.Wrap(&_WERC721.TransactOpts, _tokenAddress, _tokenId)
+}
+
+// WERC721AdminRoleOfferCancelledIterator is returned from FilterAdminRoleOfferCancelled and is used to iterate over the raw logs and unpacked data for AdminRoleOfferCancelled events raised by the WERC721 contract.
+type WERC721AdminRoleOfferCancelledIterator struct {
+	Event *WERC721AdminRoleOfferCancelled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WERC721AdminRoleOfferCancelledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WERC721AdminRoleOfferCancelled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WERC721AdminRoleOfferCancelled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WERC721AdminRoleOfferCancelledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WERC721AdminRoleOfferCancelledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WERC721AdminRoleOfferCancelled represents a AdminRoleOfferCancelled event raised by the WERC721 contract.
+type WERC721AdminRoleOfferCancelled struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterAdminRoleOfferCancelled is a free log retrieval operation binding the contract event 0x92f1154ad235f0b053e836ac3ae5025b07c629b7bbf74a1c0884dc8334382670.
+//
+// Solidity: event AdminRoleOfferCancelled(address _account)
+func (_WERC721 *WERC721Filterer) FilterAdminRoleOfferCancelled(opts *bind.FilterOpts) (*WERC721AdminRoleOfferCancelledIterator, error) {
+
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "AdminRoleOfferCancelled")
+	if err != nil {
+		return nil, err
+	}
+	return &WERC721AdminRoleOfferCancelledIterator{contract: _WERC721.contract, event: "AdminRoleOfferCancelled", logs: logs, sub: sub}, nil
+}
+
+// WatchAdminRoleOfferCancelled is a free log subscription operation binding the contract event 0x92f1154ad235f0b053e836ac3ae5025b07c629b7bbf74a1c0884dc8334382670.
+//
+// Solidity: event AdminRoleOfferCancelled(address _account)
+func (_WERC721 *WERC721Filterer) WatchAdminRoleOfferCancelled(opts *bind.WatchOpts, sink chan<- *WERC721AdminRoleOfferCancelled) (event.Subscription, error) {
+
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "AdminRoleOfferCancelled")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WERC721AdminRoleOfferCancelled)
+				if err := _WERC721.contract.UnpackLog(event, "AdminRoleOfferCancelled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAdminRoleOfferCancelled is a log parse operation binding the contract event 0x92f1154ad235f0b053e836ac3ae5025b07c629b7bbf74a1c0884dc8334382670.
+//
+// Solidity: event AdminRoleOfferCancelled(address _account)
+func (_WERC721 *WERC721Filterer) ParseAdminRoleOfferCancelled(log types.Log) (*WERC721AdminRoleOfferCancelled, error) {
+	event := new(WERC721AdminRoleOfferCancelled)
+	if err := _WERC721.contract.UnpackLog(event, "AdminRoleOfferCancelled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WERC721AdminRoleOfferedIterator is returned from FilterAdminRoleOffered and is used to iterate over the raw logs and unpacked data for AdminRoleOffered events raised by the WERC721 contract.
+type WERC721AdminRoleOfferedIterator struct {
+	Event *WERC721AdminRoleOffered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WERC721AdminRoleOfferedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WERC721AdminRoleOffered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WERC721AdminRoleOffered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WERC721AdminRoleOfferedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WERC721AdminRoleOfferedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WERC721AdminRoleOffered represents a AdminRoleOffered event raised by the WERC721 contract.
+type WERC721AdminRoleOffered struct {
+	Account   common.Address
+	OfferedBy common.Address
+	Handover  bool
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterAdminRoleOffered is a free log retrieval operation binding the contract event 0x5361567db258c455805facb30c8419432858c53df1e0ede0b3a0e2272602f26c.
+//
+// Solidity: event AdminRoleOffered(address _account, address _offeredBy, bool _handover)
+func (_WERC721 *WERC721Filterer) FilterAdminRoleOffered(opts *bind.FilterOpts) (*WERC721AdminRoleOfferedIterator, error) {
+
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "AdminRoleOffered")
+	if err != nil {
+		return nil, err
+	}
+	return &WERC721AdminRoleOfferedIterator{contract: _WERC721.contract, event: "AdminRoleOffered", logs: logs, sub: sub}, nil
+}
+
+// WatchAdminRoleOffered is a free log subscription operation binding the contract event 0x5361567db258c455805facb30c8419432858c53df1e0ede0b3a0e2272602f26c.
+//
+// Solidity: event AdminRoleOffered(address _account, address _offeredBy, bool _handover)
+func (_WERC721 *WERC721Filterer) WatchAdminRoleOffered(opts *bind.WatchOpts, sink chan<- *WERC721AdminRoleOffered) (event.Subscription, error) {
+
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "AdminRoleOffered")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WERC721AdminRoleOffered)
+				if err := _WERC721.contract.UnpackLog(event, "AdminRoleOffered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAdminRoleOffered is a log parse operation binding the contract event 0x5361567db258c455805facb30c8419432858c53df1e0ede0b3a0e2272602f26c.
+//
+// Solidity: event AdminRoleOffered(address _account, address _offeredBy, bool _handover)
+func (_WERC721 *WERC721Filterer) ParseAdminRoleOffered(log types.Log) (*WERC721AdminRoleOffered, error) {
+	event := new(WERC721AdminRoleOffered)
+	if err := _WERC721.contract.UnpackLog(event, "AdminRoleOffered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WERC721ApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the WERC721 contract.
+type WERC721ApprovalIterator struct {
+	Event *WERC721Approval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WERC721ApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WERC721Approval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WERC721Approval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WERC721ApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WERC721ApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WERC721Approval represents a Approval event raised by the WERC721 contract.
+type WERC721Approval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_WERC721 *WERC721Filterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*WERC721ApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &WERC721ApprovalIterator{contract: _WERC721.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_WERC721 *WERC721Filterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *WERC721Approval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WERC721Approval)
+				if err := _WERC721.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_WERC721 *WERC721Filterer) ParseApproval(log types.Log) (*WERC721Approval, error) {
+	event := new(WERC721Approval)
+	if err := _WERC721.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WERC721ApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the WERC721 contract.
+type WERC721ApprovalForAllIterator struct {
+	Event *WERC721ApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WERC721ApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WERC721ApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WERC721ApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WERC721ApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WERC721ApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WERC721ApprovalForAll represents a ApprovalForAll event raised by the WERC721 contract.
+type WERC721ApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_WERC721 *WERC721Filterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*WERC721ApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &WERC721ApprovalForAllIterator{contract: _WERC721.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_WERC721 *WERC721Filterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *WERC721ApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WERC721ApprovalForAll)
+				if err := _WERC721.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_WERC721 *WERC721Filterer) ParseApprovalForAll(log types.Log) (*WERC721ApprovalForAll, error) {
+	event := new(WERC721ApprovalForAll)
+	if err := _WERC721.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WERC721BatchMetadataUpdateIterator is returned from FilterBatchMetadataUpdate and is used to iterate over the raw logs and unpacked data for BatchMetadataUpdate events raised by the WERC721 contract.
+type WERC721BatchMetadataUpdateIterator struct {
+	Event *WERC721BatchMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WERC721BatchMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WERC721BatchMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WERC721BatchMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WERC721BatchMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WERC721BatchMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WERC721BatchMetadataUpdate represents a BatchMetadataUpdate event raised by the WERC721 contract.
+type WERC721BatchMetadataUpdate struct {
+	FromTokenId *big.Int
+	ToTokenId   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchMetadataUpdate is a free log retrieval operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_WERC721 *WERC721Filterer) FilterBatchMetadataUpdate(opts *bind.FilterOpts) (*WERC721BatchMetadataUpdateIterator, error) {
+
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &WERC721BatchMetadataUpdateIterator{contract: _WERC721.contract, event: "BatchMetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchMetadataUpdate is a free log subscription operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_WERC721 *WERC721Filterer) WatchBatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *WERC721BatchMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WERC721BatchMetadataUpdate)
+				if err := _WERC721.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchMetadataUpdate is a log parse operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_WERC721 *WERC721Filterer) ParseBatchMetadataUpdate(log types.Log) (*WERC721BatchMetadataUpdate, error) {
+	event := new(WERC721BatchMetadataUpdate)
+	if err := _WERC721.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WERC721MetadataUpdateIterator is returned from FilterMetadataUpdate and is used to iterate over the raw logs and unpacked data for MetadataUpdate events raised by the WERC721 contract.
+type WERC721MetadataUpdateIterator struct {
+	Event *WERC721MetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WERC721MetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WERC721MetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WERC721MetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WERC721MetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WERC721MetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WERC721MetadataUpdate represents a MetadataUpdate event raised by the WERC721 contract.
+type WERC721MetadataUpdate struct {
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterMetadataUpdate is a free log retrieval operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_WERC721 *WERC721Filterer) FilterMetadataUpdate(opts *bind.FilterOpts) (*WERC721MetadataUpdateIterator, error) {
+
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &WERC721MetadataUpdateIterator{contract: _WERC721.contract, event: "MetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchMetadataUpdate is a free log subscription operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_WERC721 *WERC721Filterer) WatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *WERC721MetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WERC721MetadataUpdate)
+				if err := _WERC721.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMetadataUpdate is a log parse operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_WERC721 *WERC721Filterer) ParseMetadataUpdate(log types.Log) (*WERC721MetadataUpdate, error) {
+	event := new(WERC721MetadataUpdate)
+	if err := _WERC721.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WERC721OwnershipTransferStartedIterator is returned from FilterOwnershipTransferStarted and is used to iterate over the raw logs and unpacked data for OwnershipTransferStarted events raised by the WERC721 contract.
+type WERC721OwnershipTransferStartedIterator struct {
+	Event *WERC721OwnershipTransferStarted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WERC721OwnershipTransferStartedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WERC721OwnershipTransferStarted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WERC721OwnershipTransferStarted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WERC721OwnershipTransferStartedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WERC721OwnershipTransferStartedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WERC721OwnershipTransferStarted represents a OwnershipTransferStarted event raised by the WERC721 contract.
+type WERC721OwnershipTransferStarted struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferStarted is a free log retrieval operation binding the contract event 0x38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e22700.
+//
+// Solidity: event OwnershipTransferStarted(address indexed previousOwner, address indexed newOwner)
+func (_WERC721 *WERC721Filterer) FilterOwnershipTransferStarted(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*WERC721OwnershipTransferStartedIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "OwnershipTransferStarted", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &WERC721OwnershipTransferStartedIterator{contract: _WERC721.contract, event: "OwnershipTransferStarted", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferStarted is a free log subscription operation binding the contract event 0x38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e22700.
+//
+// Solidity: event OwnershipTransferStarted(address indexed previousOwner, address indexed newOwner)
+func (_WERC721 *WERC721Filterer) WatchOwnershipTransferStarted(opts *bind.WatchOpts, sink chan<- *WERC721OwnershipTransferStarted, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "OwnershipTransferStarted", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WERC721OwnershipTransferStarted)
+				if err := _WERC721.contract.UnpackLog(event, "OwnershipTransferStarted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferStarted is a log parse operation binding the contract event 0x38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e22700.
+//
+// Solidity: event OwnershipTransferStarted(address indexed previousOwner, address indexed newOwner)
+func (_WERC721 *WERC721Filterer) ParseOwnershipTransferStarted(log types.Log) (*WERC721OwnershipTransferStarted, error) {
+	event := new(WERC721OwnershipTransferStarted)
+	if err := _WERC721.contract.UnpackLog(event, "OwnershipTransferStarted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WERC721OwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the WERC721 contract.
+type WERC721OwnershipTransferredIterator struct {
+	Event *WERC721OwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WERC721OwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WERC721OwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WERC721OwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WERC721OwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WERC721OwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WERC721OwnershipTransferred represents a OwnershipTransferred event raised by the WERC721 contract.
+type WERC721OwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_WERC721 *WERC721Filterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*WERC721OwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &WERC721OwnershipTransferredIterator{contract: _WERC721.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_WERC721 *WERC721Filterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *WERC721OwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WERC721OwnershipTransferred)
+				if err := _WERC721.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_WERC721 *WERC721Filterer) ParseOwnershipTransferred(log types.Log) (*WERC721OwnershipTransferred, error) {
+	event := new(WERC721OwnershipTransferred)
+	if err := _WERC721.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WERC721PauseExemptionChangedIterator is returned from FilterPauseExemptionChanged and is used to iterate over the raw logs and unpacked data for PauseExemptionChanged events raised by the WERC721 contract.
+type WERC721PauseExemptionChangedIterator struct {
+	Event *WERC721PauseExemptionChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WERC721PauseExemptionChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WERC721PauseExemptionChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WERC721PauseExemptionChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WERC721PauseExemptionChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WERC721PauseExemptionChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WERC721PauseExemptionChanged represents a PauseExemptionChanged event raised by the WERC721 contract.
+type WERC721PauseExemptionChanged struct {
+	Account  common.Address
+	IsExempt bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterPauseExemptionChanged is a free log retrieval operation binding the contract event 0x20e39ebaeba8bdfdbd096d13c9b4e40d4629c6d6bc79cc82ad642fd131ce9436.
+//
+// Solidity: event PauseExemptionChanged(address _account, bool _isExempt)
+func (_WERC721 *WERC721Filterer) FilterPauseExemptionChanged(opts *bind.FilterOpts) (*WERC721PauseExemptionChangedIterator, error) {
+
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "PauseExemptionChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &WERC721PauseExemptionChangedIterator{contract: _WERC721.contract, event: "PauseExemptionChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchPauseExemptionChanged is a free log subscription operation binding the contract event 0x20e39ebaeba8bdfdbd096d13c9b4e40d4629c6d6bc79cc82ad642fd131ce9436.
+//
+// Solidity: event PauseExemptionChanged(address _account, bool _isExempt)
+func (_WERC721 *WERC721Filterer) WatchPauseExemptionChanged(opts *bind.WatchOpts, sink chan<- *WERC721PauseExemptionChanged) (event.Subscription, error) {
+
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "PauseExemptionChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WERC721PauseExemptionChanged)
+				if err := _WERC721.contract.UnpackLog(event, "PauseExemptionChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePauseExemptionChanged is a log parse operation binding the contract event 0x20e39ebaeba8bdfdbd096d13c9b4e40d4629c6d6bc79cc82ad642fd131ce9436.
+//
+// Solidity: event PauseExemptionChanged(address _account, bool _isExempt)
+func (_WERC721 *WERC721Filterer) ParsePauseExemptionChanged(log types.Log) (*WERC721PauseExemptionChanged, error) {
+	event := new(WERC721PauseExemptionChanged)
+	if err := _WERC721.contract.UnpackLog(event, "PauseExemptionChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WERC721PausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the WERC721 contract.
+type WERC721PausedIterator struct {
+	Event *WERC721Paused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WERC721PausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WERC721Paused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WERC721Paused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WERC721PausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WERC721PausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WERC721Paused represents a Paused event raised by the WERC721 contract.
+type WERC721Paused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_WERC721 *WERC721Filterer) FilterPaused(opts *bind.FilterOpts) (*WERC721PausedIterator, error) {
+
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &WERC721PausedIterator{contract: _WERC721.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_WERC721 *WERC721Filterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *WERC721Paused) (event.Subscription, error) {
+
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WERC721Paused)
+				if err := _WERC721.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_WERC721 *WERC721Filterer) ParsePaused(log types.Log) (*WERC721Paused, error) {
+	event := new(WERC721Paused)
+	if err := _WERC721.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WERC721RoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the WERC721 contract.
+type WERC721RoleAdminChangedIterator struct {
+	Event *WERC721RoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WERC721RoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WERC721RoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WERC721RoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WERC721RoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WERC721RoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WERC721RoleAdminChanged represents a RoleAdminChanged event raised by the WERC721 contract.
+type WERC721RoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_WERC721 *WERC721Filterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*WERC721RoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &WERC721RoleAdminChangedIterator{contract: _WERC721.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_WERC721 *WERC721Filterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *WERC721RoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WERC721RoleAdminChanged)
+				if err := _WERC721.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_WERC721 *WERC721Filterer) ParseRoleAdminChanged(log types.Log) (*WERC721RoleAdminChanged, error) {
+	event := new(WERC721RoleAdminChanged)
+	if err := _WERC721.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WERC721RoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the WERC721 contract.
+type WERC721RoleGrantedIterator struct {
+	Event *WERC721RoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WERC721RoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WERC721RoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WERC721RoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WERC721RoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WERC721RoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WERC721RoleGranted represents a RoleGranted event raised by the WERC721 contract.
+type WERC721RoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_WERC721 *WERC721Filterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*WERC721RoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &WERC721RoleGrantedIterator{contract: _WERC721.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_WERC721 *WERC721Filterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *WERC721RoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WERC721RoleGranted)
+				if err := _WERC721.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_WERC721 *WERC721Filterer) ParseRoleGranted(log types.Log) (*WERC721RoleGranted, error) {
+	event := new(WERC721RoleGranted)
+	if err := _WERC721.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WERC721RoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the WERC721 contract.
+type WERC721RoleRevokedIterator struct {
+	Event *WERC721RoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WERC721RoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WERC721RoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WERC721RoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WERC721RoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WERC721RoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WERC721RoleRevoked represents a RoleRevoked event raised by the WERC721 contract.
+type WERC721RoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_WERC721 *WERC721Filterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*WERC721RoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &WERC721RoleRevokedIterator{contract: _WERC721.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_WERC721 *WERC721Filterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *WERC721RoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WERC721RoleRevoked)
+				if err := _WERC721.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_WERC721 *WERC721Filterer) ParseRoleRevoked(log types.Log) (*WERC721RoleRevoked, error) {
+	event := new(WERC721RoleRevoked)
+	if err := _WERC721.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WERC721TransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the WERC721 contract.
+type WERC721TransferIterator struct {
+	Event *WERC721Transfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WERC721TransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WERC721Transfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WERC721Transfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WERC721TransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WERC721TransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WERC721Transfer represents a Transfer event raised by the WERC721 contract.
+type WERC721Transfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_WERC721 *WERC721Filterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*WERC721TransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &WERC721TransferIterator{contract: _WERC721.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_WERC721 *WERC721Filterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *WERC721Transfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WERC721Transfer)
+				if err := _WERC721.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
 
-// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
-//
-// Solidity: function transferOwnership(address newOwner) returns()
-func (_WERC721 *WERC721Session) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
-	return _WERC721.Contract.TransferOwnership(&_WERC721.TransactOpts, newOwner)
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
 }
 
-// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
 //
-// Solidity: function transferOwnership(address newOwner) returns()
-func (_WERC721 *WERC721TransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
-	return _WERC721.Contract.TransferOwnership(&_WERC721.TransactOpts, newOwner)
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_WERC721 *WERC721Filterer) ParseTransfer(log types.Log) (*WERC721Transfer, error) {
+	event := new(WERC721Transfer)
+	if err := _WERC721.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
 }
 
-// WERC721ApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the WERC721 contract.
-type WERC721ApprovalIterator struct {
-	Event *WERC721Approval // Event containing the contract specifics and raw log
+// WERC721TrustedForwarderChangedIterator is returned from FilterTrustedForwarderChanged and is used to iterate over the raw logs and unpacked data for TrustedForwarderChanged events raised by the WERC721 contract.
+type WERC721TrustedForwarderChangedIterator struct {
+	Event *WERC721TrustedForwarderChanged // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -765,7 +3905,7 @@ type WERC721ApprovalIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *WERC721ApprovalIterator) Next() bool {
+func (it *WERC721TrustedForwarderChangedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -774,7 +3914,7 @@ func (it *WERC721ApprovalIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(WERC721Approval)
+			it.Event = new(WERC721TrustedForwarderChanged)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -789,7 +3929,7 @@ func (it *WERC721ApprovalIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(WERC721Approval)
+		it.Event = new(WERC721TrustedForwarderChanged)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -805,69 +3945,41 @@ func (it *WERC721ApprovalIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *WERC721ApprovalIterator) Error() error {
+func (it *WERC721TrustedForwarderChangedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *WERC721ApprovalIterator) Close() error {
+func (it *WERC721TrustedForwarderChangedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// WERC721Approval represents a Approval event raised by the WERC721 contract.
-type WERC721Approval struct {
-	Owner    common.Address
-	Approved common.Address
-	TokenId  *big.Int
-	Raw      types.Log // Blockchain specific contextual infos
+// WERC721TrustedForwarderChanged represents a TrustedForwarderChanged event raised by the WERC721 contract.
+type WERC721TrustedForwarderChanged struct {
+	Forwarder common.Address
+	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+// FilterTrustedForwarderChanged is a free log retrieval operation binding the contract event 0x871264f4293af7d2865ae7eae628b228f4991c57cb45b39c99f0b774ebe29018.
 //
-// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
-func (_WERC721 *WERC721Filterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*WERC721ApprovalIterator, error) {
-
-	var ownerRule []interface{}
-	for _, ownerItem := range owner {
-		ownerRule = append(ownerRule, ownerItem)
-	}
-	var approvedRule []interface{}
-	for _, approvedItem := range approved {
-		approvedRule = append(approvedRule, approvedItem)
-	}
-	var tokenIdRule []interface{}
-	for _, tokenIdItem := range tokenId {
-		tokenIdRule = append(tokenIdRule, tokenIdItem)
-	}
+// Solidity: event TrustedForwarderChanged(address _forwarder)
+func (_WERC721 *WERC721Filterer) FilterTrustedForwarderChanged(opts *bind.FilterOpts) (*WERC721TrustedForwarderChangedIterator, error) {
 
-	logs, sub, err := _WERC721.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "TrustedForwarderChanged")
 	if err != nil {
 		return nil, err
 	}
-	return &WERC721ApprovalIterator{contract: _WERC721.contract, event: "Approval", logs: logs, sub: sub}, nil
+	return &WERC721TrustedForwarderChangedIterator{contract: _WERC721.contract, event: "TrustedForwarderChanged", logs: logs, sub: sub}, nil
 }
 
-// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+// WatchTrustedForwarderChanged is a free log subscription operation binding the contract event 0x871264f4293af7d2865ae7eae628b228f4991c57cb45b39c99f0b774ebe29018.
 //
-// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
-func (_WERC721 *WERC721Filterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *WERC721Approval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
-
-	var ownerRule []interface{}
-	for _, ownerItem := range owner {
-		ownerRule = append(ownerRule, ownerItem)
-	}
-	var approvedRule []interface{}
-	for _, approvedItem := range approved {
-		approvedRule = append(approvedRule, approvedItem)
-	}
-	var tokenIdRule []interface{}
-	for _, tokenIdItem := range tokenId {
-		tokenIdRule = append(tokenIdRule, tokenIdItem)
-	}
+// Solidity: event TrustedForwarderChanged(address _forwarder)
+func (_WERC721 *WERC721Filterer) WatchTrustedForwarderChanged(opts *bind.WatchOpts, sink chan<- *WERC721TrustedForwarderChanged) (event.Subscription, error) {
 
-	logs, sub, err := _WERC721.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "TrustedForwarderChanged")
 	if err != nil {
 		return nil, err
 	}
@@ -877,8 +3989,8 @@ func (_WERC721 *WERC721Filterer) WatchApproval(opts *bind.WatchOpts, sink chan<-
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(WERC721Approval)
-				if err := _WERC721.contract.UnpackLog(event, "Approval", log); err != nil {
+				event := new(WERC721TrustedForwarderChanged)
+				if err := _WERC721.contract.UnpackLog(event, "TrustedForwarderChanged", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -899,21 +4011,21 @@ func (_WERC721 *WERC721Filterer) WatchApproval(opts *bind.WatchOpts, sink chan<-
 	}), nil
 }
 
-// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+// ParseTrustedForwarderChanged is a log parse operation binding the contract event 0x871264f4293af7d2865ae7eae628b228f4991c57cb45b39c99f0b774ebe29018.
 //
-// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
-func (_WERC721 *WERC721Filterer) ParseApproval(log types.Log) (*WERC721Approval, error) {
-	event := new(WERC721Approval)
-	if err := _WERC721.contract.UnpackLog(event, "Approval", log); err != nil {
+// Solidity: event TrustedForwarderChanged(address _forwarder)
+func (_WERC721 *WERC721Filterer) ParseTrustedForwarderChanged(log types.Log) (*WERC721TrustedForwarderChanged, error) {
+	event := new(WERC721TrustedForwarderChanged)
+	if err := _WERC721.contract.UnpackLog(event, "TrustedForwarderChanged", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// WERC721ApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the WERC721 contract.
-type WERC721ApprovalForAllIterator struct {
-	Event *WERC721ApprovalForAll // Event containing the contract specifics and raw log
+// WERC721UnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the WERC721 contract.
+type WERC721UnpausedIterator struct {
+	Event *WERC721Unpaused // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -927,7 +4039,7 @@ type WERC721ApprovalForAllIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *WERC721ApprovalForAllIterator) Next() bool {
+func (it *WERC721UnpausedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -936,7 +4048,7 @@ func (it *WERC721ApprovalForAllIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(WERC721ApprovalForAll)
+			it.Event = new(WERC721Unpaused)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -951,7 +4063,7 @@ func (it *WERC721ApprovalForAllIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(WERC721ApprovalForAll)
+		it.Event = new(WERC721Unpaused)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -967,61 +4079,41 @@ func (it *WERC721ApprovalForAllIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *WERC721ApprovalForAllIterator) Error() error {
+func (it *WERC721UnpausedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *WERC721ApprovalForAllIterator) Close() error {
+func (it *WERC721UnpausedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// WERC721ApprovalForAll represents a ApprovalForAll event raised by the WERC721 contract.
-type WERC721ApprovalForAll struct {
-	Owner    common.Address
-	Operator common.Address
-	Approved bool
-	Raw      types.Log // Blockchain specific contextual infos
+// WERC721Unpaused represents a Unpaused event raised by the WERC721 contract.
+type WERC721Unpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
 }
 
-// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
 //
-// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
-func (_WERC721 *WERC721Filterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*WERC721ApprovalForAllIterator, error) {
-
-	var ownerRule []interface{}
-	for _, ownerItem := range owner {
-		ownerRule = append(ownerRule, ownerItem)
-	}
-	var operatorRule []interface{}
-	for _, operatorItem := range operator {
-		operatorRule = append(operatorRule, operatorItem)
-	}
+// Solidity: event Unpaused(address account)
+func (_WERC721 *WERC721Filterer) FilterUnpaused(opts *bind.FilterOpts) (*WERC721UnpausedIterator, error) {
 
-	logs, sub, err := _WERC721.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "Unpaused")
 	if err != nil {
 		return nil, err
 	}
-	return &WERC721ApprovalForAllIterator{contract: _WERC721.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+	return &WERC721UnpausedIterator{contract: _WERC721.contract, event: "Unpaused", logs: logs, sub: sub}, nil
 }
 
-// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
 //
-// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
-func (_WERC721 *WERC721Filterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *WERC721ApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
-
-	var ownerRule []interface{}
-	for _, ownerItem := range owner {
-		ownerRule = append(ownerRule, ownerItem)
-	}
-	var operatorRule []interface{}
-	for _, operatorItem := range operator {
-		operatorRule = append(operatorRule, operatorItem)
-	}
+// Solidity: event Unpaused(address account)
+func (_WERC721 *WERC721Filterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *WERC721Unpaused) (event.Subscription, error) {
 
-	logs, sub, err := _WERC721.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "Unpaused")
 	if err != nil {
 		return nil, err
 	}
@@ -1031,8 +4123,8 @@ func (_WERC721 *WERC721Filterer) WatchApprovalForAll(opts *bind.WatchOpts, sink
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(WERC721ApprovalForAll)
-				if err := _WERC721.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+				event := new(WERC721Unpaused)
+				if err := _WERC721.contract.UnpackLog(event, "Unpaused", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1053,21 +4145,21 @@ func (_WERC721 *WERC721Filterer) WatchApprovalForAll(opts *bind.WatchOpts, sink
 	}), nil
 }
 
-// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
 //
-// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
-func (_WERC721 *WERC721Filterer) ParseApprovalForAll(log types.Log) (*WERC721ApprovalForAll, error) {
-	event := new(WERC721ApprovalForAll)
-	if err := _WERC721.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+// Solidity: event Unpaused(address account)
+func (_WERC721 *WERC721Filterer) ParseUnpaused(log types.Log) (*WERC721Unpaused, error) {
+	event := new(WERC721Unpaused)
+	if err := _WERC721.contract.UnpackLog(event, "Unpaused", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// WERC721OwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the WERC721 contract.
-type WERC721OwnershipTransferredIterator struct {
-	Event *WERC721OwnershipTransferred // Event containing the contract specifics and raw log
+// WERC721UnwrappedIterator is returned from FilterUnwrapped and is used to iterate over the raw logs and unpacked data for Unwrapped events raised by the WERC721 contract.
+type WERC721UnwrappedIterator struct {
+	Event *WERC721Unwrapped // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1081,7 +4173,7 @@ type WERC721OwnershipTransferredIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *WERC721OwnershipTransferredIterator) Next() bool {
+func (it *WERC721UnwrappedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1090,7 +4182,7 @@ func (it *WERC721OwnershipTransferredIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(WERC721OwnershipTransferred)
+			it.Event = new(WERC721Unwrapped)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1105,7 +4197,7 @@ func (it *WERC721OwnershipTransferredIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(WERC721OwnershipTransferred)
+		it.Event = new(WERC721Unwrapped)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1121,60 +4213,44 @@ func (it *WERC721OwnershipTransferredIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *WERC721OwnershipTransferredIterator) Error() error {
+func (it *WERC721UnwrappedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *WERC721OwnershipTransferredIterator) Close() error {
+func (it *WERC721UnwrappedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// WERC721OwnershipTransferred represents a OwnershipTransferred event raised by the WERC721 contract.
-type WERC721OwnershipTransferred struct {
-	PreviousOwner common.Address
-	NewOwner      common.Address
-	Raw           types.Log // Blockchain specific contextual infos
+// WERC721Unwrapped represents a Unwrapped event raised by the WERC721 contract.
+type WERC721Unwrapped struct {
+	Owner          common.Address
+	TokenAddress   common.Address
+	TokenId        *big.Int
+	WrappedTokenId *big.Int
+	Raw            types.Log // Blockchain specific contextual infos
 }
 
-// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// FilterUnwrapped is a free log retrieval operation binding the contract event 0x0004d6f644fc2d087d5be8fde32a4db2f8c58d96f5bb217130b5ca6d5af8f21d.
 //
-// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
-func (_WERC721 *WERC721Filterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*WERC721OwnershipTransferredIterator, error) {
-
-	var previousOwnerRule []interface{}
-	for _, previousOwnerItem := range previousOwner {
-		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
-	}
-	var newOwnerRule []interface{}
-	for _, newOwnerItem := range newOwner {
-		newOwnerRule = append(newOwnerRule, newOwnerItem)
-	}
+// Solidity: event Unwrapped(address _owner, address _tokenAddress, uint256 _tokenId, uint256 _wrappedTokenId)
+func (_WERC721 *WERC721Filterer) FilterUnwrapped(opts *bind.FilterOpts) (*WERC721UnwrappedIterator, error) {
 
-	logs, sub, err := _WERC721.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "Unwrapped")
 	if err != nil {
 		return nil, err
 	}
-	return &WERC721OwnershipTransferredIterator{contract: _WERC721.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+	return &WERC721UnwrappedIterator{contract: _WERC721.contract, event: "Unwrapped", logs: logs, sub: sub}, nil
 }
 
-// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// WatchUnwrapped is a free log subscription operation binding the contract event 0x0004d6f644fc2d087d5be8fde32a4db2f8c58d96f5bb217130b5ca6d5af8f21d.
 //
-// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
-func (_WERC721 *WERC721Filterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *WERC721OwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
-
-	var previousOwnerRule []interface{}
-	for _, previousOwnerItem := range previousOwner {
-		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
-	}
-	var newOwnerRule []interface{}
-	for _, newOwnerItem := range newOwner {
-		newOwnerRule = append(newOwnerRule, newOwnerItem)
-	}
+// Solidity: event Unwrapped(address _owner, address _tokenAddress, uint256 _tokenId, uint256 _wrappedTokenId)
+func (_WERC721 *WERC721Filterer) WatchUnwrapped(opts *bind.WatchOpts, sink chan<- *WERC721Unwrapped) (event.Subscription, error) {
 
-	logs, sub, err := _WERC721.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "Unwrapped")
 	if err != nil {
 		return nil, err
 	}
@@ -1184,8 +4260,8 @@ func (_WERC721 *WERC721Filterer) WatchOwnershipTransferred(opts *bind.WatchOpts,
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(WERC721OwnershipTransferred)
-				if err := _WERC721.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+				event := new(WERC721Unwrapped)
+				if err := _WERC721.contract.UnpackLog(event, "Unwrapped", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1206,21 +4282,21 @@ func (_WERC721 *WERC721Filterer) WatchOwnershipTransferred(opts *bind.WatchOpts,
 	}), nil
 }
 
-// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// ParseUnwrapped is a log parse operation binding the contract event 0x0004d6f644fc2d087d5be8fde32a4db2f8c58d96f5bb217130b5ca6d5af8f21d.
 //
-// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
-func (_WERC721 *WERC721Filterer) ParseOwnershipTransferred(log types.Log) (*WERC721OwnershipTransferred, error) {
-	event := new(WERC721OwnershipTransferred)
-	if err := _WERC721.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+// Solidity: event Unwrapped(address _owner, address _tokenAddress, uint256 _tokenId, uint256 _wrappedTokenId)
+func (_WERC721 *WERC721Filterer) ParseUnwrapped(log types.Log) (*WERC721Unwrapped, error) {
+	event := new(WERC721Unwrapped)
+	if err := _WERC721.contract.UnpackLog(event, "Unwrapped", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// WERC721TransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the WERC721 contract.
-type WERC721TransferIterator struct {
-	Event *WERC721Transfer // Event containing the contract specifics and raw log
+// WERC721WrappedIterator is returned from FilterWrapped and is used to iterate over the raw logs and unpacked data for Wrapped events raised by the WERC721 contract.
+type WERC721WrappedIterator struct {
+	Event *WERC721Wrapped // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1234,7 +4310,7 @@ type WERC721TransferIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *WERC721TransferIterator) Next() bool {
+func (it *WERC721WrappedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1243,7 +4319,7 @@ func (it *WERC721TransferIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(WERC721Transfer)
+			it.Event = new(WERC721Wrapped)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1258,7 +4334,7 @@ func (it *WERC721TransferIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(WERC721Transfer)
+		it.Event = new(WERC721Wrapped)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1274,69 +4350,44 @@ func (it *WERC721TransferIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *WERC721TransferIterator) Error() error {
+func (it *WERC721WrappedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *WERC721TransferIterator) Close() error {
+func (it *WERC721WrappedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// WERC721Transfer represents a Transfer event raised by the WERC721 contract.
-type WERC721Transfer struct {
-	From    common.Address
-	To      common.Address
-	TokenId *big.Int
-	Raw     types.Log // Blockchain specific contextual infos
+// WERC721Wrapped represents a Wrapped event raised by the WERC721 contract.
+type WERC721Wrapped struct {
+	Owner          common.Address
+	TokenAddress   common.Address
+	TokenId        *big.Int
+	WrappedTokenId *big.Int
+	Raw            types.Log // Blockchain specific contextual infos
 }
 
-// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+// FilterWrapped is a free log retrieval operation binding the contract event 0x9030e93f976e327ab5ef1166d3fe5cfb0820f381770421bbfef5bc656fa15687.
 //
-// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
-func (_WERC721 *WERC721Filterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*WERC721TransferIterator, error) {
-
-	var fromRule []interface{}
-	for _, fromItem := range from {
-		fromRule = append(fromRule, fromItem)
-	}
-	var toRule []interface{}
-	for _, toItem := range to {
-		toRule = append(toRule, toItem)
-	}
-	var tokenIdRule []interface{}
-	for _, tokenIdItem := range tokenId {
-		tokenIdRule = append(tokenIdRule, tokenIdItem)
-	}
+// Solidity: event Wrapped(address _owner, address _tokenAddress, uint256 _tokenId, uint256 _wrappedTokenId)
+func (_WERC721 *WERC721Filterer) FilterWrapped(opts *bind.FilterOpts) (*WERC721WrappedIterator, error) {
 
-	logs, sub, err := _WERC721.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	logs, sub, err := _WERC721.contract.FilterLogs(opts, "Wrapped")
 	if err != nil {
 		return nil, err
 	}
-	return &WERC721TransferIterator{contract: _WERC721.contract, event: "Transfer", logs: logs, sub: sub}, nil
+	return &WERC721WrappedIterator{contract: _WERC721.contract, event: "Wrapped", logs: logs, sub: sub}, nil
 }
 
-// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+// WatchWrapped is a free log subscription operation binding the contract event 0x9030e93f976e327ab5ef1166d3fe5cfb0820f381770421bbfef5bc656fa15687.
 //
-// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
-func (_WERC721 *WERC721Filterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *WERC721Transfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
-
-	var fromRule []interface{}
-	for _, fromItem := range from {
-		fromRule = append(fromRule, fromItem)
-	}
-	var toRule []interface{}
-	for _, toItem := range to {
-		toRule = append(toRule, toItem)
-	}
-	var tokenIdRule []interface{}
-	for _, tokenIdItem := range tokenId {
-		tokenIdRule = append(tokenIdRule, tokenIdItem)
-	}
+// Solidity: event Wrapped(address _owner, address _tokenAddress, uint256 _tokenId, uint256 _wrappedTokenId)
+func (_WERC721 *WERC721Filterer) WatchWrapped(opts *bind.WatchOpts, sink chan<- *WERC721Wrapped) (event.Subscription, error) {
 
-	logs, sub, err := _WERC721.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	logs, sub, err := _WERC721.contract.WatchLogs(opts, "Wrapped")
 	if err != nil {
 		return nil, err
 	}
@@ -1346,8 +4397,8 @@ func (_WERC721 *WERC721Filterer) WatchTransfer(opts *bind.WatchOpts, sink chan<-
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(WERC721Transfer)
-				if err := _WERC721.contract.UnpackLog(event, "Transfer", log); err != nil {
+				event := new(WERC721Wrapped)
+				if err := _WERC721.contract.UnpackLog(event, "Wrapped", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1368,12 +4419,12 @@ func (_WERC721 *WERC721Filterer) WatchTransfer(opts *bind.WatchOpts, sink chan<-
 	}), nil
 }
 
-// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+// ParseWrapped is a log parse operation binding the contract event 0x9030e93f976e327ab5ef1166d3fe5cfb0820f381770421bbfef5bc656fa15687.
 //
-// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
-func (_WERC721 *WERC721Filterer) ParseTransfer(log types.Log) (*WERC721Transfer, error) {
-	event := new(WERC721Transfer)
-	if err := _WERC721.contract.UnpackLog(event, "Transfer", log); err != nil {
+// Solidity: event Wrapped(address _owner, address _tokenAddress, uint256 _tokenId, uint256 _wrappedTokenId)
+func (_WERC721 *WERC721Filterer) ParseWrapped(log types.Log) (*WERC721Wrapped, error) {
+	event := new(WERC721Wrapped)
+	if err := _WERC721.contract.UnpackLog(event, "Wrapped", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
@@ -4,6 +4,7 @@
 package generated
 
 import (
+	"errors"
 	"math/big"
 	"strings"
 
@@ -17,6 +18,7 @@ import (
 
 // Reference imports to suppress errors if they are not otherwise used.
 var (
+	_ = errors.New
 	_ = big.NewInt
 	_ = strings.NewReader
 	_ = ethereum.NotFound
@@ -26,20 +28,31 @@ var (
 	_ = event.NewSubscription
 )
 
+// WETHMetaData contains all meta data concerning the WETH contract.
+var WETHMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_symbol\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_trustedForwarder\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"_burner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"Burn\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_guardian\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"_isGuardian\",\"type\":\"bool\"}],\"name\":\"GuardianChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"_minter\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"_recepient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"Mint\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_minter\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_allowance\",\"type\":\"uint256\"}],\"name\":\"MinterChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_minter\",\"type\":\"address\"}],\"name\":\"MinterRemoved\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferStarted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"_isExempt\",\"type\":\"bool\"}],\"name\":\"PauseExemptionChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Paused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_supplyCap\",\"type\":\"uint256\"}],\"name\":\"SupplyCapChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_forwarder\",\"type\":\"address\"}],\"name\":\"TrustedForwarderChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Unpaused\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"DOMAIN_SEPARATOR\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"acceptOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"burnFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"subtractedValue\",\"type\":\"uint256\"}],\"name\":\"decreaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_minter\",\"type\":\"address\"}],\"name\":\"getMinted\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_minter\",\"type\":\"address\"}],\"name\":\"getMinterAllowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinters\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getSupplyCap\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTrustedForwarder\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"addedValue\",\"type\":\"uint256\"}],\"name\":\"increaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isGuardian\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isMinter\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isPauseExempt\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_forwarder\",\"type\":\"address\"}],\"name\":\"isTrustedForwarder\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_recepient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"nonces\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"paused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pendingOwner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"permit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_minter\",\"type\":\"address\"}],\"name\":\"removeMinter\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_guardian\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"_isGuardian\",\"type\":\"bool\"}],\"name\":\"setGuardian\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_minter\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_allowance\",\"type\":\"uint256\"}],\"name\":\"setMinter\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"_isExempt\",\"type\":\"bool\"}],\"name\":\"setPauseExempt\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_supplyCap\",\"type\":\"uint256\"}],\"name\":\"setSupplyCap\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_forwarder\",\"type\":\"address\"}],\"name\":\"setTrustedForwarder\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x6101406040527f6e71edae12b1b97f4d1f60370fef10105fa2faae0126114a169c64845d6126c9610120523480156200003757600080fd5b5060405162002a2838038062002a288339810160408190526200005a916200034e565b808380604051806040016040528060018152602001603160f81b8152508686620000936200008d6200015c60201b60201c565b6200016d565b6005620000a183826200046a565b506006620000b082826200046a565b5050825160209384012082519284019290922060c083815260e08290524660a0818152604080517f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f818a018190528183019890985260608101959095526080808601939093523085830152805180860390920182529390920190925280519401939093209092526101005250506008805460ff191690556200015281620001c7565b5050505062000536565b60006200016862000223565b905090565b600080546001600160a01b038381166001600160a01b031980841682178555600180549091169055604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b60088054610100600160a81b0319166101006001600160a01b038416908102919091179091556040519081527f871264f4293af7d2865ae7eae628b228f4991c57cb45b39c99f0b774ebe290189060200160405180910390a150565b6000620002303362000256565b80156200023e575060143610155b1562000251575060131936013560601c90565b503390565b60006001600160a01b038216158015906200028357506008546001600160a01b0383811661010090920416145b92915050565b634e487b7160e01b600052604160045260246000fd5b600082601f830112620002b157600080fd5b81516001600160401b0380821115620002ce57620002ce62000289565b604051601f8301601f19908116603f01168101908282118183101715620002f957620002f962000289565b816040528381526020925086838588010111156200031657600080fd5b600091505b838210156200033a57858201830151818301840152908201906200031b565b600093810190920192909252949350505050565b6000806000606084860312156200036457600080fd5b83516001600160401b03808211156200037c57600080fd5b6200038a878388016200029f565b94506020860151915080821115620003a157600080fd5b50620003b0868287016200029f565b604086015190935090506001600160a01b0381168114620003d057600080fd5b809150509250925092565b600181811c90821680620003f057607f821691505b6020821081036200041157634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200046557600081815260208120601f850160051c81016020861015620004405750805b601f850160051c820191505b8181101562000461578281556001016200044c565b5050505b505050565b81516001600160401b0381111562000486576200048662000289565b6200049e81620004978454620003db565b8462000417565b602080601f831160018114620004d65760008415620004bd5750858301515b600019600386901b1c1916600185901b17855562000461565b600085815260208120601f198616915b828110156200050757888601518255948401946001909101908401620004e6565b5085821015620005265787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b60805160a05160c05160e05161010051610120516124a262000586600039600061123c015260006117b501526000611804015260006117df015260006117640152600061178c01526124a26000f3fe608060405234801561001057600080fd5b50600436106102485760003560e01c806370a082311161013b578063a457c2d7116100b8578063d505accf1161007c578063d505accf1461051b578063da7422281461052e578063dd62ed3e14610541578063e30c39781461057a578063f2fde38b1461058b57600080fd5b8063a457c2d7146104b9578063a9059cbb146104cc578063aa271e1a146104df578063b6a3f59a146104f2578063ce1b815f1461050557600080fd5b80638456cb59116100ff5780638456cb59146104485780638da5cb5b1461045057806395d89b411461047557806396215e921461047d5780639ce38998146104a657600080fd5b806370a08231146103e9578063715018a61461041257806379ba50971461041a57806379cc6790146104225780637ecebe001461043557600080fd5b806339509351116101c957806343afb7981161018d57806343afb7981461037a578063572b6c051461038d5780635c975abb146103a05780636ae8d6c8146103ab5780636b32810b146103d457600080fd5b8063395093511461030d5780633f4ba83a1461032057806340c10f19146103285780634294dd2a1461033b57806342966c681461036757600080fd5b806323b872dd1161021057806323b872dd146102bb5780632b8a1c5a146102ce5780633092afd5146102e3578063313ce567146102f65780633644e5151461030557600080fd5b806306fdde031461024d578063095ea7b31461026b5780630c68ba211461028e57806318160ddd146102a157806320361814146102b3575b600080fd5b61025561059e565b6040516102629190612139565b60405180910390f35b61027e6102793660046121a3565b610630565b6040519015158152602001610262565b61027e61029c3660046121cd565b61064e565b6004545b604051908152602001610262565b600b546102a5565b61027e6102c93660046121e8565b610685565b6102e16102dc366004612224565b610762565b005b6102e16102f13660046121cd565b61080f565b60405160128152602001610262565b6102a56108f4565b61027e61031b3660046121a3565b610903565b6102e1610952565b61027e6103363660046121a3565b6109a5565b61027e6103493660046121cd565b6001600160a01b03166000908152600a602052604090205460ff1690565b6102e1610375366004612260565b610b6e565b6102e1610388366004612224565b610bdd565b61027e61039b3660046121cd565b610c82565b60085460ff1661027e565b6102a56103b93660046121cd565b6001600160a01b03166000908152600e602052604090205490565b6103dc610cb1565b6040516102629190612279565b6102a56103f73660046121cd565b6001600160a01b031660009081526002602052604090205490565b6102e1610d5c565b6102e1610daf565b6102e16104303660046121a3565b610e36565b6102a56104433660046121cd565b610f0f565b6102e1610f2d565b6000546001600160a01b03165b6040516001600160a01b039091168152602001610262565b610255610f80565b6102a561048b3660046121cd565b6001600160a01b03166000908152600f602052604090205490565b6102e16104b43660046121a3565b610f8f565b61027e6104c73660046121a3565b611035565b61027e6104da3660046121a3565b6110ee565b61027e6104ed3660046121cd565b611102565b6102e1610500366004612260565b61110f565b60085461010090046001600160a01b031661045d565b6102e16105293660046122c6565b6111e8565b6102e161053c3660046121cd565b61134c565b6102a561054f366004612339565b6001600160a01b03918216600090815260036020908152604080832093909416825291909152205490565b6001546001600160a01b031661045d565b6102e16105993660046121cd565b6113a1565b6060600580546105ad9061236c565b80601f01602080910402602001604051908101604052809291908181526020018280546105d99061236c565b80156106265780601f106105fb57610100808354040283529160200191610626565b820191906000526020600020905b81548152906001019060200180831161060957829003601f168201915b5050505050905090565b600061064461063d61143b565b8484611445565b5060015b92915050565b6001600160a01b03811660009081526009602052604081205460ff16806106485750506000546001600160a01b0391821691161490565b6000610692848484611561565b6001600160a01b0384166000908152600360205260408120816106b361143b565b6001600160a01b03166001600160a01b031681526020019081526020016000205490508281101561073c5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b60648201526084015b60405180910390fd5b6107578561074861143b565b61075286856123b6565b611445565b506001949350505050565b61076a61143b565b6001600160a01b03166107856000546001600160a01b031690565b6001600160a01b0316146107ab5760405162461bcd60e51b8152600401610733906123c9565b6001600160a01b038216600081815260096020908152604091829020805460ff19168515159081179091558251938452908301527fafef3d05547c718394a99a79aa641db2143708cd1114b68479af4740f173cc5091015b60405180910390a15050565b61081761143b565b6001600160a01b03166108326000546001600160a01b031690565b6001600160a01b0316146108585760405162461bcd60e51b8152600401610733906123c9565b610863600c82611744565b6108a15760405162461bcd60e51b815260206004820152600f60248201526e24b9903737ba10309036b4b73a32b960891b6044820152606401610733565b6001600160a01b0381166000818152600e602090815260408083209290925590519182527fe94479a9f7e1952cc78f2d6baab678adc1b772d936c6583def489e524cb6669291015b60405180910390a150565b60006108fe611760565b905090565b600061064461091061143b565b84846003600061091e61143b565b6001600160a01b03908116825260208083019390935260409182016000908120918b168152925290205461075291906123fe565b61095a61143b565b6001600160a01b03166109756000546001600160a01b031690565b6001600160a01b03161461099b5760405162461bcd60e51b8152600401610733906123c9565b6109a3611852565b565b6000806109b061143b565b90506109bd600c826118eb565b6109fb5760405162461bcd60e51b815260206004820152600f60248201526e24b9903737ba10309036b4b73a32b960891b6044820152606401610733565b6001600160a01b0381166000908152600e6020908152604080832054600f90925290912054610a2b9085906123fe565b1115610a795760405162461bcd60e51b815260206004820152601960248201527f4d696e74657220616c6c6f77616e6365206578636565646564000000000000006044820152606401610733565b600b541580610a9d5750600b5483610a9060045490565b610a9a91906123fe565b11155b610adf5760405162461bcd60e51b815260206004820152601360248201527214dd5c1c1b1e4818d85c08195e18d959591959606a1b6044820152606401610733565b6001600160a01b0381166000908152600f602052604081208054859290610b079084906123fe565b90915550610b179050848461190d565b836001600160a01b0316816001600160a01b03167fab8530f87dc9b59234c4623bf917212bb2536d647574c8e7e5da92c2ede0c9f885604051610b5c91815260200190565b60405180910390a35060019392505050565b610b7f610b7961143b565b826119f8565b610b8761143b565b6001600160a01b0316610b9861143b565b6001600160a01b03167fbac40739b0d4ca32fa2d82fc91630465ba3eddd1598da6fca393b26fb63b945383604051610bd291815260200190565b60405180910390a350565b610be561143b565b6001600160a01b0316610c006000546001600160a01b031690565b6001600160a01b031614610c265760405162461bcd60e51b8152600401610733906123c9565b6001600160a01b0382166000818152600a6020908152604091829020805460ff19168515159081179091558251938452908301527f20e39ebaeba8bdfdbd096d13c9b4e40d4629c6d6bc79cc82ad642fd131ce94369101610803565b60006001600160a01b0382161580159061064857505060085461010090046001600160a01b0390811691161490565b60606000610cbf600c611b53565b67ffffffffffffffff811115610cd757610cd7612411565b604051908082528060200260200182016040528015610d00578160200160208202803683370190505b50905060005b8151811015610d5657610d1a600c82611b5d565b828281518110610d2c57610d2c612427565b6001600160a01b039092166020928302919091019091015280610d4e8161243d565b915050610d06565b50919050565b610d6461143b565b6001600160a01b0316610d7f6000546001600160a01b031690565b6001600160a01b031614610da55760405162461bcd60e51b8152600401610733906123c9565b6109a36000611b69565b610db761143b565b6001546001600160a01b03908116911614610e265760405162461bcd60e51b815260206004820152602960248201527f4f776e61626c6532537465703a2063616c6c6572206973206e6f7420746865206044820152683732bb9037bbb732b960b91b6064820152608401610733565b6109a3610e3161143b565b611b69565b6000610e448361054f61143b565b905081811015610e965760405162461bcd60e51b815260206004820152601d60248201527f4275726e20616d6f756e74206578636565647320616c6c6f77616e63650000006044820152606401610733565b610eac83610ea261143b565b61075285856123b6565b610eb683836119f8565b826001600160a01b0316610ec861143b565b6001600160a01b03167fbac40739b0d4ca32fa2d82fc91630465ba3eddd1598da6fca393b26fb63b945384604051610f0291815260200190565b60405180910390a3505050565b6001600160a01b038116600090815260076020526040812054610648565b610f3861029c61143b565b610f785760405162461bcd60e51b815260206004820152601160248201527024b9903737ba10309033bab0b93234b0b760791b6044820152606401610733565b6109a3611bc3565b6060600680546105ad9061236c565b610f9761143b565b6001600160a01b0316610fb26000546001600160a01b031690565b6001600160a01b031614610fd85760405162461bcd60e51b8152600401610733906123c9565b610fe3600c83611c3f565b506001600160a01b0382166000818152600e6020908152604091829020849055815192835282018390527fd75fd184f209746726e627e2bd2ed917eeb5f5b35120d7e89e4df569e6bb16879101610803565b6000806003600061104461143b565b6001600160a01b03908116825260208083019390935260409182016000908120918816815292529020549050828110156110ce5760405162461bcd60e51b815260206004820152602560248201527f45524332303a2064656372656173656420616c6c6f77616e63652062656c6f77604482015264207a65726f60d81b6064820152608401610733565b6110e46110d961143b565b8561075286856123b6565b5060019392505050565b60006106446110fb61143b565b8484611561565b6000610648600c836118eb565b61111761143b565b6001600160a01b03166111326000546001600160a01b031690565b6001600160a01b0316146111585760405162461bcd60e51b8152600401610733906123c9565b80158061116757506004548110155b6111b35760405162461bcd60e51b815260206004820152601e60248201527f537570706c79206361702069732062656c6f772074686520737570706c7900006044820152606401610733565b600b8190556040518181527ff2fb162690e5fc210d5e0f2d0068f308b2a6e0ffb9af57c73473ef9b3b95f11b906020016108e9565b834211156112385760405162461bcd60e51b815260206004820152601d60248201527f45524332305065726d69743a206578706972656420646561646c696e650000006044820152606401610733565b60007f00000000000000000000000000000000000000000000000000000000000000008888886112678c611c54565b6040805160208101969096526001600160a01b0394851690860152929091166060840152608083015260a082015260c0810186905260e00160405160208183030381529060405280519060200120905060006112c282611c7a565b905060006112d282878787611cc8565b9050896001600160a01b0316816001600160a01b0316146113355760405162461bcd60e51b815260206004820152601e60248201527f45524332305065726d69743a20696e76616c6964207369676e617475726500006044820152606401610733565b6113408a8a8a611445565b50505050505050505050565b61135461143b565b6001600160a01b031661136f6000546001600160a01b031690565b6001600160a01b0316146113955760405162461bcd60e51b8152600401610733906123c9565b61139e81611e71565b50565b6113a961143b565b6001600160a01b03166113c46000546001600160a01b031690565b6001600160a01b0316146113ea5760405162461bcd60e51b8152600401610733906123c9565b600180546001600160a01b0319166001600160a01b0383811691821790925560008054604051929316917f38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e227009190a350565b60006108fe611ec7565b6001600160a01b0383166114a75760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b6064820152608401610733565b6001600160a01b0382166115085760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b6064820152608401610733565b6001600160a01b0383811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b9259101610f02565b6001600160a01b0383166115c55760405162461bcd60e51b815260206004820152602560248201527f45524332303a207472616e736665722066726f6d20746865207a65726f206164604482015264647265737360d81b6064820152608401610733565b6001600160a01b0382166116275760405162461bcd60e51b815260206004820152602360248201527f45524332303a207472616e7366657220746f20746865207a65726f206164647260448201526265737360e81b6064820152608401610733565b611632838383611ef6565b6001600160a01b038316600090815260026020526040902054818110156116aa5760405162461bcd60e51b815260206004820152602660248201527f45524332303a207472616e7366657220616d6f756e7420657863656564732062604482015265616c616e636560d01b6064820152608401610733565b6116b482826123b6565b6001600160a01b0380861660009081526002602052604080822093909355908516815290812080548492906116ea9084906123fe565b92505081905550826001600160a01b0316846001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8460405161173691815260200190565b60405180910390a350505050565b6000611759836001600160a01b038416611f71565b9392505050565b60007f000000000000000000000000000000000000000000000000000000000000000046036117ae57507f000000000000000000000000000000000000000000000000000000000000000090565b50604080517f00000000000000000000000000000000000000000000000000000000000000006020808301919091527f0000000000000000000000000000000000000000000000000000000000000000828401527f000000000000000000000000000000000000000000000000000000000000000060608301524660808301523060a0808401919091528351808403909101815260c0909201909252805191012090565b60085460ff1661189b5760405162461bcd60e51b815260206004820152601460248201527314185d5cd8589b194e881b9bdd081c185d5cd95960621b6044820152606401610733565b6008805460ff191690557f5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa6118ce61143b565b6040516001600160a01b03909116815260200160405180910390a1565b6001600160a01b03811660009081526001830160205260408120541515611759565b6001600160a01b0382166119635760405162461bcd60e51b815260206004820152601f60248201527f45524332303a206d696e7420746f20746865207a65726f2061646472657373006044820152606401610733565b61196f60008383611ef6565b806004600082825461198191906123fe565b90915550506001600160a01b038216600090815260026020526040812080548392906119ae9084906123fe565b90915550506040518181526001600160a01b038316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a35050565b6001600160a01b038216611a585760405162461bcd60e51b815260206004820152602160248201527f45524332303a206275726e2066726f6d20746865207a65726f206164647265736044820152607360f81b6064820152608401610733565b611a6482600083611ef6565b6001600160a01b03821660009081526002602052604090205481811015611ad85760405162461bcd60e51b815260206004820152602260248201527f45524332303a206275726e20616d6f756e7420657863656564732062616c616e604482015261636560f01b6064820152608401610733565b611ae282826123b6565b6001600160a01b03841660009081526002602052604081209190915560048054849290611b109084906123b6565b90915550506040518281526000906001600160a01b038516907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610f02565b6000610648825490565b60006117598383612064565b600080546001600160a01b038381166001600160a01b031980841682178555600180549091169055604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b60085460ff1615611c095760405162461bcd60e51b815260206004820152601060248201526f14185d5cd8589b194e881c185d5cd95960821b6044820152606401610733565b6008805460ff191660011790557f62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a2586118ce61143b565b6000611759836001600160a01b0384166120ea565b6001600160a01b0381166000908152600760205260409020805460018101825590610d56565b6000610648611c87611760565b8360405161190160f01b6020820152602281018390526042810182905260009060620160405160208183030381529060405280519060200120905092915050565b60007f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a0821115611d455760405162461bcd60e51b815260206004820152602260248201527f45434453413a20696e76616c6964207369676e6174757265202773272076616c604482015261756560f01b6064820152608401610733565b8360ff16601b1480611d5a57508360ff16601c145b611db15760405162461bcd60e51b815260206004820152602260248201527f45434453413a20696e76616c6964207369676e6174757265202776272076616c604482015261756560f01b6064820152608401610733565b6040805160008082526020820180845288905260ff871692820192909252606081018590526080810184905260019060a0016020604051602081039080840390855afa158015611e05573d6000803e3d6000fd5b5050604051601f1901519150506001600160a01b038116611e685760405162461bcd60e51b815260206004820152601860248201527f45434453413a20696e76616c6964207369676e617475726500000000000000006044820152606401610733565b95945050505050565b60088054610100600160a81b0319166101006001600160a01b038416908102919091179091556040519081527f871264f4293af7d2865ae7eae628b228f4991c57cb45b39c99f0b774ebe29018906020016108e9565b6000611ed233610c82565b8015611edf575060143610155b15611ef1575060131936013560601c90565b503390565b60085460ff161580611f2057506001600160a01b0383166000908152600a602052604090205460ff165b611f6c5760405162461bcd60e51b815260206004820152601a60248201527f546f6b656e207472616e736665727320617265207061757365640000000000006044820152606401610733565b505050565b6000818152600183016020526040812054801561205a576000611f956001836123b6565b8554909150600090611fa9906001906123b6565b90506000866000018281548110611fc257611fc2612427565b9060005260206000200154905080876000018481548110611fe557611fe5612427565b600091825260209091200155611ffc8360016123fe565b6000828152600189016020526040902055865487908061201e5761201e612456565b60019003818190600052602060002001600090559055866001016000878152602001908152602001600020600090556001945050505050610648565b6000915050610648565b815460009082106120c25760405162461bcd60e51b815260206004820152602260248201527f456e756d657261626c655365743a20696e646578206f7574206f6620626f756e604482015261647360f01b6064820152608401610733565b8260000182815481106120d7576120d7612427565b9060005260206000200154905092915050565b600081815260018301602052604081205461213157508154600181810184556000848152602080822090930184905584548482528286019093526040902091909155610648565b506000610648565b600060208083528351808285015260005b818110156121665785810183015185820160400152820161214a565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b038116811461219e57600080fd5b919050565b600080604083850312156121b657600080fd5b6121bf83612187565b946020939093013593505050565b6000602082840312156121df57600080fd5b61175982612187565b6000806000606084860312156121fd57600080fd5b61220684612187565b925061221460208501612187565b9150604084013590509250925092565b6000806040838503121561223757600080fd5b61224083612187565b91506020830135801515811461225557600080fd5b809150509250929050565b60006020828403121561227257600080fd5b5035919050565b6020808252825182820181905260009190848201906040850190845b818110156122ba5783516001600160a01b031683529284019291840191600101612295565b50909695505050505050565b600080600080600080600060e0888a0312156122e157600080fd5b6122ea88612187565b96506122f860208901612187565b95506040880135945060608801359350608088013560ff8116811461231c57600080fd5b9699959850939692959460a0840135945060c09093013592915050565b6000806040838503121561234c57600080fd5b61235583612187565b915061236360208401612187565b90509250929050565b600181811c9082168061238057607f821691505b602082108103610d5657634e487b7160e01b600052602260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b81810381811115610648576106486123a0565b6020808252818101527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604082015260600190565b80820180821115610648576106486123a0565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b60006001820161244f5761244f6123a0565b5060010190565b634e487b7160e01b600052603160045260246000fdfea2646970667358221220f4b76ce9ce19f00990eb5c4b1c78fc45071a2fabd08c11cabd67a2248b27d71b64736f6c63430008150033",
+}
+
 // WETHABI is the input ABI used to generate the binding from.
-const WETHABI = "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_symbol\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"subtractedValue\",\"type\":\"uint256\"}],\"name\":\"decreaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"addedValue\",\"type\":\"uint256\"}],\"name\":\"increaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_recepient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+// Deprecated: Use WETHMetaData.ABI instead.
+var WETHABI = WETHMetaData.ABI
 
 // WETHBin is the compiled bytecode used for deploying new contracts.
-var WETHBin = "0x60806040523480156200001157600080fd5b5060405162001f6138038062001f6183398181016040528101906200003791906200024f565b818160006200004b6200012560201b60201c565b9050806000806101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff1602179055508073ffffffffffffffffffffffffffffffffffffffff16600073ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a3508160049080519060200190620001019291906200012d565b5080600590805190602001906200011a9291906200012d565b505050505062000432565b600033905090565b8280546200013b9062000357565b90600052602060002090601f0160209004810192826200015f5760008555620001ab565b82601f106200017a57805160ff1916838001178555620001ab565b82800160010185558215620001ab579182015b82811115620001aa5782518255916020019190600101906200018d565b5b509050620001ba9190620001be565b5090565b5b80821115620001d9576000816000905550600101620001bf565b5090565b6000620001f4620001ee84620002eb565b620002c2565b9050828152602081018484840111156200020d57600080fd5b6200021a84828562000321565b509392505050565b600082601f8301126200023457600080fd5b815162000246848260208601620001dd565b91505092915050565b600080604083850312156200026357600080fd5b600083015167ffffffffffffffff8111156200027e57600080fd5b6200028c8582860162000222565b925050602083015167ffffffffffffffff811115620002aa57600080fd5b620002b88582860162000222565b9150509250929050565b6000620002ce620002e1565b9050620002dc82826200038d565b919050565b6000604051905090565b600067ffffffffffffffff821115620003095762000308620003f2565b5b620003148262000421565b9050602081019050919050565b60005b838110156200034157808201518184015260208101905062000324565b8381111562000351576000848401525b50505050565b600060028204905060018216806200037057607f821691505b60208210811415620003875762000386620003c3565b5b50919050565b620003988262000421565b810181811067ffffffffffffffff82111715620003ba57620003b9620003f2565b5b80604052505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b6000601f19601f8301169050919050565b611b1f80620004426000396000f3fe608060405234801561001057600080fd5b50600436106100f55760003560e01c806370a0823111610097578063a457c2d711610066578063a457c2d71461028a578063a9059cbb146102ba578063dd62ed3e146102ea578063f2fde38b1461031a576100f5565b806370a0823114610214578063715018a6146102445780638da5cb5b1461024e57806395d89b411461026c576100f5565b806323b872dd116100d357806323b872dd14610166578063313ce5671461019657806339509351146101b457806340c10f19146101e4576100f5565b806306fdde03146100fa578063095ea7b31461011857806318160ddd14610148575b600080fd5b610102610336565b60405161010f919061148a565b60405180910390f35b610132600480360381019061012d9190611245565b6103c8565b60405161013f919061146f565b60405180910390f35b6101506103e6565b60405161015d91906115ec565b60405180910390f35b610180600480360381019061017b91906111f6565b6103f0565b60405161018d919061146f565b60405180910390f35b61019e6104f1565b6040516101ab9190611607565b60405180910390f35b6101ce60048036038101906101c99190611245565b6104fa565b6040516101db919061146f565b60405180910390f35b6101fe60048036038101906101f99190611245565b6105a6565b60405161020b919061146f565b60405180910390f35b61022e60048036038101906102299190611191565b610638565b60405161023b91906115ec565b60405180910390f35b61024c610681565b005b6102566107bb565b6040516102639190611454565b60405180910390f35b6102746107e4565b604051610281919061148a565b60405180910390f35b6102a4600480360381019061029f9190611245565b610876565b6040516102b1919061146f565b60405180910390f35b6102d460048036038101906102cf9190611245565b61096a565b6040516102e1919061146f565b60405180910390f35b61030460048036038101906102ff91906111ba565b610988565b60405161031191906115ec565b60405180910390f35b610334600480360381019061032f9190611191565b610a0f565b005b60606004805461034590611750565b80601f016020809104026020016040519081016040528092919081815260200182805461037190611750565b80156103be5780601f10610393576101008083540402835291602001916103be565b820191906000526020600020905b8154815290600101906020018083116103a157829003601f168201915b5050505050905090565b60006103dc6103d5610bb8565b8484610bc0565b6001905092915050565b6000600354905090565b60006103fd848484610d8b565b6000600260008673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000206000610448610bb8565b73ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020549050828110156104c8576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016104bf9061152c565b60405180910390fd5b6104e5856104d4610bb8565b85846104e09190611694565b610bc0565b60019150509392505050565b60006012905090565b600061059c610507610bb8565b848460026000610515610bb8565b73ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008873ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002054610597919061163e565b610bc0565b6001905092915050565b60006105b0610bb8565b73ffffffffffffffffffffffffffffffffffffffff166105ce6107bb565b73ffffffffffffffffffffffffffffffffffffffff1614610624576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161061b9061154c565b60405180910390fd5b61062e838361100d565b6001905092915050565b6000600160008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020549050919050565b610689610bb8565b73ffffffffffffffffffffffffffffffffffffffff166106a76107bb565b73ffffffffffffffffffffffffffffffffffffffff16146106fd576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016106f49061154c565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff1660008054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a360008060006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550565b60008060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff16905090565b6060600580546107f390611750565b80601f016020809104026020016040519081016040528092919081815260200182805461081f90611750565b801561086c5780601f106108415761010080835404028352916020019161086c565b820191906000526020600020905b81548152906001019060200180831161084f57829003601f168201915b5050505050905090565b60008060026000610885610bb8565b73ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002054905082811015610942576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610939906115ac565b60405180910390fd5b61095f61094d610bb8565b85858461095a9190611694565b610bc0565b600191505092915050565b600061097e610977610bb8565b8484610d8b565b6001905092915050565b6000600260008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002054905092915050565b610a17610bb8565b73ffffffffffffffffffffffffffffffffffffffff16610a356107bb565b73ffffffffffffffffffffffffffffffffffffffff1614610a8b576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610a829061154c565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff161415610afb576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610af2906114cc565b60405180910390fd5b8073ffffffffffffffffffffffffffffffffffffffff1660008054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a3806000806101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555050565b600033905090565b600073ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff161415610c30576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610c279061158c565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff161415610ca0576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610c97906114ec565b60405180910390fd5b80600260008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020819055508173ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92583604051610d7e91906115ec565b60405180910390a3505050565b600073ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff161415610dfb576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610df29061156c565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff161415610e6b576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610e62906114ac565b60405180910390fd5b610e76838383611162565b6000600160008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002054905081811015610efd576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610ef49061150c565b60405180910390fd5b8181610f099190611694565b600160008673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000208190555081600160008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000206000828254610f9b919061163e565b925050819055508273ffffffffffffffffffffffffffffffffffffffff168473ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef84604051610fff91906115ec565b60405180910390a350505050565b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff16141561107d576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611074906115cc565b60405180910390fd5b61108960008383611162565b806003600082825461109b919061163e565b9250508190555080600160008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008282546110f1919061163e565b925050819055508173ffffffffffffffffffffffffffffffffffffffff16600073ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8360405161115691906115ec565b60405180910390a35050565b505050565b60008135905061117681611abb565b92915050565b60008135905061118b81611ad2565b92915050565b6000602082840312156111a357600080fd5b60006111b184828501611167565b91505092915050565b600080604083850312156111cd57600080fd5b60006111db85828601611167565b92505060206111ec85828601611167565b9150509250929050565b60008060006060848603121561120b57600080fd5b600061121986828701611167565b935050602061122a86828701611167565b925050604061123b8682870161117c565b9150509250925092565b6000806040838503121561125857600080fd5b600061126685828601611167565b92505060206112778582860161117c565b9150509250929050565b61128a816116c8565b82525050565b611299816116da565b82525050565b60006112aa82611622565b6112b4818561162d565b93506112c481856020860161171d565b6112cd816117e0565b840191505092915050565b60006112e560238361162d565b91506112f0826117f1565b604082019050919050565b600061130860268361162d565b915061131382611840565b604082019050919050565b600061132b60228361162d565b91506113368261188f565b604082019050919050565b600061134e60268361162d565b9150611359826118de565b604082019050919050565b600061137160288361162d565b915061137c8261192d565b604082019050919050565b600061139460208361162d565b915061139f8261197c565b602082019050919050565b60006113b760258361162d565b91506113c2826119a5565b604082019050919050565b60006113da60248361162d565b91506113e5826119f4565b604082019050919050565b60006113fd60258361162d565b915061140882611a43565b604082019050919050565b6000611420601f8361162d565b915061142b82611a92565b602082019050919050565b61143f81611706565b82525050565b61144e81611710565b82525050565b60006020820190506114696000830184611281565b92915050565b60006020820190506114846000830184611290565b92915050565b600060208201905081810360008301526114a4818461129f565b905092915050565b600060208201905081810360008301526114c5816112d8565b9050919050565b600060208201905081810360008301526114e5816112fb565b9050919050565b600060208201905081810360008301526115058161131e565b9050919050565b6000602082019050818103600083015261152581611341565b9050919050565b6000602082019050818103600083015261154581611364565b9050919050565b6000602082019050818103600083015261156581611387565b9050919050565b60006020820190508181036000830152611585816113aa565b9050919050565b600060208201905081810360008301526115a5816113cd565b9050919050565b600060208201905081810360008301526115c5816113f0565b9050919050565b600060208201905081810360008301526115e581611413565b9050919050565b60006020820190506116016000830184611436565b92915050565b600060208201905061161c6000830184611445565b92915050565b600081519050919050565b600082825260208201905092915050565b600061164982611706565b915061165483611706565b9250827fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0382111561168957611688611782565b5b828201905092915050565b600061169f82611706565b91506116aa83611706565b9250828210156116bd576116bc611782565b5b828203905092915050565b60006116d3826116e6565b9050919050565b60008115159050919050565b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b6000819050919050565b600060ff82169050919050565b60005b8381101561173b578082015181840152602081019050611720565b8381111561174a576000848401525b50505050565b6000600282049050600182168061176857607f821691505b6020821081141561177c5761177b6117b1565b5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b6000601f19601f8301169050919050565b7f45524332303a207472616e7366657220746f20746865207a65726f206164647260008201527f6573730000000000000000000000000000000000000000000000000000000000602082015250565b7f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160008201527f6464726573730000000000000000000000000000000000000000000000000000602082015250565b7f45524332303a20617070726f766520746f20746865207a65726f20616464726560008201527f7373000000000000000000000000000000000000000000000000000000000000602082015250565b7f45524332303a207472616e7366657220616d6f756e742065786365656473206260008201527f616c616e63650000000000000000000000000000000000000000000000000000602082015250565b7f45524332303a207472616e7366657220616d6f756e742065786365656473206160008201527f6c6c6f77616e6365000000000000000000000000000000000000000000000000602082015250565b7f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572600082015250565b7f45524332303a207472616e736665722066726f6d20746865207a65726f20616460008201527f6472657373000000000000000000000000000000000000000000000000000000602082015250565b7f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460008201527f7265737300000000000000000000000000000000000000000000000000000000602082015250565b7f45524332303a2064656372656173656420616c6c6f77616e63652062656c6f7760008201527f207a65726f000000000000000000000000000000000000000000000000000000602082015250565b7f45524332303a206d696e7420746f20746865207a65726f206164647265737300600082015250565b611ac4816116c8565b8114611acf57600080fd5b50565b611adb81611706565b8114611ae657600080fd5b5056fea26469706673582212200b2961b470f31a2a4e6222a1fec7a91515290b0e69a4fffeb01ab252beadb78864736f6c63430008030033"
+// Deprecated: Use WETHMetaData.Bin instead.
+var WETHBin = WETHMetaData.Bin
 
 // DeployWETH deploys a new Ethereum contract, binding an instance of WETH to it.
-func DeployWETH(auth *bind.TransactOpts, backend bind.ContractBackend, _name string, _symbol string) (common.Address, *types.Transaction, *WETH, error) {
-	parsed, err := abi.JSON(strings.NewReader(WETHABI))
+func DeployWETH(auth *bind.TransactOpts, backend bind.ContractBackend, _name string, _symbol string, _trustedForwarder common.Address) (common.Address, *types.Transaction, *WETH, error) {
+	parsed, err := WETHMetaData.GetAbi()
 	if err != nil {
 		return common.Address{}, nil, nil, err
 	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
 
-	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(WETHBin), backend, _name, _symbol)
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(WETHBin), backend, _name, _symbol, _trustedForwarder)
 	if err != nil {
 		return common.Address{}, nil, nil, err
 	}
@@ -188,6 +201,37 @@ func (_WETH *WETHTransactorRaw) Transact(opts *bind.TransactOpts, method string,
 	return _WETH.Contract.contract.Transact(opts, method, params...)
 }
 
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_WETH *WETHCaller) DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "DOMAIN_SEPARATOR")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_WETH *WETHSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _WETH.Contract.DOMAINSEPARATOR(&_WETH.CallOpts)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_WETH *WETHCallerSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _WETH.Contract.DOMAINSEPARATOR(&_WETH.CallOpts)
+}
+
 // Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
 //
 // Solidity: function allowance(address owner, address spender) view returns(uint256)
@@ -281,6 +325,285 @@ func (_WETH *WETHCallerSession) Decimals() (uint8, error) {
 	return _WETH.Contract.Decimals(&_WETH.CallOpts)
 }
 
+// GetMinted is a free data retrieval call binding the contract method 0x96215e92.
+//
+// Solidity: function getMinted(address _minter) view returns(uint256)
+func (_WETH *WETHCaller) GetMinted(opts *bind.CallOpts, _minter common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "getMinted", _minter)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMinted is a free data retrieval call binding the contract method 0x96215e92.
+//
+// Solidity: function getMinted(address _minter) view returns(uint256)
+func (_WETH *WETHSession) GetMinted(_minter common.Address) (*big.Int, error) {
+	return _WETH.Contract.GetMinted(&_WETH.CallOpts, _minter)
+}
+
+// GetMinted is a free data retrieval call binding the contract method 0x96215e92.
+//
+// Solidity: function getMinted(address _minter) view returns(uint256)
+func (_WETH *WETHCallerSession) GetMinted(_minter common.Address) (*big.Int, error) {
+	return _WETH.Contract.GetMinted(&_WETH.CallOpts, _minter)
+}
+
+// GetMinterAllowance is a free data retrieval call binding the contract method 0x6ae8d6c8.
+//
+// Solidity: function getMinterAllowance(address _minter) view returns(uint256)
+func (_WETH *WETHCaller) GetMinterAllowance(opts *bind.CallOpts, _minter common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "getMinterAllowance", _minter)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMinterAllowance is a free data retrieval call binding the contract method 0x6ae8d6c8.
+//
+// Solidity: function getMinterAllowance(address _minter) view returns(uint256)
+func (_WETH *WETHSession) GetMinterAllowance(_minter common.Address) (*big.Int, error) {
+	return _WETH.Contract.GetMinterAllowance(&_WETH.CallOpts, _minter)
+}
+
+// GetMinterAllowance is a free data retrieval call binding the contract method 0x6ae8d6c8.
+//
+// Solidity: function getMinterAllowance(address _minter) view returns(uint256)
+func (_WETH *WETHCallerSession) GetMinterAllowance(_minter common.Address) (*big.Int, error) {
+	return _WETH.Contract.GetMinterAllowance(&_WETH.CallOpts, _minter)
+}
+
+// GetMinters is a free data retrieval call binding the contract method 0x6b32810b.
+//
+// Solidity: function getMinters() view returns(address[])
+func (_WETH *WETHCaller) GetMinters(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "getMinters")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetMinters is a free data retrieval call binding the contract method 0x6b32810b.
+//
+// Solidity: function getMinters() view returns(address[])
+func (_WETH *WETHSession) GetMinters() ([]common.Address, error) {
+	return _WETH.Contract.GetMinters(&_WETH.CallOpts)
+}
+
+// GetMinters is a free data retrieval call binding the contract method 0x6b32810b.
+//
+// Solidity: function getMinters() view returns(address[])
+func (_WETH *WETHCallerSession) GetMinters() ([]common.Address, error) {
+	return _WETH.Contract.GetMinters(&_WETH.CallOpts)
+}
+
+// GetSupplyCap is a free data retrieval call binding the contract method 0x20361814.
+//
+// Solidity: function getSupplyCap() view returns(uint256)
+func (_WETH *WETHCaller) GetSupplyCap(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "getSupplyCap")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetSupplyCap is a free data retrieval call binding the contract method 0x20361814.
+//
+// Solidity: function getSupplyCap() view returns(uint256)
+func (_WETH *WETHSession) GetSupplyCap() (*big.Int, error) {
+	return _WETH.Contract.GetSupplyCap(&_WETH.CallOpts)
+}
+
+// GetSupplyCap is a free data retrieval call binding the contract method 0x20361814.
+//
+// Solidity: function getSupplyCap() view returns(uint256)
+func (_WETH *WETHCallerSession) GetSupplyCap() (*big.Int, error) {
+	return _WETH.Contract.GetSupplyCap(&_WETH.CallOpts)
+}
+
+// GetTrustedForwarder is a free data retrieval call binding the contract method 0xce1b815f.
+//
+// Solidity: function getTrustedForwarder() view returns(address)
+func (_WETH *WETHCaller) GetTrustedForwarder(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "getTrustedForwarder")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetTrustedForwarder is a free data retrieval call binding the contract method 0xce1b815f.
+//
+// Solidity: function getTrustedForwarder() view returns(address)
+func (_WETH *WETHSession) GetTrustedForwarder() (common.Address, error) {
+	return _WETH.Contract.GetTrustedForwarder(&_WETH.CallOpts)
+}
+
+// GetTrustedForwarder is a free data retrieval call binding the contract method 0xce1b815f.
+//
+// Solidity: function getTrustedForwarder() view returns(address)
+func (_WETH *WETHCallerSession) GetTrustedForwarder() (common.Address, error) {
+	return _WETH.Contract.GetTrustedForwarder(&_WETH.CallOpts)
+}
+
+// IsGuardian is a free data retrieval call binding the contract method 0x0c68ba21.
+//
+// Solidity: function isGuardian(address _account) view returns(bool)
+func (_WETH *WETHCaller) IsGuardian(opts *bind.CallOpts, _account common.Address) (bool, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "isGuardian", _account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsGuardian is a free data retrieval call binding the contract method 0x0c68ba21.
+//
+// Solidity: function isGuardian(address _account) view returns(bool)
+func (_WETH *WETHSession) IsGuardian(_account common.Address) (bool, error) {
+	return _WETH.Contract.IsGuardian(&_WETH.CallOpts, _account)
+}
+
+// IsGuardian is a free data retrieval call binding the contract method 0x0c68ba21.
+//
+// Solidity: function isGuardian(address _account) view returns(bool)
+func (_WETH *WETHCallerSession) IsGuardian(_account common.Address) (bool, error) {
+	return _WETH.Contract.IsGuardian(&_WETH.CallOpts, _account)
+}
+
+// IsMinter is a free data retrieval call binding the contract method 0xaa271e1a.
+//
+// Solidity: function isMinter(address _account) view returns(bool)
+func (_WETH *WETHCaller) IsMinter(opts *bind.CallOpts, _account common.Address) (bool, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "isMinter", _account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsMinter is a free data retrieval call binding the contract method 0xaa271e1a.
+//
+// Solidity: function isMinter(address _account) view returns(bool)
+func (_WETH *WETHSession) IsMinter(_account common.Address) (bool, error) {
+	return _WETH.Contract.IsMinter(&_WETH.CallOpts, _account)
+}
+
+// IsMinter is a free data retrieval call binding the contract method 0xaa271e1a.
+//
+// Solidity: function isMinter(address _account) view returns(bool)
+func (_WETH *WETHCallerSession) IsMinter(_account common.Address) (bool, error) {
+	return _WETH.Contract.IsMinter(&_WETH.CallOpts, _account)
+}
+
+// IsPauseExempt is a free data retrieval call binding the contract method 0x4294dd2a.
+//
+// Solidity: function isPauseExempt(address _account) view returns(bool)
+func (_WETH *WETHCaller) IsPauseExempt(opts *bind.CallOpts, _account common.Address) (bool, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "isPauseExempt", _account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsPauseExempt is a free data retrieval call binding the contract method 0x4294dd2a.
+//
+// Solidity: function isPauseExempt(address _account) view returns(bool)
+func (_WETH *WETHSession) IsPauseExempt(_account common.Address) (bool, error) {
+	return _WETH.Contract.IsPauseExempt(&_WETH.CallOpts, _account)
+}
+
+// IsPauseExempt is a free data retrieval call binding the contract method 0x4294dd2a.
+//
+// Solidity: function isPauseExempt(address _account) view returns(bool)
+func (_WETH *WETHCallerSession) IsPauseExempt(_account common.Address) (bool, error) {
+	return _WETH.Contract.IsPauseExempt(&_WETH.CallOpts, _account)
+}
+
+// IsTrustedForwarder is a free data retrieval call binding the contract method 0x572b6c05.
+//
+// Solidity: function isTrustedForwarder(address _forwarder) view returns(bool)
+func (_WETH *WETHCaller) IsTrustedForwarder(opts *bind.CallOpts, _forwarder common.Address) (bool, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "isTrustedForwarder", _forwarder)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsTrustedForwarder is a free data retrieval call binding the contract method 0x572b6c05.
+//
+// Solidity: function isTrustedForwarder(address _forwarder) view returns(bool)
+func (_WETH *WETHSession) IsTrustedForwarder(_forwarder common.Address) (bool, error) {
+	return _WETH.Contract.IsTrustedForwarder(&_WETH.CallOpts, _forwarder)
+}
+
+// IsTrustedForwarder is a free data retrieval call binding the contract method 0x572b6c05.
+//
+// Solidity: function isTrustedForwarder(address _forwarder) view returns(bool)
+func (_WETH *WETHCallerSession) IsTrustedForwarder(_forwarder common.Address) (bool, error) {
+	return _WETH.Contract.IsTrustedForwarder(&_WETH.CallOpts, _forwarder)
+}
+
 // Name is a free data retrieval call binding the contract method 0x06fdde03.
 //
 // Solidity: function name() view returns(string)
@@ -312,6 +635,37 @@ func (_WETH *WETHCallerSession) Name() (string, error) {
 	return _WETH.Contract.Name(&_WETH.CallOpts)
 }
 
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_WETH *WETHCaller) Nonces(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "nonces", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_WETH *WETHSession) Nonces(owner common.Address) (*big.Int, error) {
+	return _WETH.Contract.Nonces(&_WETH.CallOpts, owner)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_WETH *WETHCallerSession) Nonces(owner common.Address) (*big.Int, error) {
+	return _WETH.Contract.Nonces(&_WETH.CallOpts, owner)
+}
+
 // Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
 //
 // Solidity: function owner() view returns(address)
@@ -343,6 +697,68 @@ func (_WETH *WETHCallerSession) Owner() (common.Address, error) {
 	return _WETH.Contract.Owner(&_WETH.CallOpts)
 }
 
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_WETH *WETHCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_WETH *WETHSession) Paused() (bool, error) {
+	return _WETH.Contract.Paused(&_WETH.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_WETH *WETHCallerSession) Paused() (bool, error) {
+	return _WETH.Contract.Paused(&_WETH.CallOpts)
+}
+
+// PendingOwner is a free data retrieval call binding the contract method 0xe30c3978.
+//
+// Solidity: function pendingOwner() view returns(address)
+func (_WETH *WETHCaller) PendingOwner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "pendingOwner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// PendingOwner is a free data retrieval call binding the contract method 0xe30c3978.
+//
+// Solidity: function pendingOwner() view returns(address)
+func (_WETH *WETHSession) PendingOwner() (common.Address, error) {
+	return _WETH.Contract.PendingOwner(&_WETH.CallOpts)
+}
+
+// PendingOwner is a free data retrieval call binding the contract method 0xe30c3978.
+//
+// Solidity: function pendingOwner() view returns(address)
+func (_WETH *WETHCallerSession) PendingOwner() (common.Address, error) {
+	return _WETH.Contract.PendingOwner(&_WETH.CallOpts)
+}
+
 // Symbol is a free data retrieval call binding the contract method 0x95d89b41.
 //
 // Solidity: function symbol() view returns(string)
@@ -405,6 +821,27 @@ func (_WETH *WETHCallerSession) TotalSupply() (*big.Int, error) {
 	return _WETH.Contract.TotalSupply(&_WETH.CallOpts)
 }
 
+// AcceptOwnership is a paid mutator transaction binding the contract method 0x79ba5097.
+//
+// Solidity: function acceptOwnership() returns()
+func (_WETH *WETHTransactor) AcceptOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "acceptOwnership")
+}
+
+// AcceptOwnership is a paid mutator transaction binding the contract method 0x79ba5097.
+//
+// Solidity: function acceptOwnership() returns()
+func (_WETH *WETHSession) AcceptOwnership() (*types.Transaction, error) {
+	return _WETH.Contract.AcceptOwnership(&_WETH.TransactOpts)
+}
+
+// AcceptOwnership is a paid mutator transaction binding the contract method 0x79ba5097.
+//
+// Solidity: function acceptOwnership() returns()
+func (_WETH *WETHTransactorSession) AcceptOwnership() (*types.Transaction, error) {
+	return _WETH.Contract.AcceptOwnership(&_WETH.TransactOpts)
+}
+
 // Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
 //
 // Solidity: function approve(address spender, uint256 amount) returns(bool)
@@ -426,23 +863,65 @@ func (_WETH *WETHTransactorSession) Approve(spender common.Address, amount *big.
 	return _WETH.Contract.Approve(&_WETH.TransactOpts, spender, amount)
 }
 
-// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
 //
-// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
-func (_WETH *WETHTransactor) DecreaseAllowance(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
-	return _WETH.contract.Transact(opts, "decreaseAllowance", spender, subtractedValue)
+// Solidity: function burn(uint256 _amount) returns()
+func (_WETH *WETHTransactor) Burn(opts *bind.TransactOpts, _amount *big.Int) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "burn", _amount)
 }
 
-// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
 //
-// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
-func (_WETH *WETHSession) DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
-	return _WETH.Contract.DecreaseAllowance(&_WETH.TransactOpts, spender, subtractedValue)
+// Solidity: function burn(uint256 _amount) returns()
+func (_WETH *WETHSession) Burn(_amount *big.Int) (*types.Transaction, error) {
+	return _WETH.Contract.Burn(&_WETH.TransactOpts, _amount)
 }
 
-// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
 //
-// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+// Solidity: function burn(uint256 _amount) returns()
+func (_WETH *WETHTransactorSession) Burn(_amount *big.Int) (*types.Transaction, error) {
+	return _WETH.Contract.Burn(&_WETH.TransactOpts, _amount)
+}
+
+// BurnFrom is a paid mutator transaction binding the contract method 0x79cc6790.
+//
+// Solidity: function burnFrom(address _account, uint256 _amount) returns()
+func (_WETH *WETHTransactor) BurnFrom(opts *bind.TransactOpts, _account common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "burnFrom", _account, _amount)
+}
+
+// BurnFrom is a paid mutator transaction binding the contract method 0x79cc6790.
+//
+// Solidity: function burnFrom(address _account, uint256 _amount) returns()
+func (_WETH *WETHSession) BurnFrom(_account common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _WETH.Contract.BurnFrom(&_WETH.TransactOpts, _account, _amount)
+}
+
+// BurnFrom is a paid mutator transaction binding the contract method 0x79cc6790.
+//
+// Solidity: function burnFrom(address _account, uint256 _amount) returns()
+func (_WETH *WETHTransactorSession) BurnFrom(_account common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _WETH.Contract.BurnFrom(&_WETH.TransactOpts, _account, _amount)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (_WETH *WETHTransactor) DecreaseAllowance(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "decreaseAllowance", spender, subtractedValue)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (_WETH *WETHSession) DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _WETH.Contract.DecreaseAllowance(&_WETH.TransactOpts, spender, subtractedValue)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
 func (_WETH *WETHTransactorSession) DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
 	return _WETH.Contract.DecreaseAllowance(&_WETH.TransactOpts, spender, subtractedValue)
 }
@@ -489,6 +968,69 @@ func (_WETH *WETHTransactorSession) Mint(_recepient common.Address, _amount *big
 	return _WETH.Contract.Mint(&_WETH.TransactOpts, _recepient, _amount)
 }
 
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_WETH *WETHTransactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_WETH *WETHSession) Pause() (*types.Transaction, error) {
+	return _WETH.Contract.Pause(&_WETH.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_WETH *WETHTransactorSession) Pause() (*types.Transaction, error) {
+	return _WETH.Contract.Pause(&_WETH.TransactOpts)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_WETH *WETHTransactor) Permit(opts *bind.TransactOpts, owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "permit", owner, spender, value, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_WETH *WETHSession) Permit(owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _WETH.Contract.Permit(&_WETH.TransactOpts, owner, spender, value, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_WETH *WETHTransactorSession) Permit(owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _WETH.Contract.Permit(&_WETH.TransactOpts, owner, spender, value, deadline, v, r, s)
+}
+
+// RemoveMinter is a paid mutator transaction binding the contract method 0x3092afd5.
+//
+// Solidity: function removeMinter(address _minter) returns()
+func (_WETH *WETHTransactor) RemoveMinter(opts *bind.TransactOpts, _minter common.Address) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "removeMinter", _minter)
+}
+
+// RemoveMinter is a paid mutator transaction binding the contract method 0x3092afd5.
+//
+// Solidity: function removeMinter(address _minter) returns()
+func (_WETH *WETHSession) RemoveMinter(_minter common.Address) (*types.Transaction, error) {
+	return _WETH.Contract.RemoveMinter(&_WETH.TransactOpts, _minter)
+}
+
+// RemoveMinter is a paid mutator transaction binding the contract method 0x3092afd5.
+//
+// Solidity: function removeMinter(address _minter) returns()
+func (_WETH *WETHTransactorSession) RemoveMinter(_minter common.Address) (*types.Transaction, error) {
+	return _WETH.Contract.RemoveMinter(&_WETH.TransactOpts, _minter)
+}
+
 // RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
 //
 // Solidity: function renounceOwnership() returns()
@@ -510,6 +1052,111 @@ func (_WETH *WETHTransactorSession) RenounceOwnership() (*types.Transaction, err
 	return _WETH.Contract.RenounceOwnership(&_WETH.TransactOpts)
 }
 
+// SetGuardian is a paid mutator transaction binding the contract method 0x2b8a1c5a.
+//
+// Solidity: function setGuardian(address _guardian, bool _isGuardian) returns()
+func (_WETH *WETHTransactor) SetGuardian(opts *bind.TransactOpts, _guardian common.Address, _isGuardian bool) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "setGuardian", _guardian, _isGuardian)
+}
+
+// SetGuardian is a paid mutator transaction binding the contract method 0x2b8a1c5a.
+//
+// Solidity: function setGuardian(address _guardian, bool _isGuardian) returns()
+func (_WETH *WETHSession) SetGuardian(_guardian common.Address, _isGuardian bool) (*types.Transaction, error) {
+	return _WETH.Contract.SetGuardian(&_WETH.TransactOpts, _guardian, _isGuardian)
+}
+
+// SetGuardian is a paid mutator transaction binding the contract method 0x2b8a1c5a.
+//
+// Solidity: function setGuardian(address _guardian, bool _isGuardian) returns()
+func (_WETH *WETHTransactorSession) SetGuardian(_guardian common.Address, _isGuardian bool) (*types.Transaction, error) {
+	return _WETH.Contract.SetGuardian(&_WETH.TransactOpts, _guardian, _isGuardian)
+}
+
+// SetMinter is a paid mutator transaction binding the contract method 0x9ce38998.
+//
+// Solidity: function setMinter(address _minter, uint256 _allowance) returns()
+func (_WETH *WETHTransactor) SetMinter(opts *bind.TransactOpts, _minter common.Address, _allowance *big.Int) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "setMinter", _minter, _allowance)
+}
+
+// SetMinter is a paid mutator transaction binding the contract method 0x9ce38998.
+//
+// Solidity: function setMinter(address _minter, uint256 _allowance) returns()
+func (_WETH *WETHSession) SetMinter(_minter common.Address, _allowance *big.Int) (*types.Transaction, error) {
+	return _WETH.Contract.SetMinter(&_WETH.TransactOpts, _minter, _allowance)
+}
+
+// SetMinter is a paid mutator transaction binding the contract method 0x9ce38998.
+//
+// Solidity: function setMinter(address _minter, uint256 _allowance) returns()
+func (_WETH *WETHTransactorSession) SetMinter(_minter common.Address, _allowance *big.Int) (*types.Transaction, error) {
+	return _WETH.Contract.SetMinter(&_WETH.TransactOpts, _minter, _allowance)
+}
+
+// SetPauseExempt is a paid mutator transaction binding the contract method 0x43afb798.
+//
+// Solidity: function setPauseExempt(address _account, bool _isExempt) returns()
+func (_WETH *WETHTransactor) SetPauseExempt(opts *bind.TransactOpts, _account common.Address, _isExempt bool) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "setPauseExempt", _account, _isExempt)
+}
+
+// SetPauseExempt is a paid mutator transaction binding the contract method 0x43afb798.
+//
+// Solidity: function setPauseExempt(address _account, bool _isExempt) returns()
+func (_WETH *WETHSession) SetPauseExempt(_account common.Address, _isExempt bool) (*types.Transaction, error) {
+	return _WETH.Contract.SetPauseExempt(&_WETH.TransactOpts, _account, _isExempt)
+}
+
+// SetPauseExempt is a paid mutator transaction binding the contract method 0x43afb798.
+//
+// Solidity: function setPauseExempt(address _account, bool _isExempt) returns()
+func (_WETH *WETHTransactorSession) SetPauseExempt(_account common.Address, _isExempt bool) (*types.Transaction, error) {
+	return _WETH.Contract.SetPauseExempt(&_WETH.TransactOpts, _account, _isExempt)
+}
+
+// SetSupplyCap is a paid mutator transaction binding the contract method 0xb6a3f59a.
+//
+// Solidity: function setSupplyCap(uint256 _supplyCap) returns()
+func (_WETH *WETHTransactor) SetSupplyCap(opts *bind.TransactOpts, _supplyCap *big.Int) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "setSupplyCap", _supplyCap)
+}
+
+// SetSupplyCap is a paid mutator transaction binding the contract method 0xb6a3f59a.
+//
+// Solidity: function setSupplyCap(uint256 _supplyCap) returns()
+func (_WETH *WETHSession) SetSupplyCap(_supplyCap *big.Int) (*types.Transaction, error) {
+	return _WETH.Contract.SetSupplyCap(&_WETH.TransactOpts, _supplyCap)
+}
+
+// SetSupplyCap is a paid mutator transaction binding the contract method 0xb6a3f59a.
+//
+// Solidity: function setSupplyCap(uint256 _supplyCap) returns()
+func (_WETH *WETHTransactorSession) SetSupplyCap(_supplyCap *big.Int) (*types.Transaction, error) {
+	return _WETH.Contract.SetSupplyCap(&_WETH.TransactOpts, _supplyCap)
+}
+
+// SetTrustedForwarder is a paid mutator transaction binding the contract method 0xda742228.
+//
+// Solidity: function setTrustedForwarder(address _forwarder) returns()
+func (_WETH *WETHTransactor) SetTrustedForwarder(opts *bind.TransactOpts, _forwarder common.Address) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "setTrustedForwarder", _forwarder)
+}
+
+// SetTrustedForwarder is a paid mutator transaction binding the contract method 0xda742228.
+//
+// Solidity: function setTrustedForwarder(address _forwarder) returns()
+func (_WETH *WETHSession) SetTrustedForwarder(_forwarder common.Address) (*types.Transaction, error) {
+	return _WETH.Contract.SetTrustedForwarder(&_WETH.TransactOpts, _forwarder)
+}
+
+// SetTrustedForwarder is a paid mutator transaction binding the contract method 0xda742228.
+//
+// Solidity: function setTrustedForwarder(address _forwarder) returns()
+func (_WETH *WETHTransactorSession) SetTrustedForwarder(_forwarder common.Address) (*types.Transaction, error) {
+	return _WETH.Contract.SetTrustedForwarder(&_WETH.TransactOpts, _forwarder)
+}
+
 // Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
 //
 // Solidity: function transfer(address recipient, uint256 amount) returns(bool)
@@ -554,23 +1201,44 @@ func (_WETH *WETHTransactorSession) TransferFrom(sender common.Address, recipien
 
 // TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
 //
-// Solidity: function transferOwnership(address newOwner) returns()
-func (_WETH *WETHTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
-	return _WETH.contract.Transact(opts, "transferOwnership", newOwner)
+// Solidity: function transferOwnership(address _newOwner) returns()
+func (_WETH *WETHTransactor) TransferOwnership(opts *bind.TransactOpts, _newOwner common.Address) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "transferOwnership", _newOwner)
 }
 
 // TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
 //
-// Solidity: function transferOwnership(address newOwner) returns()
-func (_WETH *WETHSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
-	return _WETH.Contract.TransferOwnership(&_WETH.TransactOpts, newOwner)
+// Solidity: function transferOwnership(address _newOwner) returns()
+func (_WETH *WETHSession) TransferOwnership(_newOwner common.Address) (*types.Transaction, error) {
+	return _WETH.Contract.TransferOwnership(&_WETH.TransactOpts, _newOwner)
 }
 
 // TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
 //
-// Solidity: function transferOwnership(address newOwner) returns()
-func (_WETH *WETHTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
-	return _WETH.Contract.TransferOwnership(&_WETH.TransactOpts, newOwner)
+// Solidity: function transferOwnership(address _newOwner) returns()
+func (_WETH *WETHTransactorSession) TransferOwnership(_newOwner common.Address) (*types.Transaction, error) {
+	return _WETH.Contract.TransferOwnership(&_WETH.TransactOpts, _newOwner)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_WETH *WETHTransactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_WETH *WETHSession) Unpause() (*types.Transaction, error) {
+	return _WETH.Contract.Unpause(&_WETH.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_WETH *WETHTransactorSession) Unpause() (*types.Transaction, error) {
+	return _WETH.Contract.Unpause(&_WETH.TransactOpts)
 }
 
 // WETHApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the WETH contract.
@@ -727,9 +1395,9 @@ func (_WETH *WETHFilterer) ParseApproval(log types.Log) (*WETHApproval, error) {
 	return event, nil
 }
 
-// WETHOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the WETH contract.
-type WETHOwnershipTransferredIterator struct {
-	Event *WETHOwnershipTransferred // Event containing the contract specifics and raw log
+// WETHBurnIterator is returned from FilterBurn and is used to iterate over the raw logs and unpacked data for Burn events raised by the WETH contract.
+type WETHBurnIterator struct {
+	Event *WETHBurn // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -743,7 +1411,7 @@ type WETHOwnershipTransferredIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *WETHOwnershipTransferredIterator) Next() bool {
+func (it *WETHBurnIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -752,7 +1420,7 @@ func (it *WETHOwnershipTransferredIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(WETHOwnershipTransferred)
+			it.Event = new(WETHBurn)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -767,7 +1435,7 @@ func (it *WETHOwnershipTransferredIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(WETHOwnershipTransferred)
+		it.Event = new(WETHBurn)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -783,60 +1451,61 @@ func (it *WETHOwnershipTransferredIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *WETHOwnershipTransferredIterator) Error() error {
+func (it *WETHBurnIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *WETHOwnershipTransferredIterator) Close() error {
+func (it *WETHBurnIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// WETHOwnershipTransferred represents a OwnershipTransferred event raised by the WETH contract.
-type WETHOwnershipTransferred struct {
-	PreviousOwner common.Address
-	NewOwner      common.Address
-	Raw           types.Log // Blockchain specific contextual infos
+// WETHBurn represents a Burn event raised by the WETH contract.
+type WETHBurn struct {
+	Burner  common.Address
+	Account common.Address
+	Amount  *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
 }
 
-// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// FilterBurn is a free log retrieval operation binding the contract event 0xbac40739b0d4ca32fa2d82fc91630465ba3eddd1598da6fca393b26fb63b9453.
 //
-// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
-func (_WETH *WETHFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*WETHOwnershipTransferredIterator, error) {
+// Solidity: event Burn(address indexed _burner, address indexed _account, uint256 _amount)
+func (_WETH *WETHFilterer) FilterBurn(opts *bind.FilterOpts, _burner []common.Address, _account []common.Address) (*WETHBurnIterator, error) {
 
-	var previousOwnerRule []interface{}
-	for _, previousOwnerItem := range previousOwner {
-		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	var _burnerRule []interface{}
+	for _, _burnerItem := range _burner {
+		_burnerRule = append(_burnerRule, _burnerItem)
 	}
-	var newOwnerRule []interface{}
-	for _, newOwnerItem := range newOwner {
-		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	var _accountRule []interface{}
+	for _, _accountItem := range _account {
+		_accountRule = append(_accountRule, _accountItem)
 	}
 
-	logs, sub, err := _WETH.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	logs, sub, err := _WETH.contract.FilterLogs(opts, "Burn", _burnerRule, _accountRule)
 	if err != nil {
 		return nil, err
 	}
-	return &WETHOwnershipTransferredIterator{contract: _WETH.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+	return &WETHBurnIterator{contract: _WETH.contract, event: "Burn", logs: logs, sub: sub}, nil
 }
 
-// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// WatchBurn is a free log subscription operation binding the contract event 0xbac40739b0d4ca32fa2d82fc91630465ba3eddd1598da6fca393b26fb63b9453.
 //
-// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
-func (_WETH *WETHFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *WETHOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+// Solidity: event Burn(address indexed _burner, address indexed _account, uint256 _amount)
+func (_WETH *WETHFilterer) WatchBurn(opts *bind.WatchOpts, sink chan<- *WETHBurn, _burner []common.Address, _account []common.Address) (event.Subscription, error) {
 
-	var previousOwnerRule []interface{}
-	for _, previousOwnerItem := range previousOwner {
-		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	var _burnerRule []interface{}
+	for _, _burnerItem := range _burner {
+		_burnerRule = append(_burnerRule, _burnerItem)
 	}
-	var newOwnerRule []interface{}
-	for _, newOwnerItem := range newOwner {
-		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	var _accountRule []interface{}
+	for _, _accountItem := range _account {
+		_accountRule = append(_accountRule, _accountItem)
 	}
 
-	logs, sub, err := _WETH.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	logs, sub, err := _WETH.contract.WatchLogs(opts, "Burn", _burnerRule, _accountRule)
 	if err != nil {
 		return nil, err
 	}
@@ -846,8 +1515,8 @@ func (_WETH *WETHFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(WETHOwnershipTransferred)
-				if err := _WETH.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+				event := new(WETHBurn)
+				if err := _WETH.contract.UnpackLog(event, "Burn", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -868,21 +1537,21 @@ func (_WETH *WETHFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink
 	}), nil
 }
 
-// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// ParseBurn is a log parse operation binding the contract event 0xbac40739b0d4ca32fa2d82fc91630465ba3eddd1598da6fca393b26fb63b9453.
 //
-// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
-func (_WETH *WETHFilterer) ParseOwnershipTransferred(log types.Log) (*WETHOwnershipTransferred, error) {
-	event := new(WETHOwnershipTransferred)
-	if err := _WETH.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+// Solidity: event Burn(address indexed _burner, address indexed _account, uint256 _amount)
+func (_WETH *WETHFilterer) ParseBurn(log types.Log) (*WETHBurn, error) {
+	event := new(WETHBurn)
+	if err := _WETH.contract.UnpackLog(event, "Burn", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// WETHTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the WETH contract.
-type WETHTransferIterator struct {
-	Event *WETHTransfer // Event containing the contract specifics and raw log
+// WETHGuardianChangedIterator is returned from FilterGuardianChanged and is used to iterate over the raw logs and unpacked data for GuardianChanged events raised by the WETH contract.
+type WETHGuardianChangedIterator struct {
+	Event *WETHGuardianChanged // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -896,7 +1565,7 @@ type WETHTransferIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *WETHTransferIterator) Next() bool {
+func (it *WETHGuardianChangedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -905,7 +1574,7 @@ func (it *WETHTransferIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(WETHTransfer)
+			it.Event = new(WETHGuardianChanged)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -920,7 +1589,7 @@ func (it *WETHTransferIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(WETHTransfer)
+		it.Event = new(WETHGuardianChanged)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -936,61 +1605,42 @@ func (it *WETHTransferIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *WETHTransferIterator) Error() error {
+func (it *WETHGuardianChangedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *WETHTransferIterator) Close() error {
+func (it *WETHGuardianChangedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// WETHTransfer represents a Transfer event raised by the WETH contract.
-type WETHTransfer struct {
-	From  common.Address
-	To    common.Address
-	Value *big.Int
-	Raw   types.Log // Blockchain specific contextual infos
+// WETHGuardianChanged represents a GuardianChanged event raised by the WETH contract.
+type WETHGuardianChanged struct {
+	Guardian   common.Address
+	IsGuardian bool
+	Raw        types.Log // Blockchain specific contextual infos
 }
 
-// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+// FilterGuardianChanged is a free log retrieval operation binding the contract event 0xafef3d05547c718394a99a79aa641db2143708cd1114b68479af4740f173cc50.
 //
-// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
-func (_WETH *WETHFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*WETHTransferIterator, error) {
-
-	var fromRule []interface{}
-	for _, fromItem := range from {
-		fromRule = append(fromRule, fromItem)
-	}
-	var toRule []interface{}
-	for _, toItem := range to {
-		toRule = append(toRule, toItem)
-	}
+// Solidity: event GuardianChanged(address _guardian, bool _isGuardian)
+func (_WETH *WETHFilterer) FilterGuardianChanged(opts *bind.FilterOpts) (*WETHGuardianChangedIterator, error) {
 
-	logs, sub, err := _WETH.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	logs, sub, err := _WETH.contract.FilterLogs(opts, "GuardianChanged")
 	if err != nil {
 		return nil, err
 	}
-	return &WETHTransferIterator{contract: _WETH.contract, event: "Transfer", logs: logs, sub: sub}, nil
+	return &WETHGuardianChangedIterator{contract: _WETH.contract, event: "GuardianChanged", logs: logs, sub: sub}, nil
 }
 
-// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+// WatchGuardianChanged is a free log subscription operation binding the contract event 0xafef3d05547c718394a99a79aa641db2143708cd1114b68479af4740f173cc50.
 //
-// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
-func (_WETH *WETHFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *WETHTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
-
-	var fromRule []interface{}
-	for _, fromItem := range from {
-		fromRule = append(fromRule, fromItem)
-	}
-	var toRule []interface{}
-	for _, toItem := range to {
-		toRule = append(toRule, toItem)
-	}
+// Solidity: event GuardianChanged(address _guardian, bool _isGuardian)
+func (_WETH *WETHFilterer) WatchGuardianChanged(opts *bind.WatchOpts, sink chan<- *WETHGuardianChanged) (event.Subscription, error) {
 
-	logs, sub, err := _WETH.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	logs, sub, err := _WETH.contract.WatchLogs(opts, "GuardianChanged")
 	if err != nil {
 		return nil, err
 	}
@@ -1000,8 +1650,8 @@ func (_WETH *WETHFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *WETH
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(WETHTransfer)
-				if err := _WETH.contract.UnpackLog(event, "Transfer", log); err != nil {
+				event := new(WETHGuardianChanged)
+				if err := _WETH.contract.UnpackLog(event, "GuardianChanged", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1022,12 +1672,1566 @@ func (_WETH *WETHFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *WETH
 	}), nil
 }
 
-// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+// ParseGuardianChanged is a log parse operation binding the contract event 0xafef3d05547c718394a99a79aa641db2143708cd1114b68479af4740f173cc50.
 //
-// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
-func (_WETH *WETHFilterer) ParseTransfer(log types.Log) (*WETHTransfer, error) {
-	event := new(WETHTransfer)
-	if err := _WETH.contract.UnpackLog(event, "Transfer", log); err != nil {
+// Solidity: event GuardianChanged(address _guardian, bool _isGuardian)
+func (_WETH *WETHFilterer) ParseGuardianChanged(log types.Log) (*WETHGuardianChanged, error) {
+	event := new(WETHGuardianChanged)
+	if err := _WETH.contract.UnpackLog(event, "GuardianChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WETHMintIterator is returned from FilterMint and is used to iterate over the raw logs and unpacked data for Mint events raised by the WETH contract.
+type WETHMintIterator struct {
+	Event *WETHMint // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WETHMintIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WETHMint)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WETHMint)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WETHMintIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WETHMintIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WETHMint represents a Mint event raised by the WETH contract.
+type WETHMint struct {
+	Minter    common.Address
+	Recepient common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterMint is a free log retrieval operation binding the contract event 0xab8530f87dc9b59234c4623bf917212bb2536d647574c8e7e5da92c2ede0c9f8.
+//
+// Solidity: event Mint(address indexed _minter, address indexed _recepient, uint256 _amount)
+func (_WETH *WETHFilterer) FilterMint(opts *bind.FilterOpts, _minter []common.Address, _recepient []common.Address) (*WETHMintIterator, error) {
+
+	var _minterRule []interface{}
+	for _, _minterItem := range _minter {
+		_minterRule = append(_minterRule, _minterItem)
+	}
+	var _recepientRule []interface{}
+	for _, _recepientItem := range _recepient {
+		_recepientRule = append(_recepientRule, _recepientItem)
+	}
+
+	logs, sub, err := _WETH.contract.FilterLogs(opts, "Mint", _minterRule, _recepientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &WETHMintIterator{contract: _WETH.contract, event: "Mint", logs: logs, sub: sub}, nil
+}
+
+// WatchMint is a free log subscription operation binding the contract event 0xab8530f87dc9b59234c4623bf917212bb2536d647574c8e7e5da92c2ede0c9f8.
+//
+// Solidity: event Mint(address indexed _minter, address indexed _recepient, uint256 _amount)
+func (_WETH *WETHFilterer) WatchMint(opts *bind.WatchOpts, sink chan<- *WETHMint, _minter []common.Address, _recepient []common.Address) (event.Subscription, error) {
+
+	var _minterRule []interface{}
+	for _, _minterItem := range _minter {
+		_minterRule = append(_minterRule, _minterItem)
+	}
+	var _recepientRule []interface{}
+	for _, _recepientItem := range _recepient {
+		_recepientRule = append(_recepientRule, _recepientItem)
+	}
+
+	logs, sub, err := _WETH.contract.WatchLogs(opts, "Mint", _minterRule, _recepientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WETHMint)
+				if err := _WETH.contract.UnpackLog(event, "Mint", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMint is a log parse operation binding the contract event 0xab8530f87dc9b59234c4623bf917212bb2536d647574c8e7e5da92c2ede0c9f8.
+//
+// Solidity: event Mint(address indexed _minter, address indexed _recepient, uint256 _amount)
+func (_WETH *WETHFilterer) ParseMint(log types.Log) (*WETHMint, error) {
+	event := new(WETHMint)
+	if err := _WETH.contract.UnpackLog(event, "Mint", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WETHMinterChangedIterator is returned from FilterMinterChanged and is used to iterate over the raw logs and unpacked data for MinterChanged events raised by the WETH contract.
+type WETHMinterChangedIterator struct {
+	Event *WETHMinterChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WETHMinterChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WETHMinterChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WETHMinterChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WETHMinterChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WETHMinterChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WETHMinterChanged represents a MinterChanged event raised by the WETH contract.
+type WETHMinterChanged struct {
+	Minter    common.Address
+	Allowance *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterMinterChanged is a free log retrieval operation binding the contract event 0xd75fd184f209746726e627e2bd2ed917eeb5f5b35120d7e89e4df569e6bb1687.
+//
+// Solidity: event MinterChanged(address _minter, uint256 _allowance)
+func (_WETH *WETHFilterer) FilterMinterChanged(opts *bind.FilterOpts) (*WETHMinterChangedIterator, error) {
+
+	logs, sub, err := _WETH.contract.FilterLogs(opts, "MinterChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &WETHMinterChangedIterator{contract: _WETH.contract, event: "MinterChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchMinterChanged is a free log subscription operation binding the contract event 0xd75fd184f209746726e627e2bd2ed917eeb5f5b35120d7e89e4df569e6bb1687.
+//
+// Solidity: event MinterChanged(address _minter, uint256 _allowance)
+func (_WETH *WETHFilterer) WatchMinterChanged(opts *bind.WatchOpts, sink chan<- *WETHMinterChanged) (event.Subscription, error) {
+
+	logs, sub, err := _WETH.contract.WatchLogs(opts, "MinterChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WETHMinterChanged)
+				if err := _WETH.contract.UnpackLog(event, "MinterChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMinterChanged is a log parse operation binding the contract event 0xd75fd184f209746726e627e2bd2ed917eeb5f5b35120d7e89e4df569e6bb1687.
+//
+// Solidity: event MinterChanged(address _minter, uint256 _allowance)
+func (_WETH *WETHFilterer) ParseMinterChanged(log types.Log) (*WETHMinterChanged, error) {
+	event := new(WETHMinterChanged)
+	if err := _WETH.contract.UnpackLog(event, "MinterChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WETHMinterRemovedIterator is returned from FilterMinterRemoved and is used to iterate over the raw logs and unpacked data for MinterRemoved events raised by the WETH contract.
+type WETHMinterRemovedIterator struct {
+	Event *WETHMinterRemoved // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WETHMinterRemovedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WETHMinterRemoved)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WETHMinterRemoved)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WETHMinterRemovedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WETHMinterRemovedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WETHMinterRemoved represents a MinterRemoved event raised by the WETH contract.
+type WETHMinterRemoved struct {
+	Minter common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterMinterRemoved is a free log retrieval operation binding the contract event 0xe94479a9f7e1952cc78f2d6baab678adc1b772d936c6583def489e524cb66692.
+//
+// Solidity: event MinterRemoved(address _minter)
+func (_WETH *WETHFilterer) FilterMinterRemoved(opts *bind.FilterOpts) (*WETHMinterRemovedIterator, error) {
+
+	logs, sub, err := _WETH.contract.FilterLogs(opts, "MinterRemoved")
+	if err != nil {
+		return nil, err
+	}
+	return &WETHMinterRemovedIterator{contract: _WETH.contract, event: "MinterRemoved", logs: logs, sub: sub}, nil
+}
+
+// WatchMinterRemoved is a free log subscription operation binding the contract event 0xe94479a9f7e1952cc78f2d6baab678adc1b772d936c6583def489e524cb66692.
+//
+// Solidity: event MinterRemoved(address _minter)
+func (_WETH *WETHFilterer) WatchMinterRemoved(opts *bind.WatchOpts, sink chan<- *WETHMinterRemoved) (event.Subscription, error) {
+
+	logs, sub, err := _WETH.contract.WatchLogs(opts, "MinterRemoved")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WETHMinterRemoved)
+				if err := _WETH.contract.UnpackLog(event, "MinterRemoved", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMinterRemoved is a log parse operation binding the contract event 0xe94479a9f7e1952cc78f2d6baab678adc1b772d936c6583def489e524cb66692.
+//
+// Solidity: event MinterRemoved(address _minter)
+func (_WETH *WETHFilterer) ParseMinterRemoved(log types.Log) (*WETHMinterRemoved, error) {
+	event := new(WETHMinterRemoved)
+	if err := _WETH.contract.UnpackLog(event, "MinterRemoved", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WETHOwnershipTransferStartedIterator is returned from FilterOwnershipTransferStarted and is used to iterate over the raw logs and unpacked data for OwnershipTransferStarted events raised by the WETH contract.
+type WETHOwnershipTransferStartedIterator struct {
+	Event *WETHOwnershipTransferStarted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WETHOwnershipTransferStartedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WETHOwnershipTransferStarted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WETHOwnershipTransferStarted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WETHOwnershipTransferStartedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WETHOwnershipTransferStartedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WETHOwnershipTransferStarted represents a OwnershipTransferStarted event raised by the WETH contract.
+type WETHOwnershipTransferStarted struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferStarted is a free log retrieval operation binding the contract event 0x38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e22700.
+//
+// Solidity: event OwnershipTransferStarted(address indexed previousOwner, address indexed newOwner)
+func (_WETH *WETHFilterer) FilterOwnershipTransferStarted(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*WETHOwnershipTransferStartedIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _WETH.contract.FilterLogs(opts, "OwnershipTransferStarted", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &WETHOwnershipTransferStartedIterator{contract: _WETH.contract, event: "OwnershipTransferStarted", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferStarted is a free log subscription operation binding the contract event 0x38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e22700.
+//
+// Solidity: event OwnershipTransferStarted(address indexed previousOwner, address indexed newOwner)
+func (_WETH *WETHFilterer) WatchOwnershipTransferStarted(opts *bind.WatchOpts, sink chan<- *WETHOwnershipTransferStarted, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _WETH.contract.WatchLogs(opts, "OwnershipTransferStarted", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WETHOwnershipTransferStarted)
+				if err := _WETH.contract.UnpackLog(event, "OwnershipTransferStarted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferStarted is a log parse operation binding the contract event 0x38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e22700.
+//
+// Solidity: event OwnershipTransferStarted(address indexed previousOwner, address indexed newOwner)
+func (_WETH *WETHFilterer) ParseOwnershipTransferStarted(log types.Log) (*WETHOwnershipTransferStarted, error) {
+	event := new(WETHOwnershipTransferStarted)
+	if err := _WETH.contract.UnpackLog(event, "OwnershipTransferStarted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WETHOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the WETH contract.
+type WETHOwnershipTransferredIterator struct {
+	Event *WETHOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WETHOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WETHOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WETHOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WETHOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WETHOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WETHOwnershipTransferred represents a OwnershipTransferred event raised by the WETH contract.
+type WETHOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_WETH *WETHFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*WETHOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _WETH.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &WETHOwnershipTransferredIterator{contract: _WETH.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_WETH *WETHFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *WETHOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _WETH.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WETHOwnershipTransferred)
+				if err := _WETH.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_WETH *WETHFilterer) ParseOwnershipTransferred(log types.Log) (*WETHOwnershipTransferred, error) {
+	event := new(WETHOwnershipTransferred)
+	if err := _WETH.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WETHPauseExemptionChangedIterator is returned from FilterPauseExemptionChanged and is used to iterate over the raw logs and unpacked data for PauseExemptionChanged events raised by the WETH contract.
+type WETHPauseExemptionChangedIterator struct {
+	Event *WETHPauseExemptionChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WETHPauseExemptionChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WETHPauseExemptionChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WETHPauseExemptionChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WETHPauseExemptionChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WETHPauseExemptionChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WETHPauseExemptionChanged represents a PauseExemptionChanged event raised by the WETH contract.
+type WETHPauseExemptionChanged struct {
+	Account  common.Address
+	IsExempt bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterPauseExemptionChanged is a free log retrieval operation binding the contract event 0x20e39ebaeba8bdfdbd096d13c9b4e40d4629c6d6bc79cc82ad642fd131ce9436.
+//
+// Solidity: event PauseExemptionChanged(address _account, bool _isExempt)
+func (_WETH *WETHFilterer) FilterPauseExemptionChanged(opts *bind.FilterOpts) (*WETHPauseExemptionChangedIterator, error) {
+
+	logs, sub, err := _WETH.contract.FilterLogs(opts, "PauseExemptionChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &WETHPauseExemptionChangedIterator{contract: _WETH.contract, event: "PauseExemptionChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchPauseExemptionChanged is a free log subscription operation binding the contract event 0x20e39ebaeba8bdfdbd096d13c9b4e40d4629c6d6bc79cc82ad642fd131ce9436.
+//
+// Solidity: event PauseExemptionChanged(address _account, bool _isExempt)
+func (_WETH *WETHFilterer) WatchPauseExemptionChanged(opts *bind.WatchOpts, sink chan<- *WETHPauseExemptionChanged) (event.Subscription, error) {
+
+	logs, sub, err := _WETH.contract.WatchLogs(opts, "PauseExemptionChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WETHPauseExemptionChanged)
+				if err := _WETH.contract.UnpackLog(event, "PauseExemptionChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePauseExemptionChanged is a log parse operation binding the contract event 0x20e39ebaeba8bdfdbd096d13c9b4e40d4629c6d6bc79cc82ad642fd131ce9436.
+//
+// Solidity: event PauseExemptionChanged(address _account, bool _isExempt)
+func (_WETH *WETHFilterer) ParsePauseExemptionChanged(log types.Log) (*WETHPauseExemptionChanged, error) {
+	event := new(WETHPauseExemptionChanged)
+	if err := _WETH.contract.UnpackLog(event, "PauseExemptionChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WETHPausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the WETH contract.
+type WETHPausedIterator struct {
+	Event *WETHPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WETHPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WETHPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WETHPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WETHPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WETHPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WETHPaused represents a Paused event raised by the WETH contract.
+type WETHPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_WETH *WETHFilterer) FilterPaused(opts *bind.FilterOpts) (*WETHPausedIterator, error) {
+
+	logs, sub, err := _WETH.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &WETHPausedIterator{contract: _WETH.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_WETH *WETHFilterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *WETHPaused) (event.Subscription, error) {
+
+	logs, sub, err := _WETH.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WETHPaused)
+				if err := _WETH.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_WETH *WETHFilterer) ParsePaused(log types.Log) (*WETHPaused, error) {
+	event := new(WETHPaused)
+	if err := _WETH.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WETHSupplyCapChangedIterator is returned from FilterSupplyCapChanged and is used to iterate over the raw logs and unpacked data for SupplyCapChanged events raised by the WETH contract.
+type WETHSupplyCapChangedIterator struct {
+	Event *WETHSupplyCapChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WETHSupplyCapChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WETHSupplyCapChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WETHSupplyCapChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WETHSupplyCapChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WETHSupplyCapChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WETHSupplyCapChanged represents a SupplyCapChanged event raised by the WETH contract.
+type WETHSupplyCapChanged struct {
+	SupplyCap *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterSupplyCapChanged is a free log retrieval operation binding the contract event 0xf2fb162690e5fc210d5e0f2d0068f308b2a6e0ffb9af57c73473ef9b3b95f11b.
+//
+// Solidity: event SupplyCapChanged(uint256 _supplyCap)
+func (_WETH *WETHFilterer) FilterSupplyCapChanged(opts *bind.FilterOpts) (*WETHSupplyCapChangedIterator, error) {
+
+	logs, sub, err := _WETH.contract.FilterLogs(opts, "SupplyCapChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &WETHSupplyCapChangedIterator{contract: _WETH.contract, event: "SupplyCapChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchSupplyCapChanged is a free log subscription operation binding the contract event 0xf2fb162690e5fc210d5e0f2d0068f308b2a6e0ffb9af57c73473ef9b3b95f11b.
+//
+// Solidity: event SupplyCapChanged(uint256 _supplyCap)
+func (_WETH *WETHFilterer) WatchSupplyCapChanged(opts *bind.WatchOpts, sink chan<- *WETHSupplyCapChanged) (event.Subscription, error) {
+
+	logs, sub, err := _WETH.contract.WatchLogs(opts, "SupplyCapChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WETHSupplyCapChanged)
+				if err := _WETH.contract.UnpackLog(event, "SupplyCapChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSupplyCapChanged is a log parse operation binding the contract event 0xf2fb162690e5fc210d5e0f2d0068f308b2a6e0ffb9af57c73473ef9b3b95f11b.
+//
+// Solidity: event SupplyCapChanged(uint256 _supplyCap)
+func (_WETH *WETHFilterer) ParseSupplyCapChanged(log types.Log) (*WETHSupplyCapChanged, error) {
+	event := new(WETHSupplyCapChanged)
+	if err := _WETH.contract.UnpackLog(event, "SupplyCapChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WETHTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the WETH contract.
+type WETHTransferIterator struct {
+	Event *WETHTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WETHTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WETHTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WETHTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WETHTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WETHTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WETHTransfer represents a Transfer event raised by the WETH contract.
+type WETHTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_WETH *WETHFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*WETHTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _WETH.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &WETHTransferIterator{contract: _WETH.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_WETH *WETHFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *WETHTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _WETH.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WETHTransfer)
+				if err := _WETH.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_WETH *WETHFilterer) ParseTransfer(log types.Log) (*WETHTransfer, error) {
+	event := new(WETHTransfer)
+	if err := _WETH.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WETHTrustedForwarderChangedIterator is returned from FilterTrustedForwarderChanged and is used to iterate over the raw logs and unpacked data for TrustedForwarderChanged events raised by the WETH contract.
+type WETHTrustedForwarderChangedIterator struct {
+	Event *WETHTrustedForwarderChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WETHTrustedForwarderChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WETHTrustedForwarderChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WETHTrustedForwarderChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WETHTrustedForwarderChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WETHTrustedForwarderChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WETHTrustedForwarderChanged represents a TrustedForwarderChanged event raised by the WETH contract.
+type WETHTrustedForwarderChanged struct {
+	Forwarder common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterTrustedForwarderChanged is a free log retrieval operation binding the contract event 0x871264f4293af7d2865ae7eae628b228f4991c57cb45b39c99f0b774ebe29018.
+//
+// Solidity: event TrustedForwarderChanged(address _forwarder)
+func (_WETH *WETHFilterer) FilterTrustedForwarderChanged(opts *bind.FilterOpts) (*WETHTrustedForwarderChangedIterator, error) {
+
+	logs, sub, err := _WETH.contract.FilterLogs(opts, "TrustedForwarderChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &WETHTrustedForwarderChangedIterator{contract: _WETH.contract, event: "TrustedForwarderChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchTrustedForwarderChanged is a free log subscription operation binding the contract event 0x871264f4293af7d2865ae7eae628b228f4991c57cb45b39c99f0b774ebe29018.
+//
+// Solidity: event TrustedForwarderChanged(address _forwarder)
+func (_WETH *WETHFilterer) WatchTrustedForwarderChanged(opts *bind.WatchOpts, sink chan<- *WETHTrustedForwarderChanged) (event.Subscription, error) {
+
+	logs, sub, err := _WETH.contract.WatchLogs(opts, "TrustedForwarderChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WETHTrustedForwarderChanged)
+				if err := _WETH.contract.UnpackLog(event, "TrustedForwarderChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTrustedForwarderChanged is a log parse operation binding the contract event 0x871264f4293af7d2865ae7eae628b228f4991c57cb45b39c99f0b774ebe29018.
+//
+// Solidity: event TrustedForwarderChanged(address _forwarder)
+func (_WETH *WETHFilterer) ParseTrustedForwarderChanged(log types.Log) (*WETHTrustedForwarderChanged, error) {
+	event := new(WETHTrustedForwarderChanged)
+	if err := _WETH.contract.UnpackLog(event, "TrustedForwarderChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WETHUnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the WETH contract.
+type WETHUnpausedIterator struct {
+	Event *WETHUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WETHUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WETHUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WETHUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WETHUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WETHUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WETHUnpaused represents a Unpaused event raised by the WETH contract.
+type WETHUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_WETH *WETHFilterer) FilterUnpaused(opts *bind.FilterOpts) (*WETHUnpausedIterator, error) {
+
+	logs, sub, err := _WETH.contract.FilterLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return &WETHUnpausedIterator{contract: _WETH.contract, event: "Unpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_WETH *WETHFilterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *WETHUnpaused) (event.Subscription, error) {
+
+	logs, sub, err := _WETH.contract.WatchLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WETHUnpaused)
+				if err := _WETH.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_WETH *WETHFilterer) ParseUnpaused(log types.Log) (*WETHUnpaused, error) {
+	event := new(WETHUnpaused)
+	if err := _WETH.contract.UnpackLog(event, "Unpaused", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
@@ -0,0 +1,273 @@
+// Package importer mints WERC721 tokens from a file in gas-bounded batches,
+// recording its progress so that an interrupted import can be resumed.
+// Batches are sent through a transaction manager, which journals each one
+// before broadcasting it, so a resumed import follows a batch it sent before
+// a crash instead of minting it again.
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/one-click-platform/system-contracts/generated"
+	"github.com/one-click-platform/system-contracts/txmanager"
+)
+
+const (
+	// DefaultBatchSize is the largest number of tokens minted by one transaction.
+	DefaultBatchSize = 100
+	// DefaultGasCeiling is the gas limit a single mintBatch transaction may use.
+	DefaultGasCeiling = 8000000
+	// DefaultWaitTimeout is the time a batch may stay unconfirmed before Run
+	// gives up, leaving it to be followed by the next run.
+	DefaultWaitTimeout = 10 * time.Minute
+)
+
+// Backend is the chain access needed to estimate, send and wait for batches.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Config tunes an import.
+type Config struct {
+	BatchSize    int           // Largest batch, DefaultBatchSize if zero
+	GasCeiling   uint64        // Gas limit per batch, DefaultGasCeiling if zero
+	WaitTimeout  time.Duration // Longest wait for a batch, DefaultWaitTimeout if zero
+	ProgressPath string        // File the progress is persisted to
+}
+
+// Progress is the persisted state of an import.
+type Progress struct {
+	Done         int    `json:"done"`                   // Records minted by confirmed batches
+	PendingKey   string `json:"pendingKey,omitempty"`   // Transaction manager key of the batch being sent
+	PendingCount int    `json:"pendingCount,omitempty"` // Records in the pending batch
+}
+
+// Importer mints records through WERC721.mintBatch.
+type Importer struct {
+	backend Backend
+	address common.Address
+	token   *generated.WERC721Transactor
+	txs     *txmanager.Manager
+	abi     abi.ABI
+	config  Config
+}
+
+// New creates an importer for the WERC721 contract at address, sending the
+// batches through txs.
+func New(backend Backend, address common.Address, txs *txmanager.Manager, config Config) (*Importer, error) {
+	if config.ProgressPath == "" {
+		return nil, errors.New("progress path is required")
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultBatchSize
+	}
+	if config.GasCeiling == 0 {
+		config.GasCeiling = DefaultGasCeiling
+	}
+	if config.WaitTimeout == 0 {
+		config.WaitTimeout = DefaultWaitTimeout
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(generated.WERC721ABI))
+	if err != nil {
+		return nil, err
+	}
+	token, err := generated.NewWERC721Transactor(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Importer{
+		backend: backend,
+		address: address,
+		token:   token,
+		txs:     txs,
+		abi:     parsed,
+		config:  config,
+	}, nil
+}
+
+// Run mints every record not yet covered by the saved progress. The sender
+// of txs must hold MINTER_ROLE. Records must be passed in the same order on
+// every run.
+//
+// A batch is recorded in the progress before it is sent and journaled by
+// txs before it is broadcast, so a run interrupted at any point resumes
+// with the same batch: it is followed if it was journaled and sent
+// otherwise. A batch unconfirmed after the wait timeout is left pending for
+// the next run.
+func (im *Importer) Run(ctx context.Context, records []Record) (Progress, error) {
+	progress, err := im.load()
+	if err != nil {
+		return progress, err
+	}
+
+	for progress.PendingKey != "" || progress.Done < len(records) {
+		if progress.PendingKey == "" {
+			batch, _, err := im.fit(ctx, records[progress.Done:])
+			if err != nil {
+				return progress, err
+			}
+			progress.PendingKey, progress.PendingCount = im.batchKey(progress.Done), len(batch)
+			if err := im.save(progress); err != nil {
+				return progress, err
+			}
+		}
+		if progress.Done+progress.PendingCount > len(records) {
+			return progress, fmt.Errorf("pending batch of %d records at %d exceeds the %d records", progress.PendingCount, progress.Done, len(records))
+		}
+		if err := im.send(ctx, progress, records[progress.Done:progress.Done+progress.PendingCount]); err != nil {
+			return progress, err
+		}
+		if err := im.confirm(ctx, &progress); err != nil {
+			return progress, err
+		}
+	}
+	return progress, nil
+}
+
+// send sends the pending batch, unless it was journaled before.
+func (im *Importer) send(ctx context.Context, progress Progress, batch []Record) error {
+	if _, ok := im.txs.Get(progress.PendingKey); ok {
+		return nil
+	}
+	gas, err := im.estimate(ctx, batch)
+	if err != nil {
+		return err
+	}
+	to, data := split(batch)
+	_, err = im.txs.Send(ctx, progress.PendingKey, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		opts.GasLimit = gas
+		return im.token.MintBatch(opts, to, data)
+	})
+	return err
+}
+
+// fit returns the largest prefix of records, up to the batch size, whose
+// mintBatch estimate stays under the gas ceiling.
+func (im *Importer) fit(ctx context.Context, records []Record) ([]Record, uint64, error) {
+	size := im.config.BatchSize
+	if size > len(records) {
+		size = len(records)
+	}
+
+	for {
+		gas, err := im.estimate(ctx, records[:size])
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to estimate batch of %d: %w", size, err)
+		}
+		if gas <= im.config.GasCeiling {
+			return records[:size], gas, nil
+		}
+		if size == 1 {
+			return nil, 0, fmt.Errorf("minting a single token needs %d gas, above the ceiling of %d", gas, im.config.GasCeiling)
+		}
+		size /= 2
+	}
+}
+
+// estimate returns the gas mintBatch needs for batch.
+func (im *Importer) estimate(ctx context.Context, batch []Record) (uint64, error) {
+	to, data := split(batch)
+	input, err := im.abi.Pack("mintBatch", to, data)
+	if err != nil {
+		return 0, err
+	}
+	return im.backend.EstimateGas(ctx, ethereum.CallMsg{From: im.txs.From(), To: &im.address, Data: input})
+}
+
+// confirm waits for the pending batch and advances the progress if it
+// succeeded. The wait is bounded by the wait timeout, after which the batch
+// stays pending. A batch that reverted, was rejected or whose nonce was
+// taken by another transaction is forgotten by the transaction manager, so
+// that the next run sends it again, and reported as an error.
+func (im *Importer) confirm(ctx context.Context, progress *Progress) error {
+	waitCtx, cancel := context.WithTimeout(ctx, im.config.WaitTimeout)
+	defer cancel()
+
+	receipt, err := im.txs.Wait(waitCtx, progress.PendingKey)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil:
+		return fmt.Errorf("batch of %d records at %d is not confirmed after %s, it is followed by the next run", progress.PendingCount, progress.Done, im.config.WaitTimeout)
+	case errors.Is(err, txmanager.ErrReplaced), errors.Is(err, txmanager.ErrRejected):
+		if err := im.txs.Forget(progress.PendingKey); err != nil {
+			return err
+		}
+		return fmt.Errorf("batch of records at %d was not mined: %w", progress.Done, err)
+	case err != nil:
+		return err
+	case receipt.Status != types.ReceiptStatusSuccessful:
+		if err := im.txs.Forget(progress.PendingKey); err != nil {
+			return err
+		}
+		return fmt.Errorf("batch of records at %d reverted in %s", progress.Done, receipt.TxHash.Hex())
+	}
+
+	// The progress is saved first: a journaled batch left behind by a crash
+	// is keyed by the records it minted, which are not sent again.
+	key := progress.PendingKey
+	progress.Done += progress.PendingCount
+	progress.PendingKey, progress.PendingCount = "", 0
+	if err := im.save(*progress); err != nil {
+		return err
+	}
+	return im.txs.Forget(key)
+}
+
+// batchKey is the transaction manager key of the batch starting at the
+// record with index done.
+func (im *Importer) batchKey(done int) string {
+	return "import:" + im.address.Hex() + ":" + strconv.Itoa(done)
+}
+
+func (im *Importer) load() (Progress, error) {
+	var progress Progress
+
+	data, err := ioutil.ReadFile(im.config.ProgressPath)
+	if os.IsNotExist(err) {
+		return progress, nil
+	}
+	if err != nil {
+		return progress, err
+	}
+	if err := json.Unmarshal(data, &progress); err != nil {
+		return progress, fmt.Errorf("failed to decode progress: %w", err)
+	}
+	return progress, nil
+}
+
+func (im *Importer) save(progress Progress) error {
+	data, err := json.MarshalIndent(progress, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := im.config.ProgressPath + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, im.config.ProgressPath)
+}
+
+func split(records []Record) ([]common.Address, []string) {
+	to := make([]common.Address, len(records))
+	data := make([]string, len(records))
+	for i, record := range records {
+		to[i], data[i] = record.To, record.Data
+	}
+	return to, data
+}
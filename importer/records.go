@@ -0,0 +1,76 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Record is a single token to mint.
+type Record struct {
+	To   common.Address `json:"to"`
+	Data string         `json:"data"`
+}
+
+// ReadFile reads records from a .csv or .json file.
+func ReadFile(path string) ([]Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return ReadCSV(f)
+	case ".json":
+		return ReadJSON(f)
+	default:
+		return nil, fmt.Errorf("unsupported file type %q", filepath.Ext(path))
+	}
+}
+
+// ReadCSV reads records from CSV rows of the form "to,data". A header row
+// starting with "to" is skipped.
+func ReadCSV(r io.Reader) ([]Record, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) != 0 && strings.EqualFold(strings.TrimSpace(rows[0][0]), "to") {
+		rows = rows[1:]
+	}
+
+	records := make([]Record, len(rows))
+	for i, row := range rows {
+		to := strings.TrimSpace(row[0])
+		if !common.IsHexAddress(to) {
+			return nil, fmt.Errorf("row %d: invalid recipient %q", i+1, to)
+		}
+		records[i] = Record{To: common.HexToAddress(to), Data: row[1]}
+	}
+	return records, nil
+}
+
+// ReadJSON reads records from a JSON array of {"to": ..., "data": ...} objects.
+func ReadJSON(r io.Reader) ([]Record, error) {
+	var records []Record
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return nil, err
+	}
+	for i, record := range records {
+		if record.To == (common.Address{}) {
+			return nil, fmt.Errorf("record %d: missing recipient", i)
+		}
+	}
+	return records, nil
+}
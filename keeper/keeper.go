@@ -0,0 +1,304 @@
+// Package keeper settles finished auctions with Auction.settle, so that lots
+// and repayments do not stay escrowed forever.
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/one-click-platform/system-contracts/generated"
+	"github.com/one-click-platform/system-contracts/reader"
+	"github.com/one-click-platform/system-contracts/txmanager"
+)
+
+const (
+	// DefaultInterval is the time between two scans of the auctions.
+	DefaultInterval = time.Minute
+	// DefaultGasLimit is the gas limit of a settlement transaction.
+	DefaultGasLimit = 300000
+	// DefaultRetryBackoff is the wait before settling an auction again
+	// after a failed settlement, doubled with every further failure.
+	DefaultRetryBackoff = 5 * time.Minute
+	// DefaultMaxAttempts is the number of failed settlements after which an
+	// auction is given up.
+	DefaultMaxAttempts = 5
+
+	// settleKeyPrefix prefixes the transaction manager keys of settlements.
+	settleKeyPrefix = "settle:"
+)
+
+// Backend is the chain access needed to send and follow settlements.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Config tunes the keeper.
+type Config struct {
+	Interval     time.Duration // Scan interval, DefaultInterval if zero
+	GasLimit     uint64        // Gas limit per transaction, DefaultGasLimit if zero
+	MaxGasPrice  *big.Int      // Settlements are postponed while the gas price is higher, unbounded if nil
+	DryRun       bool          // Only log the settlements that would be sent
+	RetryBackoff time.Duration // Wait after a first failed settlement, DefaultRetryBackoff if zero
+	MaxAttempts  int           // Failed settlements before an auction is given up, DefaultMaxAttempts if zero
+}
+
+// Keeper periodically scans the auctions and settles the finished ones.
+type Keeper struct {
+	backend Backend
+	auction *generated.AuctionTransactor
+	reader  *reader.AuctionReader
+	txs     *txmanager.Manager
+	config  Config
+
+	// failures tracks the auctions whose settlement failed, by ID. They are
+	// kept in memory only, so a restart retries given up auctions.
+	failures map[string]*failure
+}
+
+// failure is the retry state of an auction whose settlement failed.
+type failure struct {
+	attempts int
+	retryAt  time.Time
+}
+
+// New creates a keeper for the Auction contract at address, sending
+// transactions through txs. Settlements journaled by txs before a restart
+// are followed up instead of being sent again.
+func New(backend Backend, r *reader.AuctionReader, address common.Address, txs *txmanager.Manager, config Config) (*Keeper, error) {
+	if config.Interval == 0 {
+		config.Interval = DefaultInterval
+	}
+	if config.GasLimit == 0 {
+		config.GasLimit = DefaultGasLimit
+	}
+	if config.RetryBackoff == 0 {
+		config.RetryBackoff = DefaultRetryBackoff
+	}
+	if config.MaxAttempts == 0 {
+		config.MaxAttempts = DefaultMaxAttempts
+	}
+
+	auction, err := generated.NewAuctionTransactor(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Keeper{
+		backend:  backend,
+		auction:  auction,
+		reader:   r,
+		txs:      txs,
+		config:   config,
+		failures: make(map[string]*failure),
+	}, nil
+}
+
+// Run scans the auctions every interval until ctx is cancelled. Errors of a
+// single scan are logged and do not stop the keeper.
+func (k *Keeper) Run(ctx context.Context) error {
+	ticker := time.NewTicker(k.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := k.Tick(ctx); err != nil {
+			errorsCounter.Add(1)
+			log.Error("Auction keeper scan failed", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick performs a single scan, sending the settlements that are due.
+func (k *Keeper) Tick(ctx context.Context) error {
+	ticksCounter.Add(1)
+
+	if err := k.checkPending(ctx); err != nil {
+		return err
+	}
+
+	finished, err := Finished(k.reader, &bind.CallOpts{Context: ctx})
+	if err != nil {
+		return err
+	}
+	finishedGauge.Set(int64(len(finished)))
+	k.pruneFailures(finished)
+
+	var due []*big.Int
+	now := time.Now()
+	for _, id := range finished {
+		if _, ok := k.txs.Get(settleKey(id)); ok {
+			continue
+		}
+		if f, ok := k.failures[id.String()]; ok && (f.attempts >= k.config.MaxAttempts || now.Before(f.retryAt)) {
+			continue
+		}
+		due = append(due, id)
+	}
+	if len(due) == 0 {
+		return nil
+	}
+	if k.config.DryRun {
+		for _, id := range due {
+			dryRunCounter.Add(1)
+			log.Info("Would settle auction", "auction", id)
+		}
+		return nil
+	}
+
+	gasPrice, err := k.backend.SuggestGasPrice(ctx)
+	if err != nil {
+		return err
+	}
+	if k.config.MaxGasPrice != nil && gasPrice.Cmp(k.config.MaxGasPrice) > 0 {
+		gasPriceSkipsCounter.Add(1)
+		log.Warn("Gas price above ceiling, postponing settlements", "price", gasPrice, "ceiling", k.config.MaxGasPrice, "due", len(due))
+		return nil
+	}
+
+	sent, err := SettleAll(ctx, k.txs, k.auction, due, k.config.GasLimit)
+	for i, tx := range sent {
+		sentCounter.Add(1)
+		log.Info("Sent auction settlement", "auction", due[i], "tx", tx.Hashes[0])
+	}
+	return err
+}
+
+// Finished returns the IDs of the auctions that are finished and therefore
+// wait for settle. Auctions that could not be read are skipped.
+func Finished(r *reader.AuctionReader, opts *bind.CallOpts) ([]*big.Int, error) {
+	auctions, err := r.GetAll(opts)
+	var partial *reader.PartialError
+	if err != nil && !errors.As(err, &partial) {
+		return nil, err
+	}
+
+	var finished []*big.Int
+	for _, a := range auctions {
+		if a.Err == nil && a.Status == reader.StatusFinished {
+			finished = append(finished, a.ID)
+		}
+	}
+	return finished, nil
+}
+
+// SettleAll sends a settle transaction for each of the given auctions
+// through txs, under the key settle:<id>. A settlement already journaled by
+// txs is returned instead of being sent again. The gas limit is estimated if
+// gasLimit is zero. On error, the transactions sent before the failing one
+// are returned with it.
+func SettleAll(ctx context.Context, txs *txmanager.Manager, auction *generated.AuctionTransactor, auctionIds []*big.Int, gasLimit uint64) ([]*txmanager.Tx, error) {
+	sent := make([]*txmanager.Tx, 0, len(auctionIds))
+	for _, id := range auctionIds {
+		id := id
+		tx, err := txs.Send(ctx, settleKey(id), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			opts.GasLimit = gasLimit
+			return auction.Settle(opts, id)
+		})
+		if err != nil {
+			return sent, fmt.Errorf("failed to settle auction %s: %w", id, err)
+		}
+		sent = append(sent, tx)
+	}
+	return sent, nil
+}
+
+// checkPending forgets settlements that were mined. Failed ones are retried
+// with an exponential backoff while the auction is still finished, until the
+// auction is given up after the maximum number of attempts.
+func (k *Keeper) checkPending(ctx context.Context) error {
+	for _, tx := range k.txs.Pending() {
+		if !strings.HasPrefix(tx.Key, settleKeyPrefix) {
+			continue
+		}
+		id := strings.TrimPrefix(tx.Key, settleKeyPrefix)
+		receipt, err := k.txs.Check(ctx, tx.Key)
+		switch {
+		case errors.Is(err, txmanager.ErrReplaced):
+			log.Warn("Auction settlement replaced by another transaction", "auction", id, "nonce", tx.Nonce)
+		case errors.Is(err, txmanager.ErrRejected):
+			log.Warn("Auction settlement rejected by the node", "auction", id, "nonce", tx.Nonce, "err", err)
+			k.recordFailure(id)
+			continue
+		case err != nil:
+			return err
+		case receipt == nil:
+			continue
+		case receipt.Status != types.ReceiptStatusSuccessful:
+			failedCounter.Add(1)
+			log.Warn("Auction settlement failed", "auction", id, "tx", receipt.TxHash)
+			k.recordFailure(id)
+		}
+		if err := k.txs.Forget(tx.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordFailure counts a failed settlement of the auction id and schedules
+// its retry, or gives it up after the maximum number of attempts.
+func (k *Keeper) recordFailure(id string) {
+	f, ok := k.failures[id]
+	if !ok {
+		f = &failure{}
+		k.failures[id] = f
+	}
+	f.attempts++
+	failedSettlements.Add(id, 1)
+
+	if f.attempts >= k.config.MaxAttempts {
+		log.Error("Giving up settling auction", "auction", id, "attempts", f.attempts)
+	} else {
+		backoff := k.config.RetryBackoff << (f.attempts - 1)
+		f.retryAt = time.Now().Add(backoff)
+		log.Info("Postponing auction settlement", "auction", id, "attempts", f.attempts, "retryIn", backoff)
+	}
+	k.updateFailureGauges()
+}
+
+// pruneFailures forgets the failures of auctions that are no longer
+// finished, e.g. because someone else settled them.
+func (k *Keeper) pruneFailures(finished []*big.Int) {
+	still := make(map[string]bool, len(finished))
+	for _, id := range finished {
+		still[id.String()] = true
+	}
+	for id := range k.failures {
+		if !still[id] {
+			delete(k.failures, id)
+			failedSettlements.Delete(id)
+		}
+	}
+	k.updateFailureGauges()
+}
+
+func (k *Keeper) updateFailureGauges() {
+	var failing, abandoned int64
+	for _, f := range k.failures {
+		if f.attempts >= k.config.MaxAttempts {
+			abandoned++
+		} else {
+			failing++
+		}
+	}
+	failingGauge.Set(failing)
+	abandonedGauge.Set(abandoned)
+}
+
+func settleKey(id *big.Int) string {
+	return settleKeyPrefix + id.String()
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import "expvar"
+
+// Metrics are published through expvar under /debug/vars.
+var (
+	ticksCounter         = expvar.NewInt("keeper_ticks")
+	errorsCounter        = expvar.NewInt("keeper_errors")
+	finishedGauge        = expvar.NewInt("keeper_finished_auctions")
+	sentCounter          = expvar.NewInt("keeper_txs_sent")
+	failedCounter        = expvar.NewInt("keeper_txs_failed")
+	dryRunCounter        = expvar.NewInt("keeper_txs_dry_run")
+	gasPriceSkipsCounter = expvar.NewInt("keeper_gas_price_skips")
+	failingGauge         = expvar.NewInt("keeper_failing_auctions")
+	abandonedGauge       = expvar.NewInt("keeper_abandoned_auctions")
+	// failedSettlements maps the ID of every auction whose settlement
+	// failed to the number of failed attempts.
+	failedSettlements = expvar.NewMap("keeper_failed_settlements")
+)
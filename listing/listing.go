@@ -0,0 +1,98 @@
+// Package listing builds, signs and verifies off-chain EIP-712 listings that
+// are settled on-chain by the Listings contract, and keeps a local store of
+// the listings that can still be filled.
+package listing
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/one-click-platform/system-contracts/eip712"
+	"github.com/one-click-platform/system-contracts/generated"
+)
+
+// ListingType is the EIP-712 encoded type of Listings.Listing.
+const ListingType = "Listing(address seller,address tokenAddress,uint256 tokenId,address currencyAddress,uint256 price,uint256 expiry,uint256 nonce)"
+
+var (
+	ErrExpired          = errors.New("listing has expired")
+	ErrInvalidSignature = errors.New("listing is not signed by its seller")
+	ErrNonceUsed        = errors.New("listing nonce is filled or cancelled")
+)
+
+// Signed is a listing together with the seller's signature.
+type Signed struct {
+	Listing   generated.ListingsListing
+	Signature hexutil.Bytes
+}
+
+// Key identifies a listing by its seller and nonce.
+type Key struct {
+	Seller common.Address
+	Nonce  string
+}
+
+// Key returns the identity of the listing.
+func (s *Signed) Key() Key {
+	return Key{Seller: s.Listing.Seller, Nonce: s.Listing.Nonce.String()}
+}
+
+// Domain returns the EIP-712 domain of the Listings contract deployed at address.
+func Domain(chainID *big.Int, address common.Address) eip712.Domain {
+	return eip712.Domain{
+		Name:              "Listings",
+		Version:           "1",
+		ChainID:           chainID,
+		VerifyingContract: address,
+	}
+}
+
+// Hash returns the EIP-712 struct hash of the listing.
+func Hash(l generated.ListingsListing) common.Hash {
+	return eip712.HashStruct(
+		eip712.TypeHash(ListingType),
+		l.Seller,
+		l.TokenAddress,
+		l.TokenId,
+		l.CurrencyAddress,
+		l.Price,
+		l.Expiry,
+		l.Nonce,
+	)
+}
+
+// Sign signs the listing with the seller's key. The seller of the listing
+// is set to the key's address.
+func Sign(key *ecdsa.PrivateKey, domain eip712.Domain, l generated.ListingsListing) (*Signed, error) {
+	sig, err := eip712.Sign(key, eip712.Digest(domain.Separator(), Hash(l)))
+	if err != nil {
+		return nil, err
+	}
+	return &Signed{Listing: l, Signature: sig.Bytes()}, nil
+}
+
+// Verify checks that the listing is signed by its seller and not expired at now.
+func Verify(domain eip712.Domain, s *Signed, now time.Time) error {
+	if s.Listing.Expiry.Cmp(big.NewInt(now.Unix())) < 0 {
+		return ErrExpired
+	}
+
+	sig, err := eip712.ParseSignature(s.Signature)
+	if err != nil {
+		return err
+	}
+	signer, err := eip712.Recover(eip712.Digest(domain.Separator(), Hash(s.Listing)), sig)
+	if err != nil {
+		return fmt.Errorf("failed to recover listing signer: %w", err)
+	}
+	if signer != s.Listing.Seller {
+		return ErrInvalidSignature
+	}
+	return nil
+}
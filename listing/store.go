@@ -0,0 +1,219 @@
+package listing
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/one-click-platform/system-contracts/eip712"
+)
+
+// Store keeps the signed listings that can still be filled, optionally
+// persisted to a JSON file. It also keeps the nonces of filled and
+// cancelled listings and the minimum nonce of every seller, so that such
+// listings are not accepted again.
+type Store struct {
+	domain eip712.Domain
+	path   string
+
+	mu         sync.RWMutex
+	listings   map[Key]*Signed
+	minNonces  map[common.Address]*big.Int
+	usedNonces map[Key]bool
+}
+
+// storeState is the persisted form of a store. Earlier versions persisted
+// the listings alone, as a JSON array.
+type storeState struct {
+	Listings   []*Signed
+	MinNonces  map[common.Address]*big.Int
+	UsedNonces []Key
+}
+
+// NewStore creates a store for listings of the given domain. If path is not
+// empty, the store is loaded from and saved to that file.
+func NewStore(domain eip712.Domain, path string) (*Store, error) {
+	s := &Store{
+		domain:     domain,
+		path:       path,
+		listings:   make(map[Key]*Signed),
+		minNonces:  make(map[common.Address]*big.Int),
+		usedNonces: make(map[Key]bool),
+	}
+	if path == "" {
+		return s, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var state storeState
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		err = json.Unmarshal(data, &state.Listings)
+	} else {
+		err = json.Unmarshal(data, &state)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range state.Listings {
+		s.listings[l.Key()] = l
+	}
+	for seller, min := range state.MinNonces {
+		s.minNonces[seller] = min
+	}
+	for _, key := range state.UsedNonces {
+		s.usedNonces[key] = true
+	}
+	return s, nil
+}
+
+// Put verifies and stores a listing, replacing any listing with the same
+// seller and nonce. Listings with a filled or cancelled nonce, or a nonce
+// below the minimum of the seller, are rejected with ErrNonceUsed.
+func (s *Store) Put(l *Signed) error {
+	if err := Verify(s.domain, l, time.Now()); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if min, ok := s.minNonces[l.Listing.Seller]; ok && l.Listing.Nonce.Cmp(min) < 0 {
+		return ErrNonceUsed
+	}
+	if s.usedNonces[l.Key()] {
+		return ErrNonceUsed
+	}
+	s.listings[l.Key()] = l
+	return s.save()
+}
+
+// Get returns the listing of seller with the given nonce.
+func (s *Store) Get(seller common.Address, nonce *big.Int) (*Signed, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	l, ok := s.listings[Key{Seller: seller, Nonce: nonce.String()}]
+	return l, ok
+}
+
+// ByToken returns the listings of a token ordered by ascending price.
+func (s *Store) ByToken(tokenAddress common.Address, tokenId *big.Int) []*Signed {
+	return s.filter(func(l *Signed) bool {
+		return l.Listing.TokenAddress == tokenAddress && l.Listing.TokenId.Cmp(tokenId) == 0
+	})
+}
+
+// BySeller returns the listings of a seller ordered by ascending price.
+func (s *Store) BySeller(seller common.Address) []*Signed {
+	return s.filter(func(l *Signed) bool {
+		return l.Listing.Seller == seller
+	})
+}
+
+// Remove drops the listing of seller with the given nonce, after it was
+// filled or cancelled, and rejects the nonce from now on.
+func (s *Store) Remove(seller common.Address, nonce *big.Int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := Key{Seller: seller, Nonce: nonce.String()}
+	delete(s.listings, key)
+	if min, ok := s.minNonces[seller]; !ok || nonce.Cmp(min) >= 0 {
+		s.usedNonces[key] = true
+	}
+	return s.save()
+}
+
+// SetMinNonce drops every listing of seller with a nonce below minNonce and
+// rejects such listings from now on. The used nonces below it are covered
+// by the minimum and no longer kept.
+func (s *Store) SetMinNonce(seller common.Address, minNonce *big.Int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.minNonces[seller] = minNonce
+	for key, l := range s.listings {
+		if l.Listing.Seller == seller && l.Listing.Nonce.Cmp(minNonce) < 0 {
+			delete(s.listings, key)
+		}
+	}
+	for key := range s.usedNonces {
+		if nonce, ok := new(big.Int).SetString(key.Nonce, 10); ok && key.Seller == seller && nonce.Cmp(minNonce) < 0 {
+			delete(s.usedNonces, key)
+		}
+	}
+	return s.save()
+}
+
+// Prune drops listings that expired before now.
+func (s *Store) Prune(now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deadline := big.NewInt(now.Unix())
+	for key, l := range s.listings {
+		if l.Listing.Expiry.Cmp(deadline) < 0 {
+			delete(s.listings, key)
+		}
+	}
+	return s.save()
+}
+
+func (s *Store) filter(match func(*Signed) bool) []*Signed {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var result []*Signed
+	for _, l := range s.listings {
+		if match(l) {
+			result = append(result, l)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Listing.Price.Cmp(result[j].Listing.Price) < 0
+	})
+	return result
+}
+
+// save persists the listings, minimum nonces and used nonces; the caller
+// must hold the write lock.
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	state := storeState{
+		Listings:   make([]*Signed, 0, len(s.listings)),
+		MinNonces:  s.minNonces,
+		UsedNonces: make([]Key, 0, len(s.usedNonces)),
+	}
+	for _, l := range s.listings {
+		state.Listings = append(state.Listings, l)
+	}
+	for key := range s.usedNonces {
+		state.UsedNonces = append(state.UsedNonces, key)
+	}
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,71 @@
+package listing
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/one-click-platform/system-contracts/generated"
+)
+
+// Tracker removes filled and cancelled listings from a store by following
+// the events of the Listings contract.
+type Tracker struct {
+	filterer *generated.ListingsFilterer
+	store    *Store
+}
+
+// NewTracker creates a tracker updating store from the Listings events.
+func NewTracker(filterer *generated.ListingsFilterer, store *Store) *Tracker {
+	return &Tracker{
+		filterer: filterer,
+		store:    store,
+	}
+}
+
+// Sync applies the ListingFilled, ListingCancelled and MinNonceUpdated
+// events in the range of opts to the store.
+func (t *Tracker) Sync(opts *bind.FilterOpts) error {
+	filled, err := t.filterer.FilterListingFilled(opts)
+	if err != nil {
+		return err
+	}
+	defer filled.Close()
+	for filled.Next() {
+		if err := t.store.Remove(filled.Event.Seller, filled.Event.Nonce); err != nil {
+			return err
+		}
+	}
+	if err := filled.Error(); err != nil {
+		return err
+	}
+
+	cancelled, err := t.filterer.FilterListingCancelled(opts)
+	if err != nil {
+		return err
+	}
+	defer cancelled.Close()
+	for cancelled.Next() {
+		if err := t.store.Remove(cancelled.Event.Seller, cancelled.Event.Nonce); err != nil {
+			return err
+		}
+	}
+	if err := cancelled.Error(); err != nil {
+		return err
+	}
+
+	updated, err := t.filterer.FilterMinNonceUpdated(opts)
+	if err != nil {
+		return err
+	}
+	defer updated.Close()
+	for updated.Next() {
+		if err := t.store.SetMinNonce(updated.Event.Seller, updated.Event.MinNonce); err != nil {
+			return err
+		}
+	}
+	return updated.Error()
+}
+
+// IsFillable reports whether the listing's nonce is still valid on-chain.
+func IsFillable(caller *generated.ListingsCaller, opts *bind.CallOpts, l *Signed) (bool, error) {
+	return caller.IsValidNonce(opts, l.Listing.Seller, l.Listing.Nonce)
+}
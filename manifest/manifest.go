@@ -0,0 +1,100 @@
+// Package manifest reads deployment manifests, which list the system
+// contracts of a deployment for the operator commands.
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Contract types of a manifest.
+const (
+	TypeAuction = "Auction"
+	TypeWETH    = "WETH"
+	TypeWERC721 = "WERC721"
+)
+
+// Manifest lists the contracts of a deployment, e.g.
+//
+//	{
+//	  "name": "goerli",
+//	  "chainId": 5,
+//	  "contracts": [
+//	    {"name": "auction", "type": "Auction", "address": "0x..."},
+//	    {"name": "weth", "type": "WETH", "address": "0x..."}
+//	  ]
+//	}
+type Manifest struct {
+	Name      string  `json:"name"`
+	ChainID   uint64  `json:"chainId"`
+	Contracts []Entry `json:"contracts"`
+}
+
+// Entry is a contract of a manifest.
+type Entry struct {
+	Name    string         `json:"name"`
+	Type    string         `json:"type"` // TypeAuction, TypeWETH or TypeWERC721
+	Address common.Address `json:"address"`
+}
+
+// Load reads and validates a manifest.
+func Load(path string) (*Manifest, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	m := new(Manifest)
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+
+	if m.ChainID == 0 {
+		return nil, fmt.Errorf("manifest %s has no chain id", path)
+	}
+	names := make(map[string]bool, len(m.Contracts))
+	for _, c := range m.Contracts {
+		switch c.Type {
+		case TypeAuction, TypeWETH, TypeWERC721:
+		default:
+			return nil, fmt.Errorf("contract %s has unknown type %q", c.Name, c.Type)
+		}
+		if names[c.Name] {
+			return nil, fmt.Errorf("contract %s is listed twice", c.Name)
+		}
+		if c.Address == (common.Address{}) {
+			return nil, fmt.Errorf("contract %s has no address", c.Name)
+		}
+		names[c.Name] = true
+	}
+	return m, nil
+}
+
+// Entry returns the contract with the given name.
+func (m *Manifest) Entry(name string) (Entry, error) {
+	for _, c := range m.Contracts {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return Entry{}, fmt.Errorf("no contract %s in manifest %s", name, m.Name)
+}
+
+// Select returns the contracts with the given names, or every contract of
+// the manifest if names is empty.
+func (m *Manifest) Select(names []string) ([]Entry, error) {
+	if len(names) == 0 {
+		return m.Contracts, nil
+	}
+	entries := make([]Entry, 0, len(names))
+	for _, name := range names {
+		entry, err := m.Entry(name)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,116 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/one-click-platform/system-contracts/generated"
+)
+
+// DefaultIPFSGateway is used to resolve ipfs:// URIs when none is configured.
+const DefaultIPFSGateway = "https://ipfs.io/ipfs/"
+
+// maxDocumentSize bounds metadata documents fetched from remote URIs.
+const maxDocumentSize = 1 << 20
+
+// Gateway resolves WERC721 token metadata and serves it over HTTP at
+// /<tokenId>, with ipfs:// URIs rewritten to an HTTP gateway.
+type Gateway struct {
+	token       *generated.WERC721Caller
+	client      *http.Client
+	ipfsGateway string
+}
+
+// NewGateway creates a gateway for the given WERC721 contract. An empty
+// ipfsGateway falls back to DefaultIPFSGateway.
+func NewGateway(token *generated.WERC721Caller, client *http.Client, ipfsGateway string) *Gateway {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if ipfsGateway == "" {
+		ipfsGateway = DefaultIPFSGateway
+	}
+	return &Gateway{
+		token:       token,
+		client:      client,
+		ipfsGateway: ipfsGateway,
+	}
+}
+
+// Metadata resolves tokenURI of the token and returns its validated metadata.
+func (g *Gateway) Metadata(ctx context.Context, tokenId *big.Int) (*Metadata, error) {
+	uri, err := g.token.TokenURI(&bind.CallOpts{Context: ctx}, tokenId)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(uri, "data:") {
+		return ParseDataURI(uri)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.Resolve(uri), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch metadata from %s: %s", uri, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxDocumentSize))
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// Resolve rewrites an ipfs:// URI to the configured HTTP gateway. Other URIs
+// are returned unchanged.
+func (g *Gateway) Resolve(uri string) string {
+	if strings.HasPrefix(uri, "ipfs://") {
+		return strings.TrimSuffix(g.ipfsGateway, "/") + "/" + strings.TrimPrefix(strings.TrimPrefix(uri, "ipfs://"), "ipfs/")
+	}
+	return uri
+}
+
+// Render returns a copy of m with every URI resolved for HTTP clients.
+func (g *Gateway) Render(m *Metadata) *Metadata {
+	rendered := *m
+	rendered.Image = g.Resolve(m.Image)
+	rendered.ExternalURL = g.Resolve(m.ExternalURL)
+	rendered.AnimationURL = g.Resolve(m.AnimationURL)
+	return &rendered
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	tokenId, ok := new(big.Int).SetString(strings.Trim(r.URL.Path, "/"), 10)
+	if !ok || tokenId.Sign() <= 0 {
+		http.Error(w, "invalid token id", http.StatusBadRequest)
+		return
+	}
+
+	m, err := g.Metadata(r.Context(), tokenId)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(g.Render(m))
+}
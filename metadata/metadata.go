@@ -0,0 +1,164 @@
+// Package metadata parses, validates and renders ERC-721 token metadata of
+// WERC721 tokens.
+package metadata
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Metadata is the ERC-721 metadata JSON schema with the commonly used
+// marketplace extensions.
+type Metadata struct {
+	Name            string      `json:"name"`
+	Description     string      `json:"description,omitempty"`
+	Image           string      `json:"image,omitempty"`
+	ExternalURL     string      `json:"external_url,omitempty"`
+	AnimationURL    string      `json:"animation_url,omitempty"`
+	BackgroundColor string      `json:"background_color,omitempty"`
+	Attributes      []Attribute `json:"attributes,omitempty"`
+}
+
+// Attribute is a single trait of a token.
+type Attribute struct {
+	TraitType   string      `json:"trait_type,omitempty"`
+	DisplayType string      `json:"display_type,omitempty"`
+	Value       interface{} `json:"value"`
+}
+
+// ValidationError lists every problem found in a metadata document.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid metadata: " + strings.Join(e.Problems, "; ")
+}
+
+var (
+	ErrNotDataURI = errors.New("not a JSON data URI")
+
+	colorPattern = regexp.MustCompile(`^[0-9a-fA-F]{6}$`)
+
+	uriSchemes = map[string]bool{
+		"http":  true,
+		"https": true,
+		"ipfs":  true,
+		"ar":    true,
+		"data":  true,
+	}
+
+	numericDisplayTypes = map[string]bool{
+		"number":           true,
+		"boost_number":     true,
+		"boost_percentage": true,
+		"date":             true,
+	}
+)
+
+// Parse decodes and validates a metadata JSON document.
+func Parse(data []byte) (*Metadata, error) {
+	var m Metadata
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to decode metadata: %w", err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// ParseDataURI parses metadata embedded in a data URI, as returned by
+// WERC721.tokenURI for tokens without a base or per-token URI.
+func ParseDataURI(uri string) (*Metadata, error) {
+	if !strings.HasPrefix(uri, "data:") {
+		return nil, ErrNotDataURI
+	}
+	comma := strings.IndexByte(uri, ',')
+	if comma == -1 {
+		return nil, ErrNotDataURI
+	}
+	header, payload := uri[len("data:"):comma], uri[comma+1:]
+
+	params := strings.Split(header, ";")
+	if params[0] != "application/json" {
+		return nil, ErrNotDataURI
+	}
+
+	var data []byte
+	if params[len(params)-1] == "base64" {
+		decoded, err := base64.StdEncoding.DecodeString(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode data URI: %w", err)
+		}
+		data = decoded
+	} else {
+		// tokenURI embeds tokensData verbatim, so only unescape payloads
+		// that are not valid JSON as they are.
+		data = []byte(payload)
+		if !json.Valid(data) {
+			if unescaped, err := url.PathUnescape(payload); err == nil {
+				data = []byte(unescaped)
+			}
+		}
+	}
+	return Parse(data)
+}
+
+// Validate checks the document against the ERC-721 metadata schema.
+func (m *Metadata) Validate() error {
+	var problems []string
+
+	if strings.TrimSpace(m.Name) == "" {
+		problems = append(problems, "name is required")
+	}
+	for _, field := range []struct{ name, uri string }{
+		{"image", m.Image},
+		{"external_url", m.ExternalURL},
+		{"animation_url", m.AnimationURL},
+	} {
+		if field.uri == "" {
+			continue
+		}
+		if err := validateURI(field.uri); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", field.name, err))
+		}
+	}
+	if m.BackgroundColor != "" && !colorPattern.MatchString(m.BackgroundColor) {
+		problems = append(problems, "background_color must be six hexadecimal digits without a leading #")
+	}
+	for i, attr := range m.Attributes {
+		switch attr.Value.(type) {
+		case string, bool:
+			if numericDisplayTypes[attr.DisplayType] {
+				problems = append(problems, fmt.Sprintf("attributes[%d]: display type %q requires a numeric value", i, attr.DisplayType))
+			}
+		case float64:
+		case nil:
+			problems = append(problems, fmt.Sprintf("attributes[%d]: value is required", i))
+		default:
+			problems = append(problems, fmt.Sprintf("attributes[%d]: value must be a string, number or boolean", i))
+		}
+	}
+
+	if len(problems) != 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+func validateURI(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	if !uriSchemes[u.Scheme] {
+		return fmt.Errorf("unsupported URI scheme %q", u.Scheme)
+	}
+	return nil
+}
@@ -0,0 +1,300 @@
+// Package migration moves the auctions of an Auction deployment to a new
+// one. It snapshots every auction, plans per auction whether it is settled,
+// refunded or recreated on the new contract, and executes the plan step by
+// step with checkpoints, so that an interrupted migration resumes where it
+// stopped.
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/one-click-platform/system-contracts/generated"
+	"github.com/one-click-platform/system-contracts/reader"
+	"github.com/one-click-platform/system-contracts/txmanager"
+)
+
+const (
+	// DefaultStartDelay is the time between recreating an auction and its
+	// start, which leaves room for the transaction to be mined.
+	DefaultStartDelay = 10 * time.Minute
+	// DefaultDurationIncrement replaces the duration increment of auctions
+	// that have none, which createAuction stored for every auction before
+	// it was fixed, but requires for new ones.
+	DefaultDurationIncrement = 5 * time.Minute
+)
+
+// ErrNotDue is returned for auctions that have not finished yet.
+var ErrNotDue = errors.New("auction has not finished yet")
+
+// Config tunes a migration.
+type Config struct {
+	CheckpointPath    string        // File the checkpoints are persisted to
+	StartDelay        time.Duration // DefaultStartDelay if zero
+	DurationIncrement time.Duration // DefaultDurationIncrement if zero
+	DryRun            bool          // Only log the steps that are due
+}
+
+// Checkpoint is the persisted progress of a step.
+type Checkpoint struct {
+	Done       bool          `json:"done"`
+	Settled    bool          `json:"settled,omitempty"`    // Auction is closed on the old contract
+	NewAuction *big.Int      `json:"newAuction,omitempty"` // ID of the recreated auction
+	Txs        []common.Hash `json:"txs,omitempty"`
+	Note       string        `json:"note,omitempty"`
+}
+
+// Progress summarizes a run. Skipped auctions are not counted.
+type Progress struct {
+	Done    int // Steps completed, in this or an earlier run
+	Due     int // Steps a dry run would execute
+	Waiting int // Steps whose auction has not finished yet
+}
+
+// Migrator executes a plan. The sender of its transactions must be the
+// migrator of the plan.
+type Migrator struct {
+	backend     txmanager.Backend
+	plan        *Plan
+	old         *generated.Auction
+	new         *generated.Auction
+	txs         *txmanager.Manager
+	config      Config
+	checkpoints map[string]*Checkpoint
+}
+
+// New creates a migrator for plan, sending through txs and loading the
+// checkpoints of earlier runs.
+func New(backend txmanager.Backend, plan *Plan, txs *txmanager.Manager, config Config) (*Migrator, error) {
+	if config.CheckpointPath == "" {
+		return nil, errors.New("checkpoint path is required")
+	}
+	if txs.From() != plan.Migrator {
+		return nil, fmt.Errorf("plan was made for %s, not for %s", plan.Migrator.Hex(), txs.From().Hex())
+	}
+	if config.StartDelay == 0 {
+		config.StartDelay = DefaultStartDelay
+	}
+	if config.DurationIncrement == 0 {
+		config.DurationIncrement = DefaultDurationIncrement
+	}
+
+	m := &Migrator{
+		backend:     backend,
+		plan:        plan,
+		txs:         txs,
+		config:      config,
+		checkpoints: make(map[string]*Checkpoint),
+	}
+	var err error
+	if m.old, err = generated.NewAuction(plan.Old, backend); err != nil {
+		return nil, err
+	}
+	if plan.New != (common.Address{}) {
+		if m.new, err = generated.NewAuction(plan.New, backend); err != nil {
+			return nil, err
+		}
+	}
+	if err := load(config.CheckpointPath, &m.checkpoints); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to load checkpoints: %w", err)
+	}
+	return m, nil
+}
+
+// Checkpoint returns the progress of the step of an auction.
+func (m *Migrator) Checkpoint(auction *big.Int) (Checkpoint, bool) {
+	cp, ok := m.checkpoints[auction.String()]
+	if !ok {
+		return Checkpoint{}, false
+	}
+	return *cp, true
+}
+
+// Run executes the steps whose auction finished, in plan order. Steps of
+// auctions still running are left for a later run.
+func (m *Migrator) Run(ctx context.Context) (Progress, error) {
+	var progress Progress
+	for _, step := range m.plan.Steps {
+		if step.Action == ActionSkip {
+			continue
+		}
+		cp := m.checkpoint(step.Auction)
+		if cp.Done {
+			progress.Done++
+			continue
+		}
+
+		err := m.execute(ctx, step, cp)
+		switch {
+		case errors.Is(err, ErrNotDue):
+			progress.Waiting++
+		case err != nil:
+			return progress, fmt.Errorf("failed to migrate auction %s: %w", step.Auction, err)
+		case m.config.DryRun:
+			progress.Due++
+		default:
+			progress.Done++
+		}
+	}
+	return progress, nil
+}
+
+// execute runs a step. The action is chosen again from the current state,
+// as a recreated auction may have received bids since the snapshot.
+func (m *Migrator) execute(ctx context.Context, step Step, cp *Checkpoint) error {
+	callOpts := &bind.CallOpts{Context: ctx}
+	status, err := m.old.GetStatus(callOpts, step.Auction)
+	if err != nil {
+		return err
+	}
+	info, err := m.old.GetAuctionInfo(callOpts, step.Auction)
+	if err != nil {
+		return err
+	}
+	switch reader.Status(status) {
+	case reader.StatusPending, reader.StatusActive:
+		return ErrNotDue
+	}
+
+	action := step.Action
+	if action == ActionRecreate && info.HighestBid.Sign() != 0 {
+		action = ActionSettle
+		cp.Note = "received bids after the snapshot, settled instead of recreated"
+	}
+	if m.config.DryRun {
+		log.Info("Would migrate auction", "auction", step.Auction, "action", action, "status", reader.Status(status))
+		return nil
+	}
+
+	if !cp.Settled {
+		if reader.Status(status) != reader.StatusClosed {
+			receipt, err := m.send(ctx, stepKey("settle", step.Auction), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+				return m.old.Settle(opts, step.Auction)
+			})
+			if err != nil {
+				return err
+			}
+			cp.Txs = append(cp.Txs, receipt.TxHash)
+		}
+		cp.Settled = true
+		if err := m.commit(stepKey("settle", step.Auction)); err != nil {
+			return err
+		}
+		log.Info("Settled auction on the old contract", "auction", step.Auction, "action", action)
+	}
+
+	if action == ActionRecreate && cp.NewAuction == nil {
+		if err := m.recreate(ctx, step.Auction, info, cp); err != nil {
+			return err
+		}
+	}
+	cp.Done = true
+	return m.commit("")
+}
+
+// recreate auctions the lot of a refunded auction again on the new
+// contract, with its original terms and a start after StartDelay.
+func (m *Migrator) recreate(ctx context.Context, id *big.Int, info generated.AuctionAuctionInfo, cp *Checkpoint) error {
+	token, err := generated.NewWERC721(info.TokenAddress, m.backend)
+	if err != nil {
+		return err
+	}
+	owner, err := token.OwnerOf(&bind.CallOpts{Context: ctx}, info.TokenId)
+	if err != nil {
+		return err
+	}
+	if owner != m.txs.From() {
+		return fmt.Errorf("lot %s/%s is held by %s", info.TokenAddress.Hex(), info.TokenId, owner.Hex())
+	}
+
+	receipt, err := m.send(ctx, stepKey("approve", id), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return token.Approve(opts, m.plan.New, info.TokenId)
+	})
+	if err != nil {
+		return err
+	}
+	cp.Txs = append(cp.Txs, receipt.TxHash)
+	if err := m.commit(stepKey("approve", id)); err != nil {
+		return err
+	}
+
+	head, err := m.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	start := new(big.Int).SetUint64(head.Time + uint64(m.config.StartDelay/time.Second))
+	increment := info.DurationIncrement
+	if increment.Sign() == 0 {
+		increment = big.NewInt(int64(m.config.DurationIncrement / time.Second))
+	}
+	receipt, err = m.send(ctx, stepKey("create", id), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return m.new.CreateAuction(opts, info.TokenAddress, info.TokenId, info.CurrencyAddress, info.StartPrice,
+			info.BuyNowPrice, start, info.Duration, increment, info.BidIncrement, info.Description)
+	})
+	if err != nil {
+		return err
+	}
+	cp.Txs = append(cp.Txs, receipt.TxHash)
+	for _, l := range receipt.Logs {
+		if l.Address != m.plan.New {
+			continue
+		}
+		if created, err := m.new.ParseAuctionCreated(*l); err == nil {
+			cp.NewAuction = created.AuctionId
+		}
+	}
+	if cp.NewAuction == nil {
+		return fmt.Errorf("no AuctionCreated event in %s", receipt.TxHash.Hex())
+	}
+	log.Info("Recreated auction on the new contract", "auction", id, "new", cp.NewAuction, "start", start)
+	return m.commit(stepKey("create", id))
+}
+
+// send sends a transaction and waits until it succeeded.
+func (m *Migrator) send(ctx context.Context, key string, send func(opts *bind.TransactOpts) (*types.Transaction, error)) (*types.Receipt, error) {
+	if _, err := m.txs.Send(ctx, key, send); err != nil {
+		return nil, err
+	}
+	receipt, err := m.txs.Wait(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("transaction %s reverted", receipt.TxHash.Hex())
+	}
+	return receipt, nil
+}
+
+// commit saves the checkpoints and then forgets the transaction of key,
+// which the checkpoints cover from now on.
+func (m *Migrator) commit(key string) error {
+	if err := save(m.config.CheckpointPath, m.checkpoints); err != nil {
+		return err
+	}
+	if key == "" {
+		return nil
+	}
+	return m.txs.Forget(key)
+}
+
+func (m *Migrator) checkpoint(id *big.Int) *Checkpoint {
+	cp, ok := m.checkpoints[id.String()]
+	if !ok {
+		cp = new(Checkpoint)
+		m.checkpoints[id.String()] = cp
+	}
+	return cp
+}
+
+func stepKey(phase string, id *big.Int) string {
+	return fmt.Sprintf("migrate:%s:%s", phase, id)
+}
@@ -0,0 +1,153 @@
+package migration
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/one-click-platform/system-contracts/reader"
+)
+
+// Action is what the migration does with an auction of the old contract.
+type Action string
+
+const (
+	// ActionSkip leaves closed auctions alone.
+	ActionSkip Action = "skip"
+	// ActionSettle settles an auction with a winner on the old contract,
+	// once it finished.
+	ActionSettle Action = "settle"
+	// ActionRefund settles an auction without winner on the old contract,
+	// once it finished, which returns the lot to its creator.
+	ActionRefund Action = "refund"
+	// ActionRecreate refunds an auction without winner and auctions the lot
+	// again on the new contract. Only auctions created by the migrating
+	// account can be recreated, as createAuction takes the lot from the
+	// sender.
+	ActionRecreate Action = "recreate"
+)
+
+// Step is the migration of a single auction.
+type Step struct {
+	Auction *big.Int       `json:"auction"`
+	Action  Action         `json:"action"`
+	Status  string         `json:"status"`           // Status at the snapshot
+	Creator common.Address `json:"creator"`          // Creator of the auction
+	Ends    time.Time      `json:"ends"`             // End at the snapshot, later bids extend it
+	Reason  string         `json:"reason,omitempty"` // Why the action was chosen
+}
+
+// Plan lists the steps migrating every auction of a snapshot.
+type Plan struct {
+	Old      common.Address `json:"old"`
+	New      common.Address `json:"new"`
+	Migrator common.Address `json:"migrator"` // Account sending the steps
+	Block    uint64         `json:"block"`    // Block of the snapshot
+	Steps    []Step         `json:"steps"`
+}
+
+// NewPlan classifies the auctions of snapshot. Auctions of migrator without
+// bids are recreated on the contract at newContract, unless it is the zero
+// address.
+func NewPlan(snapshot *Snapshot, newContract, migrator common.Address) *Plan {
+	plan := &Plan{
+		Old:      snapshot.Contract,
+		New:      newContract,
+		Migrator: migrator,
+		Block:    snapshot.Block,
+		Steps:    make([]Step, 0, len(snapshot.Auctions)),
+	}
+	for _, a := range snapshot.Auctions {
+		action, reason := classify(a, newContract, migrator)
+		plan.Steps = append(plan.Steps, Step{
+			Auction: a.ID,
+			Action:  action,
+			Status:  a.Status.String(),
+			Creator: a.Info.Creator,
+			Ends:    ends(a),
+			Reason:  reason,
+		})
+	}
+	return plan
+}
+
+func classify(a AuctionSnapshot, newContract, migrator common.Address) (Action, string) {
+	hasWinner := a.Info.HighestBid.Sign() != 0
+
+	switch a.Status {
+	case reader.StatusNone, reader.StatusClosed:
+		return ActionSkip, "nothing left to transfer"
+	case reader.StatusFinished:
+		if hasWinner {
+			return ActionSettle, "finished with a winner"
+		}
+		return ActionRefund, "finished without bids"
+	}
+
+	// Pending and active auctions can not be cancelled, they run to their
+	// end on the old contract.
+	if hasWinner {
+		return ActionSettle, "has bids, settled once finished"
+	}
+	if newContract == (common.Address{}) {
+		return ActionRefund, "no bids, no new contract given"
+	}
+	if a.Info.Creator != migrator {
+		return ActionRefund, "no bids, the creator has to recreate it"
+	}
+	return ActionRecreate, "no bids, recreated once finished"
+}
+
+func ends(a AuctionSnapshot) time.Time {
+	end := new(big.Int).Add(a.Info.StartTime, a.Info.Duration)
+	if !end.IsInt64() {
+		return time.Time{}
+	}
+	return time.Unix(end.Int64(), 0).UTC()
+}
+
+// Count returns the number of steps per action.
+func (p *Plan) Count() map[Action]int {
+	count := make(map[Action]int)
+	for _, step := range p.Steps {
+		count[step.Action]++
+	}
+	return count
+}
+
+// Report writes a human readable summary of the plan, which is what a dry
+// run of the migration shows before anything is sent.
+func (p *Plan) Report(w io.Writer) error {
+	count := p.Count()
+	fmt.Fprintf(w, "Migration of %s to %s at block %d by %s\n", p.Old.Hex(), p.New.Hex(), p.Block, p.Migrator.Hex())
+	fmt.Fprintf(w, "%d auctions: %d skip, %d settle, %d refund, %d recreate\n\n",
+		len(p.Steps), count[ActionSkip], count[ActionSettle], count[ActionRefund], count[ActionRecreate])
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "AUCTION\tSTATUS\tACTION\tENDS\tCREATOR\tREASON")
+	for _, step := range p.Steps {
+		if step.Action == ActionSkip {
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", step.Auction, step.Status, step.Action, step.Ends.Format(time.RFC3339), step.Creator.Hex(), step.Reason)
+	}
+	return tw.Flush()
+}
+
+// LoadPlan reads a plan written by Save.
+func LoadPlan(path string) (*Plan, error) {
+	plan := new(Plan)
+	if err := load(path, plan); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// Save writes the plan to path.
+func (p *Plan) Save(path string) error {
+	return save(path, p)
+}
@@ -0,0 +1,97 @@
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/one-click-platform/system-contracts/generated"
+	"github.com/one-click-platform/system-contracts/reader"
+)
+
+// Snapshot is the state of every auction of a deployment at one block.
+type Snapshot struct {
+	Contract common.Address    `json:"contract"`
+	Block    uint64            `json:"block"`
+	Time     time.Time         `json:"time"` // Timestamp of Block
+	Auctions []AuctionSnapshot `json:"auctions"`
+}
+
+// AuctionSnapshot is a single auction of a snapshot.
+type AuctionSnapshot struct {
+	ID     *big.Int                     `json:"id"`
+	Status reader.Status                `json:"status"`
+	Info   generated.AuctionAuctionInfo `json:"info"`
+}
+
+// HeaderReader reads the block a snapshot is taken at.
+type HeaderReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Take snapshots every auction of the contract r reads at the latest block.
+// Unlike the keeper, a migration can not skip auctions, so a snapshot fails
+// if any auction can not be read.
+func Take(ctx context.Context, headers HeaderReader, r *reader.AuctionReader, contract common.Address) (*Snapshot, error) {
+	head, err := headers.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	auctions, err := r.GetAll(&bind.CallOpts{Context: ctx, BlockNumber: head.Number})
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &Snapshot{
+		Contract: contract,
+		Block:    head.Number.Uint64(),
+		Time:     time.Unix(int64(head.Time), 0).UTC(),
+		Auctions: make([]AuctionSnapshot, len(auctions)),
+	}
+	for i, a := range auctions {
+		snapshot.Auctions[i] = AuctionSnapshot{ID: a.ID, Status: a.Status, Info: a.Info}
+	}
+	return snapshot, nil
+}
+
+// LoadSnapshot reads a snapshot written by Save.
+func LoadSnapshot(path string) (*Snapshot, error) {
+	snapshot := new(Snapshot)
+	if err := load(path, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// Save writes the snapshot to path.
+func (s *Snapshot) Save(path string) error {
+	return save(path, s)
+}
+
+func load(path string, v interface{}) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func save(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,49 @@
+package offers
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ServeHTTP lists outstanding offers as JSON:
+//
+//	GET /tokens/<tokenAddress>/<tokenId>
+//	GET /bidders/<bidder>
+func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	now := time.Now()
+
+	var result []Offer
+	switch {
+	case len(parts) == 3 && parts[0] == "tokens":
+		tokenId, ok := new(big.Int).SetString(parts[2], 10)
+		if !common.IsHexAddress(parts[1]) || !ok {
+			http.Error(w, "invalid token", http.StatusBadRequest)
+			return
+		}
+		result = s.ByToken(common.HexToAddress(parts[1]), tokenId, now)
+	case len(parts) == 2 && parts[0] == "bidders":
+		if !common.IsHexAddress(parts[1]) {
+			http.Error(w, "invalid bidder", http.StatusBadRequest)
+			return
+		}
+		result = s.ByBidder(common.HexToAddress(parts[1]), now)
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,163 @@
+// Package offers indexes the events of the Offers contract and lists the
+// outstanding offers per token and per bidder.
+package offers
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/one-click-platform/system-contracts/generated"
+)
+
+// Offer is the indexed state of a single offer.
+type Offer struct {
+	ID              *big.Int       `json:"id"`
+	Bidder          common.Address `json:"bidder"`
+	TokenAddress    common.Address `json:"tokenAddress"`
+	TokenId         *big.Int       `json:"tokenId"`
+	CurrencyAddress common.Address `json:"currencyAddress"`
+	Amount          *big.Int       `json:"amount"`
+	Expiry          *big.Int       `json:"expiry"`
+	Accepted        bool           `json:"accepted"`
+	Cancelled       bool           `json:"cancelled"`
+}
+
+// Outstanding reports whether the offer can still be accepted at now.
+func (o *Offer) Outstanding(now time.Time) bool {
+	return !o.Accepted && !o.Cancelled && o.Expiry.Cmp(big.NewInt(now.Unix())) >= 0
+}
+
+// Service keeps an in-memory index of offers built from contract events.
+type Service struct {
+	filterer *generated.OffersFilterer
+
+	mu     sync.RWMutex
+	offers map[string]*Offer
+	next   uint64 // Next block to index
+}
+
+// NewService creates a service indexing events from fromBlock on, usually
+// the deployment block of the Offers contract.
+func NewService(filterer *generated.OffersFilterer, fromBlock uint64) *Service {
+	return &Service{
+		filterer: filterer,
+		offers:   make(map[string]*Offer),
+		next:     fromBlock,
+	}
+}
+
+// Sync indexes all events up to and including block to.
+func (s *Service) Sync(ctx context.Context, to uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if to < s.next {
+		return nil
+	}
+	opts := &bind.FilterOpts{Start: s.next, End: &to, Context: ctx}
+
+	// Offers are created before they are accepted or cancelled, so applying
+	// the creations of the range first keeps the index consistent.
+	created, err := s.filterer.FilterOfferCreated(opts)
+	if err != nil {
+		return err
+	}
+	defer created.Close()
+	for created.Next() {
+		e := created.Event
+		s.offers[e.OfferId.String()] = &Offer{
+			ID:              e.OfferId,
+			Bidder:          e.Bidder,
+			TokenAddress:    e.TokenAddress,
+			TokenId:         e.TokenId,
+			CurrencyAddress: e.CurrencyAddress,
+			Amount:          e.Amount,
+			Expiry:          e.Expiry,
+		}
+	}
+	if err := created.Error(); err != nil {
+		return err
+	}
+
+	accepted, err := s.filterer.FilterOfferAccepted(opts)
+	if err != nil {
+		return err
+	}
+	defer accepted.Close()
+	for accepted.Next() {
+		if offer, ok := s.offers[accepted.Event.OfferId.String()]; ok {
+			offer.Accepted = true
+		}
+	}
+	if err := accepted.Error(); err != nil {
+		return err
+	}
+
+	cancelled, err := s.filterer.FilterOfferCancelled(opts)
+	if err != nil {
+		return err
+	}
+	defer cancelled.Close()
+	for cancelled.Next() {
+		if offer, ok := s.offers[cancelled.Event.OfferId.String()]; ok {
+			offer.Cancelled = true
+		}
+	}
+	if err := cancelled.Error(); err != nil {
+		return err
+	}
+
+	s.next = to + 1
+	return nil
+}
+
+// Get returns a copy of the offer with the given ID.
+func (s *Service) Get(offerId *big.Int) (Offer, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	offer, ok := s.offers[offerId.String()]
+	if !ok {
+		return Offer{}, false
+	}
+	return *offer, true
+}
+
+// ByToken returns the outstanding offers on a token, highest amount first.
+func (s *Service) ByToken(tokenAddress common.Address, tokenId *big.Int, now time.Time) []Offer {
+	return s.outstanding(now, func(o *Offer) bool {
+		return o.TokenAddress == tokenAddress && o.TokenId.Cmp(tokenId) == 0
+	})
+}
+
+// ByBidder returns the outstanding offers of a bidder, highest amount first.
+func (s *Service) ByBidder(bidder common.Address, now time.Time) []Offer {
+	return s.outstanding(now, func(o *Offer) bool {
+		return o.Bidder == bidder
+	})
+}
+
+func (s *Service) outstanding(now time.Time, match func(*Offer) bool) []Offer {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]Offer, 0)
+	for _, offer := range s.offers {
+		if offer.Outstanding(now) && match(offer) {
+			result = append(result, *offer)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if c := result[i].Amount.Cmp(result[j].Amount); c != 0 {
+			return c > 0
+		}
+		return result[i].ID.Cmp(result[j].ID) < 0
+	})
+	return result
+}
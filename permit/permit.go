@@ -0,0 +1,114 @@
+// Package permit signs EIP-2612 permits for WETH so that a bid can be placed
+// with a single Auction.bidWithPermit transaction instead of approve + bid.
+package permit
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/one-click-platform/system-contracts/eip712"
+	"github.com/one-click-platform/system-contracts/generated"
+	"github.com/one-click-platform/system-contracts/signer"
+	"github.com/one-click-platform/system-contracts/txmanager"
+)
+
+// PermitType is the EIP-712 encoded type of an EIP-2612 permit.
+const PermitType = "Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"
+
+// Permit is an EIP-2612 allowance approval.
+type Permit struct {
+	Owner    common.Address
+	Spender  common.Address
+	Value    *big.Int
+	Nonce    *big.Int
+	Deadline *big.Int
+}
+
+// Hash returns the EIP-712 struct hash of the permit.
+func (p Permit) Hash() common.Hash {
+	return eip712.HashStruct(eip712.TypeHash(PermitType), p.Owner, p.Spender, p.Value, p.Nonce, p.Deadline)
+}
+
+// Sign signs the permit for the token with the given domain separator.
+func Sign(key *ecdsa.PrivateKey, domainSeparator common.Hash, p Permit) (eip712.Signature, error) {
+	return eip712.Sign(key, eip712.Digest(domainSeparator, p.Hash()))
+}
+
+// New reads the owner's current nonce and the domain separator from the WETH
+// contract and returns a permit allowing spender to transfer value until deadline.
+func New(weth *generated.WETHCaller, opts *bind.CallOpts, owner, spender common.Address, value, deadline *big.Int) (Permit, common.Hash, error) {
+	nonce, err := weth.Nonces(opts, owner)
+	if err != nil {
+		return Permit{}, common.Hash{}, err
+	}
+	separator, err := weth.DOMAINSEPARATOR(opts)
+	if err != nil {
+		return Permit{}, common.Hash{}, err
+	}
+
+	p := Permit{
+		Owner:    owner,
+		Spender:  spender,
+		Value:    value,
+		Nonce:    nonce,
+		Deadline: deadline,
+	}
+	return p, separator, nil
+}
+
+// ErrWrongSender is returned when the transactions are not sent by the
+// signer of the permit.
+var ErrWrongSender = errors.New("transactions are not sent by the permit signer")
+
+// Bid signs a permit for amount with s and places the bid with
+// Auction.bidWithPermit in one transaction, sent through txs, which must
+// send from s as well. The bid is journaled by txs under a key of the
+// auction and amount, so placing it again returns the journaled transaction
+// without signing another permit.
+func Bid(ctx context.Context, backend bind.ContractBackend, s signer.Signer, txs *txmanager.Manager, auctionAddress common.Address, auctionId, amount, deadline *big.Int) (*txmanager.Tx, error) {
+	if txs.From() != s.Address() {
+		return nil, ErrWrongSender
+	}
+	key := fmt.Sprintf("bid-with-permit:%s:%s:%s", auctionAddress.Hex(), auctionId, amount)
+	if tx, ok := txs.Get(key); ok {
+		return tx, nil
+	}
+
+	auction, err := generated.NewAuction(auctionAddress, backend)
+	if err != nil {
+		return nil, err
+	}
+	callOpts := &bind.CallOpts{Context: ctx}
+
+	info, err := auction.GetAuctionInfo(callOpts, auctionId)
+	if err != nil {
+		return nil, err
+	}
+	weth, err := generated.NewWETHCaller(info.CurrencyAddress, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	p, separator, err := New(weth, callOpts, s.Address(), auctionAddress, amount, deadline)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := s.SignHash(ctx, eip712.Digest(separator, p.Hash()))
+	if err != nil {
+		return nil, err
+	}
+	sig, err := eip712.ParseSignature(raw)
+	if err != nil {
+		return nil, err
+	}
+	return txs.Send(ctx, key, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return auction.BidWithPermit(opts, auctionId, amount, deadline, sig.V, sig.R, sig.S)
+	})
+}
@@ -0,0 +1,350 @@
+// Package proxybid places proxy bids: it keeps outbidding others with the
+// minimum raising bid until a per-auction ceiling or a total budget is hit.
+package proxybid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/one-click-platform/system-contracts/generated"
+	"github.com/one-click-platform/system-contracts/reader"
+	"github.com/one-click-platform/system-contracts/txmanager"
+)
+
+// DefaultInterval is the time between two checks of every tracked auction,
+// which catches auctions finishing without a bid event.
+const DefaultInterval = 15 * time.Second
+
+var (
+	ErrCurrencyMismatch = errors.New("auction is not paid in the bidder's currency")
+	ErrNotBiddable      = errors.New("auction is neither pending nor active")
+	ErrOutbid           = errors.New("outbid beyond the ceiling")
+	ErrFinished         = errors.New("auction is finished")
+)
+
+// Backend is the chain access needed to watch bids and send transactions.
+// Watching requires a backend with subscription support, such as a
+// websocket connection.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Config tunes the bidder.
+type Config struct {
+	Budget   *big.Int      // Highest total of leading and won bids across all auctions
+	Interval time.Duration // Check interval, DefaultInterval if zero
+}
+
+// Auction is the bidding state of a tracked auction.
+type Auction struct {
+	ID      *big.Int
+	Ceiling *big.Int // Highest bid to place
+	Bid     *big.Int // Our leading or pending bid, nil if there is none
+	Escrow  *big.Int // Funds held by the contract for Bid, above it for max bids
+	Leading bool     // Whether Bid is the highest bid of the auction
+	Stopped error    // ErrOutbid or ErrFinished once bidding stopped
+}
+
+// Won reports whether the auction finished with our bid as the highest.
+func (a *Auction) Won() bool {
+	return a.Stopped == ErrFinished && a.Leading
+}
+
+// Bidder bids on a set of auctions of one Auction contract, all of them paid
+// in the same WETH token.
+type Bidder struct {
+	backend     Backend
+	auction     *generated.Auction
+	address     common.Address
+	weth        *generated.WETH
+	wethAddress common.Address
+	txs         *txmanager.Manager
+	config      Config
+
+	auctions []*Auction
+	byID     map[string]*Auction
+	pending  map[string]string // Transaction manager keys of the bids sent per auction ID
+}
+
+// New creates a bidder for the Auction contract at address, paying with the
+// WETH token at weth and sending transactions through txs.
+func New(backend Backend, address, weth common.Address, txs *txmanager.Manager, config Config) (*Bidder, error) {
+	if config.Budget == nil || config.Budget.Sign() <= 0 {
+		return nil, errors.New("budget should be positive")
+	}
+	if config.Interval == 0 {
+		config.Interval = DefaultInterval
+	}
+
+	auction, err := generated.NewAuction(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	token, err := generated.NewWETH(weth, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Bidder{
+		backend:     backend,
+		auction:     auction,
+		address:     address,
+		weth:        token,
+		wethAddress: weth,
+		txs:         txs,
+		config:      config,
+		byID:        make(map[string]*Auction),
+		pending:     make(map[string]string),
+	}, nil
+}
+
+// Add starts tracking an auction, bidding on it up to ceiling. It must be
+// called before Run.
+func (b *Bidder) Add(ctx context.Context, auctionId, ceiling *big.Int) error {
+	if _, ok := b.byID[auctionId.String()]; ok {
+		return fmt.Errorf("auction %s is already tracked", auctionId)
+	}
+
+	callOpts := &bind.CallOpts{Context: ctx, From: b.txs.From()}
+	status, err := b.auction.GetStatus(callOpts, auctionId)
+	if err != nil {
+		return err
+	}
+	if s := reader.Status(status); s != reader.StatusPending && s != reader.StatusActive {
+		return fmt.Errorf("auction %s is %s: %w", auctionId, s, ErrNotBiddable)
+	}
+	info, err := b.auction.GetAuctionInfo(callOpts, auctionId)
+	if err != nil {
+		return err
+	}
+	if info.CurrencyAddress != b.wethAddress {
+		return fmt.Errorf("auction %s is paid in %s: %w", auctionId, info.CurrencyAddress.Hex(), ErrCurrencyMismatch)
+	}
+
+	a := &Auction{ID: new(big.Int).Set(auctionId), Ceiling: new(big.Int).Set(ceiling)}
+	b.auctions = append(b.auctions, a)
+	b.byID[auctionId.String()] = a
+	// A bid journaled before a restart is followed up by the next check.
+	for _, tx := range b.txs.Pending() {
+		if strings.HasPrefix(tx.Key, bidKeyPrefix(auctionId)) {
+			b.pending[auctionId.String()] = tx.Key
+		}
+	}
+	return nil
+}
+
+// Auctions returns a copy of the state of the tracked auctions.
+func (b *Bidder) Auctions() []Auction {
+	auctions := make([]Auction, len(b.auctions))
+	for i, a := range b.auctions {
+		auctions[i] = *a
+	}
+	return auctions
+}
+
+// Run bids until bidding stopped on every tracked auction or ctx is
+// cancelled. Errors of a single check are logged and do not stop the bidder.
+func (b *Bidder) Run(ctx context.Context) error {
+	bids := make(chan *generated.AuctionAuctionBid)
+	sub, err := b.auction.WatchAuctionBid(&bind.WatchOpts{Context: ctx}, bids)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	ticker := time.NewTicker(b.config.Interval)
+	defer ticker.Stop()
+
+	for _, a := range b.auctions {
+		b.check(ctx, a)
+	}
+	for !b.stopped() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-sub.Err():
+			return err
+		case ev := <-bids:
+			a, ok := b.byID[ev.AuctionId.String()]
+			if !ok || a.Stopped != nil {
+				continue
+			}
+			log.Debug("Auction bid observed", "auction", ev.AuctionId, "bidder", ev.Bidder, "amount", ev.Amount)
+			b.check(ctx, a)
+		case <-ticker.C:
+			for _, a := range b.auctions {
+				b.check(ctx, a)
+			}
+		}
+	}
+	return nil
+}
+
+func (b *Bidder) check(ctx context.Context, a *Auction) {
+	if a.Stopped != nil {
+		return
+	}
+	if err := b.bid(ctx, a); err != nil {
+		log.Error("Proxy bid failed", "auction", a.ID, "err", err)
+	}
+}
+
+// bid syncs the state of an auction with the chain and outbids the current
+// highest bidder if the ceiling and the budget allow it.
+func (b *Bidder) bid(ctx context.Context, a *Auction) error {
+	if key, ok := b.pending[a.ID.String()]; ok {
+		receipt, err := b.txs.Check(ctx, key)
+		switch {
+		case errors.Is(err, txmanager.ErrReplaced):
+			log.Warn("Proxy bid replaced by another transaction", "auction", a.ID, "amount", a.Bid)
+		case err != nil:
+			return err
+		case receipt == nil:
+			return nil
+		case receipt.Status != types.ReceiptStatusSuccessful:
+			log.Warn("Proxy bid reverted", "auction", a.ID, "amount", a.Bid, "tx", receipt.TxHash)
+		}
+		if err := b.txs.Forget(key); err != nil {
+			return err
+		}
+		delete(b.pending, a.ID.String())
+	}
+
+	callOpts := &bind.CallOpts{Context: ctx, From: b.txs.From()}
+	status, err := b.auction.GetStatus(callOpts, a.ID)
+	if err != nil {
+		return err
+	}
+	info, err := b.auction.GetAuctionInfo(callOpts, a.ID)
+	if err != nil {
+		return err
+	}
+	a.Leading = info.HighestBid.Sign() != 0 && info.CurrentBidder == b.txs.From()
+	a.Bid, a.Escrow = nil, nil
+	if a.Leading {
+		escrow, err := b.auction.GetMaxBid(callOpts, a.ID)
+		if err != nil {
+			return err
+		}
+		a.Bid, a.Escrow = info.HighestBid, escrow
+	}
+
+	switch reader.Status(status) {
+	case reader.StatusPending:
+		return nil
+	case reader.StatusActive:
+	default:
+		a.Stopped = ErrFinished
+		log.Info("Auction finished", "auction", a.ID, "won", a.Leading, "bid", a.Bid)
+		return nil
+	}
+	if a.Leading {
+		return nil
+	}
+
+	amount, err := b.auction.GetRaisingBid(callOpts, a.ID)
+	if err != nil {
+		return err
+	}
+	if amount.Cmp(a.Ceiling) > 0 {
+		a.Stopped = ErrOutbid
+		log.Info("Outbid beyond the ceiling", "auction", a.ID, "raisingBid", amount, "ceiling", a.Ceiling)
+		return nil
+	}
+	available := new(big.Int).Sub(b.config.Budget, b.committed())
+	if amount.Cmp(available) > 0 {
+		log.Warn("Budget exhausted, postponing bid", "auction", a.ID, "raisingBid", amount, "available", available)
+		return nil
+	}
+
+	balance, err := b.weth.BalanceOf(callOpts, b.txs.From())
+	if err != nil {
+		return err
+	}
+	if balance.Cmp(amount) < 0 {
+		return fmt.Errorf("balance of %s is below the raising bid of %s", balance, amount)
+	}
+	if err := b.ensureAllowance(ctx, amount, available); err != nil {
+		return err
+	}
+
+	key := bidKeyPrefix(a.ID) + amount.String()
+	tx, err := b.txs.Send(ctx, key, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return b.auction.Bid(opts, a.ID, amount)
+	})
+	if err != nil {
+		return err
+	}
+	a.Bid, a.Escrow = amount, amount
+	b.pending[a.ID.String()] = key
+	log.Info("Sent proxy bid", "auction", a.ID, "amount", amount, "ceiling", a.Ceiling, "tx", tx.Hashes[0])
+	return nil
+}
+
+// ensureAllowance approves the remaining budget to the Auction contract when
+// the current allowance does not cover amount, and waits for the approval to
+// be mined so that the bid that follows does not fail gas estimation.
+func (b *Bidder) ensureAllowance(ctx context.Context, amount, available *big.Int) error {
+	allowance, err := b.weth.Allowance(&bind.CallOpts{Context: ctx}, b.txs.From(), b.address)
+	if err != nil {
+		return err
+	}
+	if allowance.Cmp(amount) >= 0 {
+		return nil
+	}
+
+	key := fmt.Sprintf("approve:%s:%s", b.address.Hex(), available)
+	tx, err := b.txs.Send(ctx, key, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return b.weth.Approve(opts, b.address, available)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to approve: %w", err)
+	}
+	log.Info("Sent allowance approval", "spender", b.address, "amount", available, "tx", tx.Hashes[0])
+
+	receipt, err := b.txs.Wait(ctx, key)
+	if err != nil {
+		return err
+	}
+	if err := b.txs.Forget(key); err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("approval %s reverted", receipt.TxHash.Hex())
+	}
+	return nil
+}
+
+// committed sums the escrows of leading, pending and won bids, which are the
+// funds held by the Auction contract or already spent.
+func (b *Bidder) committed() *big.Int {
+	total := new(big.Int)
+	for _, a := range b.auctions {
+		if a.Escrow != nil {
+			total.Add(total, a.Escrow)
+		}
+	}
+	return total
+}
+
+func bidKeyPrefix(auctionId *big.Int) string {
+	return "bid:" + auctionId.String() + ":"
+}
+
+func (b *Bidder) stopped() bool {
+	for _, a := range b.auctions {
+		if a.Stopped == nil {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,163 @@
+package reader
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/one-click-platform/system-contracts/generated"
+)
+
+// DefaultPageSize is the number of entries requested per page by the iterators.
+const DefaultPageSize = 50
+
+// AuctionIterator lazily pages through all auctions with getAuctions.
+// Pin opts.BlockNumber to get a consistent view across pages.
+type AuctionIterator struct {
+	ID   *big.Int                     // ID of the current auction
+	Info generated.AuctionAuctionInfo // Current auction
+
+	caller   *generated.AuctionCaller
+	opts     *bind.CallOpts
+	pageSize int64
+
+	page   []generated.AuctionAuctionInfo
+	offset int64 // ID of the first auction in page
+	pos    int
+	done   bool
+	fail   error
+}
+
+// NewAuctionIterator creates an iterator over all auctions. A non-positive
+// pageSize falls back to DefaultPageSize.
+func NewAuctionIterator(caller *generated.AuctionCaller, opts *bind.CallOpts, pageSize int) *AuctionIterator {
+	return &AuctionIterator{
+		caller:   caller,
+		opts:     opts,
+		pageSize: normalizePageSize(pageSize),
+		pos:      -1,
+	}
+}
+
+// Next advances the iterator to the next auction, fetching a new page when
+// the current one is exhausted. It returns false when there are no more
+// auctions or an error occurred.
+func (it *AuctionIterator) Next() bool {
+	if it.fail != nil {
+		return false
+	}
+	if it.pos+1 >= len(it.page) {
+		if it.done {
+			return false
+		}
+		offset := it.offset + int64(len(it.page))
+		page, err := it.caller.GetAuctions(it.opts, big.NewInt(offset), big.NewInt(it.pageSize))
+		if err != nil {
+			it.fail = err
+			return false
+		}
+		it.page, it.offset, it.pos = page, offset, -1
+		it.done = int64(len(page)) < it.pageSize
+		if len(page) == 0 {
+			return false
+		}
+	}
+
+	it.pos++
+	it.ID = big.NewInt(it.offset + int64(it.pos))
+	it.Info = it.page[it.pos]
+	return true
+}
+
+// Error returns any retrieval error that occurred during paging.
+func (it *AuctionIterator) Error() error {
+	return it.fail
+}
+
+// IDIterator lazily pages through a list of auction or token IDs.
+type IDIterator struct {
+	ID *big.Int // Current ID
+
+	fetch    func(offset, limit *big.Int) ([]*big.Int, error)
+	pageSize int64
+
+	page   []*big.Int
+	offset int64
+	pos    int
+	done   bool
+	fail   error
+}
+
+// NewCreatorAuctionIterator creates an iterator over the IDs of auctions
+// created by creator.
+func NewCreatorAuctionIterator(caller *generated.AuctionCaller, opts *bind.CallOpts, creator common.Address, pageSize int) *IDIterator {
+	return newIDIterator(func(offset, limit *big.Int) ([]*big.Int, error) {
+		return caller.GetCreatorAuctions(opts, creator, offset, limit)
+	}, pageSize)
+}
+
+// NewBidderAuctionIterator creates an iterator over the IDs of auctions
+// bidder has ever bid on.
+func NewBidderAuctionIterator(caller *generated.AuctionCaller, opts *bind.CallOpts, bidder common.Address, pageSize int) *IDIterator {
+	return newIDIterator(func(offset, limit *big.Int) ([]*big.Int, error) {
+		return caller.GetBidderAuctions(opts, bidder, offset, limit)
+	}, pageSize)
+}
+
+// NewOwnerTokenIterator creates an iterator over the IDs of WERC721 tokens
+// held by owner. The token order changes when owner transfers tokens, so pin
+// opts.BlockNumber while paging.
+func NewOwnerTokenIterator(caller *generated.WERC721Caller, opts *bind.CallOpts, owner common.Address, pageSize int) *IDIterator {
+	return newIDIterator(func(offset, limit *big.Int) ([]*big.Int, error) {
+		return caller.GetTokensOfOwner(opts, owner, offset, limit)
+	}, pageSize)
+}
+
+func newIDIterator(fetch func(offset, limit *big.Int) ([]*big.Int, error), pageSize int) *IDIterator {
+	return &IDIterator{
+		fetch:    fetch,
+		pageSize: normalizePageSize(pageSize),
+		pos:      -1,
+	}
+}
+
+// Next advances the iterator to the next ID, fetching a new page
+// when the current one is exhausted.
+func (it *IDIterator) Next() bool {
+	if it.fail != nil {
+		return false
+	}
+	if it.pos+1 >= len(it.page) {
+		if it.done {
+			return false
+		}
+		offset := it.offset + int64(len(it.page))
+		page, err := it.fetch(big.NewInt(offset), big.NewInt(it.pageSize))
+		if err != nil {
+			it.fail = err
+			return false
+		}
+		it.page, it.offset, it.pos = page, offset, -1
+		it.done = int64(len(page)) < it.pageSize
+		if len(page) == 0 {
+			return false
+		}
+	}
+
+	it.pos++
+	it.ID = it.page[it.pos]
+	return true
+}
+
+// Error returns any retrieval error that occurred during paging.
+func (it *IDIterator) Error() error {
+	return it.fail
+}
+
+func normalizePageSize(pageSize int) int64 {
+	if pageSize <= 0 {
+		return DefaultPageSize
+	}
+	return int64(pageSize)
+}
@@ -0,0 +1,233 @@
+// Package reader lists auctions and tokens of the system contracts without
+// paying an RPC round trip per entry, either by batching calls or by paging.
+package reader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/one-click-platform/system-contracts/generated"
+)
+
+// DefaultBatchSize is the number of auctions read per JSON-RPC batch request.
+const DefaultBatchSize = 100
+
+// Auction is the state of a single auction as seen by the reader.
+type Auction struct {
+	ID     *big.Int
+	Info   generated.AuctionAuctionInfo
+	Status Status
+	// Err is set when getAuctionInfo or getStatus failed for this auction,
+	// in which case Info and Status must not be used.
+	Err error
+}
+
+// PartialError is returned when some auctions of a batch could not be read.
+// The remaining auctions are still returned to the caller.
+type PartialError struct {
+	Failed []*big.Int
+}
+
+func (e *PartialError) Error() string {
+	ids := make([]string, len(e.Failed))
+	for i, id := range e.Failed {
+		ids[i] = id.String()
+	}
+	return fmt.Sprintf("failed to read %d auction(s): %s", len(e.Failed), strings.Join(ids, ", "))
+}
+
+// AuctionReader reads auctions with JSON-RPC batch requests of eth_call.
+type AuctionReader struct {
+	client    *rpc.Client
+	address   common.Address
+	abi       abi.ABI
+	batchSize int
+}
+
+// NewAuctionReader creates a reader for the Auction contract deployed at address.
+// A non-positive batchSize falls back to DefaultBatchSize.
+func NewAuctionReader(client *rpc.Client, address common.Address, batchSize int) (*AuctionReader, error) {
+	parsed, err := abi.JSON(strings.NewReader(generated.AuctionABI))
+	if err != nil {
+		return nil, err
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	return &AuctionReader{
+		client:    client,
+		address:   address,
+		abi:       parsed,
+		batchSize: batchSize,
+	}, nil
+}
+
+// GetAll reads every auction created so far.
+func (r *AuctionReader) GetAll(opts *bind.CallOpts) ([]Auction, error) {
+	opts, err := r.pin(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var count *big.Int
+	if err := r.call(opts, &count, "countOfAuctions"); err != nil {
+		return nil, err
+	}
+
+	ids := make([]*big.Int, count.Int64())
+	for i := range ids {
+		ids[i] = big.NewInt(int64(i))
+	}
+	return r.Get(opts, ids)
+}
+
+// Get reads the info and status of the given auctions in batches of the
+// configured size. All batches are executed against the same block. If only
+// some of the auctions fail, every auction is returned along with a
+// *PartialError listing the failed ones.
+func (r *AuctionReader) Get(opts *bind.CallOpts, ids []*big.Int) ([]Auction, error) {
+	opts, err := r.pin(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Auction, 0, len(ids))
+	for start := 0; start < len(ids); start += r.batchSize {
+		end := start + r.batchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		batch, err := r.getBatch(opts, ids[start:end])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, batch...)
+	}
+
+	var failed []*big.Int
+	for _, a := range result {
+		if a.Err != nil {
+			failed = append(failed, a.ID)
+		}
+	}
+	if len(failed) != 0 {
+		return result, &PartialError{Failed: failed}
+	}
+	return result, nil
+}
+
+func (r *AuctionReader) getBatch(opts *bind.CallOpts, ids []*big.Int) ([]Auction, error) {
+	elems := make([]rpc.BatchElem, 0, 2*len(ids))
+	for _, id := range ids {
+		for _, method := range []string{"getAuctionInfo", "getStatus"} {
+			elem, err := r.callElem(opts, method, id)
+			if err != nil {
+				return nil, err
+			}
+			elems = append(elems, elem)
+		}
+	}
+
+	if err := r.client.BatchCallContext(r.context(opts), elems); err != nil {
+		return nil, err
+	}
+
+	result := make([]Auction, len(ids))
+	for i, id := range ids {
+		result[i].ID = id
+
+		info, status := elems[2*i], elems[2*i+1]
+		if err := r.unpack(info, &result[i].Info, "getAuctionInfo"); err != nil {
+			result[i].Err = err
+			continue
+		}
+		var raw uint8
+		if err := r.unpack(status, &raw, "getStatus"); err != nil {
+			result[i].Err = err
+			continue
+		}
+		result[i].Status = Status(raw)
+	}
+	return result, nil
+}
+
+// pin fixes the block number of opts so that all batches observe the same state.
+func (r *AuctionReader) pin(opts *bind.CallOpts) (*bind.CallOpts, error) {
+	if opts == nil {
+		opts = new(bind.CallOpts)
+	}
+	if opts.BlockNumber != nil {
+		return opts, nil
+	}
+
+	var head hexutil.Big
+	if err := r.client.CallContext(r.context(opts), &head, "eth_blockNumber"); err != nil {
+		return nil, err
+	}
+	pinned := *opts
+	pinned.BlockNumber = head.ToInt()
+	return &pinned, nil
+}
+
+func (r *AuctionReader) call(opts *bind.CallOpts, out interface{}, method string, params ...interface{}) error {
+	elem, err := r.callElem(opts, method, params...)
+	if err != nil {
+		return err
+	}
+	if err := r.client.CallContext(r.context(opts), elem.Result, elem.Method, elem.Args...); err != nil {
+		return err
+	}
+	return r.unpack(elem, out, method)
+}
+
+func (r *AuctionReader) callElem(opts *bind.CallOpts, method string, params ...interface{}) (rpc.BatchElem, error) {
+	data, err := r.abi.Pack(method, params...)
+	if err != nil {
+		return rpc.BatchElem{}, err
+	}
+
+	msg := map[string]interface{}{
+		"from": opts.From,
+		"to":   r.address,
+		"data": hexutil.Bytes(data),
+	}
+	return rpc.BatchElem{
+		Method: "eth_call",
+		Args:   []interface{}{msg, hexutil.EncodeBig(opts.BlockNumber)},
+		Result: new(hexutil.Bytes),
+	}, nil
+}
+
+func (r *AuctionReader) unpack(elem rpc.BatchElem, out interface{}, method string) error {
+	if elem.Error != nil {
+		return elem.Error
+	}
+	data := *elem.Result.(*hexutil.Bytes)
+	if len(data) == 0 {
+		return errors.New("no contract code at given address")
+	}
+
+	values, err := r.abi.Unpack(method, data)
+	if err != nil {
+		return err
+	}
+	abi.ConvertType(values[0], out)
+	return nil
+}
+
+func (r *AuctionReader) context(opts *bind.CallOpts) context.Context {
+	if opts.Context != nil {
+		return opts.Context
+	}
+	return context.Background()
+}
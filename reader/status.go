@@ -0,0 +1,29 @@
+package reader
+
+// Status mirrors the Auction.AuctionStatus enum of the Auction contract.
+type Status uint8
+
+const (
+	StatusNone Status = iota
+	StatusPending
+	StatusActive
+	StatusFinished
+	StatusClosed
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusNone:
+		return "none"
+	case StatusPending:
+		return "pending"
+	case StatusActive:
+		return "active"
+	case StatusFinished:
+		return "finished"
+	case StatusClosed:
+		return "closed"
+	default:
+		return "unknown"
+	}
+}
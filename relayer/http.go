@@ -0,0 +1,142 @@
+package relayer
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// maxRequestSize bounds the bodies read by the handler.
+const maxRequestSize = 1 << 20
+
+// requestJSON is the wire form of a request. Numbers are accepted as
+// decimal or 0x prefixed hex strings.
+type requestJSON struct {
+	From     common.Address        `json:"from"`
+	To       common.Address        `json:"to"`
+	Value    *math.HexOrDecimal256 `json:"value"`
+	Gas      math.HexOrDecimal64   `json:"gas"`
+	Nonce    *math.HexOrDecimal256 `json:"nonce"`
+	Deadline *math.HexOrDecimal256 `json:"deadline"`
+	Data     hexutil.Bytes         `json:"data"`
+}
+
+type relayJSON struct {
+	Request   requestJSON   `json:"request"`
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+type accountJSON struct {
+	Nonce           *math.HexOrDecimal256 `json:"nonce"`
+	DomainSeparator common.Hash           `json:"domainSeparator"`
+	Usage           Usage                 `json:"usage"`
+}
+
+// Handler serves the relayer over HTTP:
+//
+//	POST /relay              {"request": {...}, "signature": "0x..."} -> {"tx": "0x..."}
+//	GET  /account?address=.. -> {"nonce": ..., "domainSeparator": "0x...", "usage": {...}}
+type Handler struct {
+	relayer *Relayer
+}
+
+// NewHandler creates a handler for relayer.
+func NewHandler(relayer *Relayer) *Handler {
+	return &Handler{relayer: relayer}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/relay":
+		h.serveRelay(w, r)
+	case "/account":
+		h.serveAccount(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (h *Handler) serveRelay(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	var body relayJSON
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(&body); err != nil {
+		http.Error(w, "invalid request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := Request{
+		From:     body.Request.From,
+		To:       body.Request.To,
+		Value:    toInt(body.Request.Value),
+		Gas:      uint64(body.Request.Gas),
+		Nonce:    toInt(body.Request.Nonce),
+		Deadline: toInt(body.Request.Deadline),
+		Data:     body.Request.Data,
+	}
+
+	tx, err := h.relayer.Relay(r.Context(), req, body.Signature)
+	if err != nil {
+		http.Error(w, err.Error(), statusOf(err))
+		return
+	}
+	respond(w, map[string]common.Hash{"tx": tx.Hashes[0]})
+}
+
+func (h *Handler) serveAccount(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	address := r.URL.Query().Get("address")
+	if !common.IsHexAddress(address) {
+		http.Error(w, "invalid address", http.StatusBadRequest)
+		return
+	}
+	from := common.HexToAddress(address)
+
+	nonce, err := h.relayer.Nonce(r.Context(), from)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	respond(w, accountJSON{
+		Nonce:           (*math.HexOrDecimal256)(nonce),
+		DomainSeparator: h.relayer.DomainSeparator(),
+		Usage:           h.relayer.Usage(from),
+	})
+}
+
+func toInt(v *math.HexOrDecimal256) *big.Int {
+	if v == nil {
+		return nil
+	}
+	return (*big.Int)(v)
+}
+
+func statusOf(err error) int {
+	switch {
+	case errors.Is(err, ErrIncomplete):
+		return http.StatusBadRequest
+	case errors.Is(err, ErrQuotaExceeded):
+		return http.StatusTooManyRequests
+	case errors.Is(err, ErrTargetNotAllowed), errors.Is(err, ErrValueNotAllowed), errors.Is(err, ErrGasTooHigh):
+		return http.StatusForbidden
+	case errors.Is(err, ErrExpired), errors.Is(err, ErrInvalidSignature), errors.Is(err, ErrInvalidNonce), errors.Is(err, ErrCallFailed):
+		return http.StatusUnprocessableEntity
+	}
+	return http.StatusInternalServerError
+}
+
+func respond(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
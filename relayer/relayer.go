@@ -0,0 +1,277 @@
+// Package relayer submits forward requests signed by users to the Forwarder
+// contract, paying their gas. This lets users without native currency bid
+// and transfer on the ERC-2771 aware system contracts.
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/one-click-platform/system-contracts/eip712"
+	"github.com/one-click-platform/system-contracts/generated"
+	"github.com/one-click-platform/system-contracts/txmanager"
+)
+
+const (
+	// DefaultQuota is the number of requests relayed per user and window.
+	DefaultQuota = 20
+	// DefaultWindow is the period quotas apply to.
+	DefaultWindow = time.Hour
+	// DefaultMaxGas is the highest gas a single request may ask for.
+	DefaultMaxGas = 500000
+
+	// executeOverhead is the gas used by Forwarder.execute around the call.
+	executeOverhead = 100000
+)
+
+var (
+	ErrIncomplete       = errors.New("request misses its value, nonce or deadline")
+	ErrTargetNotAllowed = errors.New("target contract is not relayed")
+	ErrValueNotAllowed  = errors.New("requests with value are not relayed")
+	ErrGasTooHigh       = errors.New("request gas is above the limit")
+	ErrExpired          = errors.New("request has expired")
+	ErrInvalidSignature = errors.New("signature does not match the request")
+	ErrInvalidNonce     = errors.New("request nonce is not the next one")
+	ErrQuotaExceeded    = errors.New("quota of the user is exceeded")
+	ErrCallFailed       = errors.New("call of the request fails")
+)
+
+// Config tunes the relayer.
+type Config struct {
+	Targets  []common.Address // Contracts requests may call
+	Quota    int              // Requests per user and window, DefaultQuota if zero
+	GasQuota uint64           // Gas of the requests per user and window, unbounded if zero
+	Window   time.Duration    // DefaultWindow if zero
+	MaxGas   uint64           // Highest gas of a request, DefaultMaxGas if zero
+}
+
+// Usage is what a user relayed within the current window.
+type Usage struct {
+	Requests int    `json:"requests"`
+	Gas      uint64 `json:"gas"`
+}
+
+type relayed struct {
+	at  time.Time
+	gas uint64
+}
+
+// Relayer verifies forward requests and submits the valid ones within the
+// quota of their signer. Usage is kept in memory, a restart resets quotas.
+type Relayer struct {
+	forwarder *generated.Forwarder
+	address   common.Address
+	separator common.Hash
+	txs       *txmanager.Manager
+	targets   map[common.Address]bool
+	config    Config
+
+	mu    sync.Mutex
+	usage map[common.Address][]relayed
+}
+
+// New creates a relayer for the Forwarder contract at address, sending
+// transactions through txs.
+func New(backend bind.ContractBackend, address common.Address, txs *txmanager.Manager, config Config) (*Relayer, error) {
+	if len(config.Targets) == 0 {
+		return nil, errors.New("no target contracts")
+	}
+	if config.Quota == 0 {
+		config.Quota = DefaultQuota
+	}
+	if config.Window == 0 {
+		config.Window = DefaultWindow
+	}
+	if config.MaxGas == 0 {
+		config.MaxGas = DefaultMaxGas
+	}
+
+	forwarder, err := generated.NewForwarder(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	separator, err := forwarder.DomainSeparator(&bind.CallOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read domain separator: %w", err)
+	}
+	targets := make(map[common.Address]bool, len(config.Targets))
+	for _, target := range config.Targets {
+		targets[target] = true
+	}
+	return &Relayer{
+		forwarder: forwarder,
+		address:   address,
+		separator: separator,
+		txs:       txs,
+		targets:   targets,
+		config:    config,
+		usage:     make(map[common.Address][]relayed),
+	}, nil
+}
+
+// DomainSeparator returns the EIP-712 domain separator requests are signed
+// for.
+func (r *Relayer) DomainSeparator() common.Hash {
+	return r.separator
+}
+
+// Nonce returns the next nonce of from at the forwarder.
+func (r *Relayer) Nonce(ctx context.Context, from common.Address) (*big.Int, error) {
+	return r.forwarder.GetNonce(&bind.CallOpts{Context: ctx}, from)
+}
+
+// Usage returns what from relayed within the current window.
+func (r *Relayer) Usage(from common.Address) Usage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var usage Usage
+	for _, u := range r.prune(from) {
+		usage.Requests++
+		usage.Gas += u.gas
+	}
+	return usage
+}
+
+// Relay verifies a signed request and submits it. The request is checked
+// against the relay policy and the quota of its signer, and simulated so
+// that calls bound to fail are not paid for.
+func (r *Relayer) Relay(ctx context.Context, req Request, signature []byte) (*txmanager.Tx, error) {
+	if err := r.check(req, signature); err != nil {
+		return nil, err
+	}
+
+	// Requests are relayed one at a time, so that concurrent requests of a
+	// user can not overrun the quota.
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkQuota(req); err != nil {
+		return nil, err
+	}
+
+	callOpts := &bind.CallOpts{Context: ctx, From: r.txs.From()}
+	ok, err := r.forwarder.Verify(callOpts, req.forward(), signature)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrInvalidNonce
+	}
+	if err := r.simulate(callOpts, req, signature); err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("forward:%s:%s", req.From.Hex(), req.Nonce)
+	tx, err := r.txs.Send(ctx, key, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		opts.GasLimit = req.Gas + req.Gas/63 + executeOverhead
+		return r.forwarder.Execute(opts, req.forward(), signature)
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.usage[req.From] = append(r.usage[req.From], relayed{at: time.Now(), gas: req.Gas})
+	log.Info("Relayed forward request", "from", req.From, "to", req.To, "nonce", req.Nonce, "tx", tx.Hashes[0])
+	return tx, nil
+}
+
+// check applies the relay policy and verifies the signature locally, which
+// rejects most invalid requests without a call to the node.
+func (r *Relayer) check(req Request, signature []byte) error {
+	if req.Value == nil || req.Nonce == nil || req.Deadline == nil {
+		return ErrIncomplete
+	}
+	if !r.targets[req.To] {
+		return ErrTargetNotAllowed
+	}
+	if req.Value.Sign() != 0 {
+		return ErrValueNotAllowed
+	}
+	if req.Gas > r.config.MaxGas {
+		return ErrGasTooHigh
+	}
+	if req.Deadline.Cmp(big.NewInt(time.Now().Unix())) < 0 {
+		return ErrExpired
+	}
+
+	sig, err := eip712.ParseSignature(signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	signer, err := eip712.Recover(eip712.Digest(r.separator, req.Hash()), sig)
+	if err != nil || signer != req.From {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func (r *Relayer) checkQuota(req Request) error {
+	var gas uint64
+	used := r.prune(req.From)
+	for _, u := range used {
+		gas += u.gas
+	}
+	if len(used) >= r.config.Quota {
+		return fmt.Errorf("%d requests within %s: %w", len(used), r.config.Window, ErrQuotaExceeded)
+	}
+	if r.config.GasQuota != 0 && gas+req.Gas > r.config.GasQuota {
+		return fmt.Errorf("%d gas within %s: %w", gas, r.config.Window, ErrQuotaExceeded)
+	}
+	return nil
+}
+
+// prune drops the requests of from that left the window and returns the
+// remaining ones.
+func (r *Relayer) prune(from common.Address) []relayed {
+	since := time.Now().Add(-r.config.Window)
+	used := r.usage[from]
+	i := 0
+	for i < len(used) && used[i].at.Before(since) {
+		i++
+	}
+	used = used[i:]
+	if len(used) == 0 {
+		delete(r.usage, from)
+		return nil
+	}
+	r.usage[from] = used
+	return used
+}
+
+// simulate executes the request with eth_call, as the forwarder does not
+// revert when the forwarded call fails.
+func (r *Relayer) simulate(opts *bind.CallOpts, req Request, signature []byte) error {
+	raw := &generated.ForwarderCallerRaw{Contract: &r.forwarder.ForwarderCaller}
+	var out []interface{}
+	if err := raw.Call(opts, &out, "execute", req.forward(), signature); err != nil {
+		return fmt.Errorf("%w: %v", ErrCallFailed, err)
+	}
+	if len(out) != 2 {
+		return fmt.Errorf("unexpected result of execute: %v", out)
+	}
+	if success, _ := out[0].(bool); !success {
+		result, _ := out[1].([]byte)
+		return fmt.Errorf("%w: %s", ErrCallFailed, revertReason(result))
+	}
+	return nil
+}
+
+// revertReason decodes an Error(string) revert, or returns the raw data.
+func revertReason(result []byte) string {
+	reason, err := abi.UnpackRevert(result)
+	if err != nil {
+		return hexutil.Encode(result)
+	}
+	return reason
+}
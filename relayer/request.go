@@ -0,0 +1,79 @@
+package relayer
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/one-click-platform/system-contracts/eip712"
+	"github.com/one-click-platform/system-contracts/generated"
+	"github.com/one-click-platform/system-contracts/signer"
+)
+
+// ForwardRequestType is the EIP-712 encoded type of a forward request.
+const ForwardRequestType = "ForwardRequest(address from,address to,uint256 value,uint256 gas,uint256 nonce,uint256 deadline,bytes data)"
+
+// Request is a call signed by From that the Forwarder contract executes on
+// its behalf, with From appended to the calldata for ERC-2771 recipients.
+type Request struct {
+	From     common.Address
+	To       common.Address
+	Value    *big.Int
+	Gas      uint64
+	Nonce    *big.Int
+	Deadline *big.Int
+	Data     []byte
+}
+
+// Hash returns the EIP-712 struct hash of the request.
+func (r Request) Hash() common.Hash {
+	return eip712.HashStruct(eip712.TypeHash(ForwardRequestType), r.From, r.To, r.Value, r.Gas, r.Nonce, r.Deadline, crypto.Keccak256Hash(r.Data))
+}
+
+// forward converts the request to the struct of the Forwarder binding.
+func (r Request) forward() generated.ForwarderForwardRequest {
+	return generated.ForwarderForwardRequest{
+		From:     r.From,
+		To:       r.To,
+		Value:    r.Value,
+		Gas:      new(big.Int).SetUint64(r.Gas),
+		Nonce:    r.Nonce,
+		Deadline: r.Deadline,
+		Data:     r.Data,
+	}
+}
+
+// NewRequest returns a request of from calling to with data, using the next
+// nonce of from at the forwarder.
+func NewRequest(forwarder *generated.ForwarderCaller, opts *bind.CallOpts, from, to common.Address, data []byte, gas uint64, deadline *big.Int) (Request, error) {
+	nonce, err := forwarder.GetNonce(opts, from)
+	if err != nil {
+		return Request{}, err
+	}
+	return Request{
+		From:     from,
+		To:       to,
+		Value:    new(big.Int),
+		Gas:      gas,
+		Nonce:    nonce,
+		Deadline: deadline,
+		Data:     data,
+	}, nil
+}
+
+// Sign signs the request for the forwarder with the given domain separator.
+// The signer must be the account of r.From.
+func Sign(ctx context.Context, s signer.Signer, domainSeparator common.Hash, r Request) ([]byte, error) {
+	sig, err := s.SignHash(ctx, eip712.Digest(domainSeparator, r.Hash()))
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := eip712.ParseSignature(sig)
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Bytes(), nil
+}
@@ -0,0 +1,86 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TokenEnv is the environment variable holding the bearer token of the
+// remote signer.
+const TokenEnv = "SIGNER_TOKEN"
+
+// Config selects one of the signers.
+type Config struct {
+	Keystore     string // Keystore directory or single key file
+	Account      string // Account of a keystore directory holding several keys
+	PasswordFile string // File holding the keystore password
+	URL          string // Endpoint of a remote signer
+	Token        string // Bearer token of the remote signer
+	Key          string // Hex encoded private key
+}
+
+// Flags registers the signer flags of a command on fs. The token of the
+// remote signer is read from TokenEnv and the hex key from keyEnv, which
+// keeps both out of process listings.
+func Flags(fs *flag.FlagSet, keyEnv string) *Config {
+	config := &Config{
+		Token: os.Getenv(TokenEnv),
+		Key:   os.Getenv(keyEnv),
+	}
+	fs.StringVar(&config.Keystore, "keystore", "", "keystore directory or key file to sign with")
+	fs.StringVar(&config.Account, "account", "", "account to use from the keystore, optional if it holds one key")
+	fs.StringVar(&config.PasswordFile, "password-file", "", "file holding the keystore password")
+	fs.StringVar(&config.URL, "signer", "", "URL of a remote signer to sign with, its token is read from "+TokenEnv)
+	return config
+}
+
+// Open opens the configured signer. The keystore and the remote signer take
+// precedence over the hex key.
+func Open(ctx context.Context, config *Config) (Signer, error) {
+	switch {
+	case config.Keystore != "" && config.URL != "":
+		return nil, errors.New("both a keystore and a remote signer are configured")
+	case config.Keystore != "":
+		password, err := readPassword(config.PasswordFile)
+		if err != nil {
+			return nil, err
+		}
+		info, err := os.Stat(config.Keystore)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			return OpenKeyFile(config.Keystore, password)
+		}
+		var account common.Address
+		if config.Account != "" {
+			if !common.IsHexAddress(config.Account) {
+				return nil, errors.New("invalid account address " + config.Account)
+			}
+			account = common.HexToAddress(config.Account)
+		}
+		return OpenKeystore(config.Keystore, account, password)
+	case config.URL != "":
+		return NewRemote(ctx, config.URL, config.Token, nil)
+	case config.Key != "":
+		return HexKey(config.Key)
+	}
+	return nil, errors.New("no signer configured")
+}
+
+func readPassword(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
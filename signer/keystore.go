@@ -0,0 +1,73 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Keystore is a signer for an account of an encrypted geth keystore
+// directory. The account is unlocked when the signer is opened.
+type Keystore struct {
+	ks      *keystore.KeyStore
+	account accounts.Account
+}
+
+// OpenKeystore unlocks account in the keystore directory dir with password.
+// The zero address selects the only account of the directory.
+func OpenKeystore(dir string, account common.Address, password string) (*Keystore, error) {
+	ks := keystore.NewKeyStore(dir, keystore.StandardScryptN, keystore.StandardScryptP)
+
+	var a accounts.Account
+	if account == (common.Address{}) {
+		all := ks.Accounts()
+		if len(all) != 1 {
+			return nil, fmt.Errorf("keystore %s holds %d accounts, select one", dir, len(all))
+		}
+		a = all[0]
+	} else {
+		found, err := ks.Find(accounts.Account{Address: account})
+		if err != nil {
+			return nil, fmt.Errorf("account %s: %w", account.Hex(), err)
+		}
+		a = found
+	}
+	if err := ks.Unlock(a, password); err != nil {
+		return nil, fmt.Errorf("failed to unlock %s: %w", a.Address.Hex(), err)
+	}
+	return &Keystore{ks: ks, account: a}, nil
+}
+
+// OpenKeyFile decrypts a single geth key file with password.
+func OpenKeyFile(path, password string) (*Key, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keystore.DecryptKey(data, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt %s: %w", path, err)
+	}
+	return NewKey(key.PrivateKey), nil
+}
+
+// Address returns the unlocked account.
+func (k *Keystore) Address() common.Address {
+	return k.account.Address
+}
+
+// SignTx signs tx with the unlocked account.
+func (k *Keystore) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return k.ks.SignTx(k.account, tx, chainID)
+}
+
+// SignHash signs hash with the unlocked account.
+func (k *Keystore) SignHash(ctx context.Context, hash common.Hash) ([]byte, error) {
+	return k.ks.SignHash(k.account, hash.Bytes())
+}
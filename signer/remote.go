@@ -0,0 +1,159 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// The remote signer protocol is JSON over HTTP, authenticated with an
+// optional bearer token:
+//
+//	GET  /address    -> {"address": "0x..."}
+//	POST /sign/tx    {"chainId": "0x1", "tx": "0x<unsigned tx>"} -> {"tx": "0x<signed tx>"}
+//	POST /sign/hash  {"hash": "0x..."} -> {"signature": "0x<r || s || v>"}
+//
+// Transactions are in their binary encoding. Errors are reported with a
+// non-200 status and a plain text message.
+type (
+	addressResponse struct {
+		Address common.Address `json:"address"`
+	}
+	signTxRequest struct {
+		ChainID *hexutil.Big  `json:"chainId"`
+		Tx      hexutil.Bytes `json:"tx"`
+	}
+	signTxResponse struct {
+		Tx hexutil.Bytes `json:"tx"`
+	}
+	signHashRequest struct {
+		Hash common.Hash `json:"hash"`
+	}
+	signHashResponse struct {
+		Signature hexutil.Bytes `json:"signature"`
+	}
+)
+
+// maxResponseSize bounds the responses read from a remote signer.
+const maxResponseSize = 1 << 20
+
+// Remote is a signer delegating to a remote signer over HTTP. Signed
+// transactions are checked to be the requested ones, signed by the account
+// the remote signer announced.
+type Remote struct {
+	url     string
+	token   string
+	client  *http.Client
+	address common.Address
+}
+
+// NewRemote connects to the remote signer at url and reads its account.
+// A nil client falls back to http.DefaultClient.
+func NewRemote(ctx context.Context, url, token string, client *http.Client) (*Remote, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	r := &Remote{url: strings.TrimSuffix(url, "/"), token: token, client: client}
+
+	var resp addressResponse
+	if err := r.call(ctx, http.MethodGet, "/address", nil, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Address == (common.Address{}) {
+		return nil, errors.New("remote signer announced no account")
+	}
+	r.address = resp.Address
+	return r, nil
+}
+
+// Address returns the account of the remote signer.
+func (r *Remote) Address() common.Address {
+	return r.address
+}
+
+// SignTx has the remote signer sign tx.
+func (r *Remote) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	unsigned, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	var resp signTxResponse
+	if err := r.call(ctx, http.MethodPost, "/sign/tx", signTxRequest{ChainID: (*hexutil.Big)(chainID), Tx: unsigned}, &resp); err != nil {
+		return nil, err
+	}
+
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(resp.Tx); err != nil {
+		return nil, fmt.Errorf("invalid signed transaction: %w", err)
+	}
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signed) != signer.Hash(tx) {
+		return nil, errors.New("remote signer signed a different transaction")
+	}
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, err
+	}
+	if sender != r.address {
+		return nil, fmt.Errorf("remote signer signed with %s instead of %s", sender.Hex(), r.address.Hex())
+	}
+	return signed, nil
+}
+
+// SignHash has the remote signer sign hash.
+func (r *Remote) SignHash(ctx context.Context, hash common.Hash) ([]byte, error) {
+	var resp signHashResponse
+	if err := r.call(ctx, http.MethodPost, "/sign/hash", signHashRequest{Hash: hash}, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Signature) != 65 {
+		return nil, errors.New("signature must be 65 bytes long")
+	}
+	return resp.Signature, nil
+}
+
+func (r *Remote) call(ctx context.Context, method, path string, body, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, r.url+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if r.token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("remote signer: %s: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+	return json.Unmarshal(data, result)
+}
@@ -0,0 +1,125 @@
+package signer
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// maxRequestSize bounds the requests read by the server.
+const maxRequestSize = 1 << 20
+
+// Server serves the remote signer protocol for a local signer, standing in
+// for a dedicated signing service during development and tests.
+type Server struct {
+	signer   Signer
+	token    string
+	insecure bool
+	chainID  *big.Int
+}
+
+// NewServer creates a server signing with s. Requests must carry token as a
+// bearer token; with an empty token every request is refused, as /sign/hash
+// signs any hash it is given. Transactions are only signed for chainID
+// unless it is nil.
+func NewServer(s Signer, token string, chainID *big.Int) *Server {
+	return &Server{signer: s, token: token, chainID: chainID}
+}
+
+// NewInsecureServer creates a server signing with s for anyone who can reach
+// it, meant for local development only.
+func NewInsecureServer(s Signer, chainID *big.Int) *Server {
+	return &Server{signer: s, insecure: true, chainID: chainID}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.insecure && (s.token == "" || subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+s.token)) != 1) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/address":
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		s.respond(w, addressResponse{Address: s.signer.Address()})
+	case "/sign/tx":
+		var req signTxRequest
+		if !s.decode(w, r, &req) {
+			return
+		}
+		s.signTx(w, r, req)
+	case "/sign/hash":
+		var req signHashRequest
+		if !s.decode(w, r, &req) {
+			return
+		}
+		sig, err := s.signer.SignHash(r.Context(), req.Hash)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		log.Info("Signed hash", "hash", req.Hash)
+		s.respond(w, signHashResponse{Signature: sig})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) signTx(w http.ResponseWriter, r *http.Request, req signTxRequest) {
+	if req.ChainID == nil {
+		http.Error(w, "missing chain id", http.StatusBadRequest)
+		return
+	}
+	chainID := req.ChainID.ToInt()
+	if s.chainID != nil && s.chainID.Cmp(chainID) != 0 {
+		http.Error(w, fmt.Sprintf("chain %s is not allowed", chainID), http.StatusForbidden)
+		return
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(req.Tx); err != nil {
+		http.Error(w, "invalid transaction: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	signed, err := s.signer.SignTx(r.Context(), tx, chainID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	raw, err := signed.MarshalBinary()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	log.Info("Signed transaction", "chainId", chainID, "nonce", tx.Nonce(), "to", tx.To(), "value", tx.Value(), "tx", signed.Hash())
+	s.respond(w, signTxResponse{Tx: raw})
+}
+
+// decode reads the JSON body of a POST request, replying with an error and
+// returning false if it is not one.
+func (s *Server) decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return false
+	}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(v); err != nil {
+		http.Error(w, "invalid request: "+err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func (s *Server) respond(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
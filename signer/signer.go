@@ -0,0 +1,82 @@
+// Package signer abstracts where the keys of the tooling live: in memory,
+// in an encrypted geth keystore or behind a remote signer reached over
+// HTTP. Every signer produces the bind.TransactOpts used by the bindings.
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signer signs on behalf of a single account.
+type Signer interface {
+	// Address returns the account of the signer.
+	Address() common.Address
+	// SignTx returns tx signed for the chain chainID.
+	SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	// SignHash signs a 32 byte digest and returns the 65 byte r || s || v
+	// signature with v as 0 or 1, the format of crypto.Sign.
+	SignHash(ctx context.Context, hash common.Hash) ([]byte, error)
+}
+
+// TransactOpts returns options that sign the transactions of the bindings
+// with s for the chain chainID. ctx bounds the signing requests, it is not
+// set as the context of the options.
+func TransactOpts(ctx context.Context, s Signer, chainID *big.Int) *bind.TransactOpts {
+	from := s.Address()
+	return &bind.TransactOpts{
+		From: from,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != from {
+				return nil, bind.ErrNotAuthorized
+			}
+			return s.SignTx(ctx, tx, chainID)
+		},
+	}
+}
+
+// Key is a signer holding a private key in memory, meant for tests and
+// short-lived tools.
+type Key struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+// NewKey creates a signer for key.
+func NewKey(key *ecdsa.PrivateKey) *Key {
+	return &Key{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+// HexKey creates a signer for a hex encoded private key.
+func HexKey(hexKey string) (*Key, error) {
+	if hexKey == "" {
+		return nil, errors.New("empty private key")
+	}
+	key, err := crypto.HexToECDSA(hexKey)
+	if err != nil {
+		return nil, err
+	}
+	return NewKey(key), nil
+}
+
+// Address returns the account of the key.
+func (k *Key) Address() common.Address {
+	return k.address
+}
+
+// SignTx signs tx with the key.
+func (k *Key) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), k.key)
+}
+
+// SignHash signs hash with the key.
+func (k *Key) SignHash(ctx context.Context, hash common.Hash) ([]byte, error) {
+	return crypto.Sign(hash.Bytes(), k.key)
+}
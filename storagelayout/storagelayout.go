@@ -0,0 +1,306 @@
+// Package storagelayout checks that a new version of a contract keeps the
+// storage layout of the deployed one, which an upgrade behind a proxy
+// requires. It compares the storage layouts solc emits with
+// --storage-layout, by structure rather than by AST ids, so that layouts of
+// separate compilations can be compared.
+package storagelayout
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Layout is the storage layout of a contract as emitted by solc.
+type Layout struct {
+	Storage []Variable       `json:"storage"`
+	Types   map[string]*Type `json:"types"`
+}
+
+// Variable is a state variable or a struct member.
+type Variable struct {
+	Contract string `json:"contract,omitempty"`
+	Label    string `json:"label"`
+	Offset   uint64 `json:"offset"`
+	Slot     string `json:"slot"`
+	Type     string `json:"type"`
+}
+
+// Type describes how a type is stored.
+type Type struct {
+	Encoding      string     `json:"encoding"`
+	Label         string     `json:"label"`
+	NumberOfBytes string     `json:"numberOfBytes"`
+	Key           string     `json:"key,omitempty"`
+	Value         string     `json:"value,omitempty"`
+	Base          string     `json:"base,omitempty"`
+	Members       []Variable `json:"members,omitempty"`
+}
+
+// Load reads a layout from a file holding either a storage layout or the
+// standard JSON output of solc, from which the layout of contract is taken.
+func Load(path, contract string) (*Layout, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data, contract)
+}
+
+// Parse decodes a storage layout, see Load.
+func Parse(data []byte, contract string) (*Layout, error) {
+	var output struct {
+		Contracts map[string]map[string]struct {
+			StorageLayout *Layout `json:"storageLayout"`
+		} `json:"contracts"`
+		*Layout
+	}
+	output.Layout = new(Layout)
+	if err := json.Unmarshal(data, &output); err != nil {
+		return nil, err
+	}
+	if output.Contracts == nil {
+		if output.Storage == nil {
+			return nil, errors.New("no storage layout found")
+		}
+		return output.Layout, nil
+	}
+
+	var found *Layout
+	for _, contracts := range output.Contracts {
+		for name, c := range contracts {
+			if name != contract || c.StorageLayout == nil {
+				continue
+			}
+			if found != nil {
+				return nil, fmt.Errorf("contract %s is defined in several files", contract)
+			}
+			found = c.StorageLayout
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no storage layout of contract %s found", contract)
+	}
+	return found, nil
+}
+
+// Severity ranks an issue.
+type Severity int
+
+const (
+	// Warning marks a change that keeps the data readable but may be a
+	// mistake, such as a renamed variable.
+	Warning Severity = iota
+	// Error marks a change that corrupts the existing data.
+	Error
+)
+
+func (s Severity) String() string {
+	if s == Error {
+		return "error"
+	}
+	return "warning"
+}
+
+// Issue is an incompatibility between two layouts.
+type Issue struct {
+	Severity Severity
+	Variable string // Path of the variable, e.g. auctions.value.highestBid
+	Message  string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s: %s", i.Severity, i.Variable, i.Message)
+}
+
+// HasErrors reports whether any issue is an error.
+func HasErrors(issues []Issue) bool {
+	for _, issue := range issues {
+		if issue.Severity == Error {
+			return true
+		}
+	}
+	return false
+}
+
+// Compare checks that next keeps the layout of prev: every variable of prev
+// must keep its slot, offset and type, and new variables must not overlap
+// them. Struct members may only be appended to structs held by mappings.
+func Compare(prev, next *Layout) []Issue {
+	c := &comparison{prev: prev, next: next}
+
+	type position struct {
+		slot   string
+		offset uint64
+	}
+	byPosition := make(map[position]Variable, len(next.Storage))
+	byLabel := make(map[string]Variable, len(next.Storage))
+	for _, v := range next.Storage {
+		byPosition[position{v.Slot, v.Offset}] = v
+		byLabel[v.Label] = v
+	}
+
+	matched := make(map[position]bool)
+	for _, p := range prev.Storage {
+		n, ok := byPosition[position{p.Slot, p.Offset}]
+		if !ok {
+			if moved, ok := byLabel[p.Label]; ok {
+				c.errorf(p.Label, "moved from slot %s offset %d to slot %s offset %d", p.Slot, p.Offset, moved.Slot, moved.Offset)
+			} else {
+				c.errorf(p.Label, "removed from slot %s offset %d", p.Slot, p.Offset)
+			}
+			continue
+		}
+		matched[position{n.Slot, n.Offset}] = true
+		if n.Label != p.Label {
+			c.warnf(p.Label, "renamed to %s", n.Label)
+		}
+		c.compareTypes(p.Label, p.Type, n.Type, false)
+	}
+
+	for _, n := range next.Storage {
+		if matched[position{n.Slot, n.Offset}] {
+			continue
+		}
+		start, end, err := c.bounds(c.next, n)
+		if err != nil {
+			c.errorf(n.Label, "%v", err)
+			continue
+		}
+		for _, p := range prev.Storage {
+			pStart, pEnd, err := c.bounds(c.prev, p)
+			if err != nil {
+				c.errorf(p.Label, "%v", err)
+				continue
+			}
+			if start < pEnd && pStart < end {
+				c.errorf(n.Label, "added over the storage of %s", p.Label)
+			}
+		}
+	}
+
+	sort.SliceStable(c.issues, func(a, b int) bool { return c.issues[a].Severity > c.issues[b].Severity })
+	return c.issues
+}
+
+type comparison struct {
+	prev, next *Layout
+	issues     []Issue
+}
+
+func (c *comparison) errorf(variable, format string, args ...interface{}) {
+	c.issues = append(c.issues, Issue{Severity: Error, Variable: variable, Message: fmt.Sprintf(format, args...)})
+}
+
+func (c *comparison) warnf(variable, format string, args ...interface{}) {
+	c.issues = append(c.issues, Issue{Severity: Warning, Variable: variable, Message: fmt.Sprintf(format, args...)})
+}
+
+// compareTypes compares the types of a variable in both layouts. growable
+// allows appending struct members, which is safe for the values of
+// mappings only.
+func (c *comparison) compareTypes(path, prevID, nextID string, growable bool) {
+	p, n := c.prev.Types[prevID], c.next.Types[nextID]
+	if p == nil || n == nil {
+		c.errorf(path, "type %s or %s is missing from the layout", prevID, nextID)
+		return
+	}
+	if p.Encoding != n.Encoding {
+		c.errorf(path, "encoding changed from %s to %s", p.Encoding, n.Encoding)
+		return
+	}
+
+	switch p.Encoding {
+	case "mapping":
+		if c.label(c.prev, p.Key) != c.label(c.next, n.Key) {
+			c.errorf(path, "key type changed from %s to %s", c.label(c.prev, p.Key), c.label(c.next, n.Key))
+		}
+		c.compareTypes(path+".value", p.Value, n.Value, true)
+	case "dynamic_array":
+		c.compareTypes(path+"[]", p.Base, n.Base, false)
+	case "bytes":
+		if p.Label != n.Label {
+			c.warnf(path, "type changed from %s to %s", p.Label, n.Label)
+		}
+	default:
+		switch {
+		case p.Members != nil || n.Members != nil:
+			c.compareMembers(path, p, n, growable)
+		case p.Base != "":
+			if n.Base == "" {
+				c.errorf(path, "type changed from %s to %s", p.Label, n.Label)
+				return
+			}
+			c.compareTypes(path+"[]", p.Base, n.Base, false)
+			if p.NumberOfBytes != n.NumberOfBytes {
+				c.errorf(path, "length changed from %s to %s", p.Label, n.Label)
+			}
+		case p.Label != n.Label:
+			if isAddress(p.Label) && isAddress(n.Label) {
+				c.warnf(path, "type changed from %s to %s", p.Label, n.Label)
+			} else {
+				c.errorf(path, "type changed from %s to %s", p.Label, n.Label)
+			}
+		case p.NumberOfBytes != n.NumberOfBytes:
+			c.errorf(path, "size changed from %s to %s bytes", p.NumberOfBytes, n.NumberOfBytes)
+		}
+	}
+}
+
+func (c *comparison) compareMembers(path string, p, n *Type, growable bool) {
+	if p.Members == nil || n.Members == nil {
+		c.errorf(path, "type changed from %s to %s", p.Label, n.Label)
+		return
+	}
+	for i, pm := range p.Members {
+		if i >= len(n.Members) {
+			c.errorf(path+"."+pm.Label, "member removed")
+			continue
+		}
+		nm := n.Members[i]
+		if pm.Slot != nm.Slot || pm.Offset != nm.Offset {
+			c.errorf(path+"."+pm.Label, "moved from slot %s offset %d to slot %s offset %d of the struct", pm.Slot, pm.Offset, nm.Slot, nm.Offset)
+			continue
+		}
+		if pm.Label != nm.Label {
+			c.warnf(path+"."+pm.Label, "renamed to %s", nm.Label)
+		}
+		c.compareTypes(path+"."+pm.Label, pm.Type, nm.Type, false)
+	}
+	if p.NumberOfBytes != n.NumberOfBytes && !growable {
+		c.errorf(path, "struct size changed from %s to %s bytes, which moves the storage behind it", p.NumberOfBytes, n.NumberOfBytes)
+	}
+}
+
+// bounds returns the byte range a top-level variable occupies.
+func (c *comparison) bounds(l *Layout, v Variable) (uint64, uint64, error) {
+	slot, err := strconv.ParseUint(v.Slot, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid slot %s", v.Slot)
+	}
+	t := l.Types[v.Type]
+	if t == nil {
+		return 0, 0, fmt.Errorf("type %s is missing from the layout", v.Type)
+	}
+	size, err := strconv.ParseUint(t.NumberOfBytes, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid size %s of %s", t.NumberOfBytes, t.Label)
+	}
+	start := slot*32 + v.Offset
+	return start, start + size, nil
+}
+
+func (c *comparison) label(l *Layout, id string) string {
+	if t := l.Types[id]; t != nil {
+		return t.Label
+	}
+	return id
+}
+
+func isAddress(label string) bool {
+	return label == "address" || label == "address payable" || strings.HasPrefix(label, "contract ")
+}
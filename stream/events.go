@@ -0,0 +1,199 @@
+// Package stream fans auction, WERC721 and WETH events out to WebSocket and
+// server-sent events clients, with per-auction topics and cursor replay.
+package stream
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/one-click-platform/system-contracts/events"
+	"github.com/one-click-platform/system-contracts/generated"
+)
+
+// Event types.
+const (
+	TypeAuctionCreated       = "AuctionCreated"
+	TypeAuctionBid           = "AuctionBid"
+	TypeLotTransferred       = "LotTransferred"
+	TypeRepaymentTransferred = "RepaymentTransferred"
+	TypeTokenTransfer        = "TokenTransfer"
+	TypeCurrencyTransfer     = "CurrencyTransfer"
+)
+
+// Topics events are published on. Per-item topics are the plural topic
+// followed by a slash and the auction or token ID, e.g. auctions/42.
+const (
+	TopicAuctions = "auctions"
+	TopicTokens   = "tokens"
+	TopicWETH     = "weth"
+)
+
+// Cursor is the position of an event in the chain. Cursors are ordered and
+// stay valid across restarts of the service.
+type Cursor struct {
+	Block uint64
+	Index uint
+}
+
+// ParseCursor parses the <block>-<logIndex> form produced by Cursor.String.
+func ParseCursor(s string) (Cursor, error) {
+	parts := strings.SplitN(s, "-", 2)
+	if len(parts) != 2 {
+		return Cursor{}, fmt.Errorf("invalid cursor %q", s)
+	}
+	block, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("invalid cursor %q", s)
+	}
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("invalid cursor %q", s)
+	}
+	return Cursor{Block: block, Index: uint(index)}, nil
+}
+
+func (c Cursor) String() string {
+	return fmt.Sprintf("%d-%d", c.Block, c.Index)
+}
+
+// Less reports whether c is before other.
+func (c Cursor) Less(other Cursor) bool {
+	if c.Block != other.Block {
+		return c.Block < other.Block
+	}
+	return c.Index < other.Index
+}
+
+// prev returns the closest cursor before c.
+func (c Cursor) prev() Cursor {
+	if c.Index == 0 {
+		if c.Block == 0 {
+			return c
+		}
+		return Cursor{Block: c.Block - 1, Index: math.MaxUint32}
+	}
+	return Cursor{Block: c.Block, Index: c.Index - 1}
+}
+
+// Event is a decoded contract event as sent to clients. Amounts and IDs are
+// decimal strings, so that JavaScript clients do not lose precision.
+type Event struct {
+	Cursor  Cursor            `json:"-"`
+	ID      string            `json:"id"`
+	Type    string            `json:"type"`
+	Block   uint64            `json:"block"`
+	TxHash  common.Hash       `json:"txHash"`
+	Removed bool              `json:"removed,omitempty"` // Set when a reorg dropped the event
+	Data    map[string]string `json:"data"`
+
+	topics []string
+}
+
+// matches reports whether the event is published on any of topics.
+func (e *Event) matches(topics map[string]bool) bool {
+	if len(topics) == 0 {
+		return true
+	}
+	for _, topic := range e.topics {
+		if topics[topic] {
+			return true
+		}
+	}
+	return false
+}
+
+// decoder turns logs of the streamed contracts into events.
+type decoder struct {
+	events *events.Decoder
+}
+
+func newDecoder(auction, werc721, weth common.Address) (*decoder, error) {
+	d, err := events.NewDecoder(events.Addresses{Auction: auction, WERC721: werc721, WETH: weth})
+	if err != nil {
+		return nil, err
+	}
+	return &decoder{events: d}, nil
+}
+
+// query returns the filter matching the streamed events. It also matches
+// the events that are not streamed, which decode then skips.
+func (d *decoder) query() ethereum.FilterQuery {
+	return d.events.Query()
+}
+
+func (d *decoder) decode(log types.Log) (*Event, error) {
+	decoded, err := d.events.Decode(log)
+	if err != nil {
+		return nil, err
+	}
+	ev := &Event{
+		Cursor:  Cursor{Block: log.BlockNumber, Index: log.Index},
+		Block:   log.BlockNumber,
+		TxHash:  log.TxHash,
+		Removed: log.Removed,
+	}
+	ev.ID = ev.Cursor.String()
+
+	var auctionId *big.Int
+	switch v := decoded.Value.(type) {
+	case *generated.AuctionAuctionCreated:
+		auctionId = v.AuctionId
+		ev.Type = TypeAuctionCreated
+		ev.Data = map[string]string{
+			"creator":         v.Creator.Hex(),
+			"tokenAddress":    v.TokenAddress.Hex(),
+			"tokenId":         v.TokenId.String(),
+			"currencyAddress": v.CurrencyAddress.Hex(),
+		}
+	case *generated.AuctionAuctionBid:
+		auctionId = v.AuctionId
+		ev.Type = TypeAuctionBid
+		ev.Data = map[string]string{
+			"bidder": v.Bidder.Hex(),
+			"amount": v.Amount.String(),
+		}
+	case *generated.AuctionLotTransferred:
+		auctionId = v.AuctionId
+		ev.Type = TypeLotTransferred
+		ev.Data = map[string]string{
+			"winner": v.Winner.Hex(),
+		}
+	case *generated.AuctionRepaymentTransferred:
+		auctionId = v.AuctionId
+		ev.Type = TypeRepaymentTransferred
+		ev.Data = map[string]string{
+			"creator": v.Creator.Hex(),
+		}
+	case *generated.WERC721Transfer:
+		ev.Type = TypeTokenTransfer
+		ev.Data = map[string]string{
+			"from":    v.From.Hex(),
+			"to":      v.To.Hex(),
+			"tokenId": v.TokenId.String(),
+		}
+		ev.topics = []string{TopicTokens, TopicTokens + "/" + v.TokenId.String()}
+		return ev, nil
+	case *generated.WETHTransfer:
+		ev.Type = TypeCurrencyTransfer
+		ev.Data = map[string]string{
+			"from":  v.From.Hex(),
+			"to":    v.To.Hex(),
+			"value": v.Value.String(),
+		}
+		ev.topics = []string{TopicWETH}
+		return ev, nil
+	default:
+		return nil, events.ErrUnknownEvent
+	}
+
+	ev.Data["auctionId"] = auctionId.String()
+	ev.topics = []string{TopicAuctions, TopicAuctions + "/" + auctionId.String()}
+	return ev, nil
+}
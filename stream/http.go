@@ -0,0 +1,186 @@
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/gorilla/websocket"
+)
+
+// DefaultPingInterval is the time between two keep-alives sent to idle
+// clients.
+const DefaultPingInterval = 30 * time.Second
+
+// resetEvent tells a client that events after its cursor are no longer in
+// the history, so it should reload the state it derives from them.
+const resetEvent = "reset"
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin:     func(*http.Request) bool { return true },
+}
+
+// Handler serves the hub to clients:
+//
+//	GET /events?topics=auctions/1,weth&cursor=<id>  server-sent events
+//	GET /ws?topics=auctions/1,weth&cursor=<id>      WebSocket
+//
+// Without topics every event is sent. The cursor is the id of the last
+// event received; SSE clients reconnecting with Last-Event-ID need not pass
+// it.
+type Handler struct {
+	hub *Hub
+	mux *http.ServeMux
+}
+
+// NewHandler creates the HTTP handler of hub.
+func NewHandler(hub *Hub) *Handler {
+	h := &Handler{hub: hub, mux: http.NewServeMux()}
+	h.mux.HandleFunc("/events", h.serveSSE)
+	h.mux.HandleFunc("/ws", h.serveWebSocket)
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) subscribe(r *http.Request) (*Subscription, bool, error) {
+	var topics []string
+	if raw := r.URL.Query().Get("topics"); raw != "" {
+		topics = strings.Split(raw, ",")
+	}
+
+	raw := r.URL.Query().Get("cursor")
+	if raw == "" {
+		raw = r.Header.Get("Last-Event-ID")
+	}
+	var from *Cursor
+	if raw != "" {
+		cursor, err := ParseCursor(raw)
+		if err != nil {
+			return nil, false, err
+		}
+		from = &cursor
+	}
+
+	sub, complete := h.hub.Subscribe(topics, from)
+	return sub, complete, nil
+}
+
+func (h *Handler) serveSSE(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	sub, complete, err := h.subscribe(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer sub.Unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	if !complete {
+		fmt.Fprintf(w, "event: %s\ndata: {}\n\n", resetEvent)
+	}
+	flusher.Flush()
+
+	ping := time.NewTicker(DefaultPingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ping.C:
+			fmt.Fprint(w, ": ping\n\n")
+		case ev, ok := <-sub.Events:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(ev)
+			if err != nil {
+				log.Error("Failed to encode event", "id", ev.ID, "err", err)
+				continue
+			}
+			fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", ev.ID, ev.Type, data)
+		}
+		flusher.Flush()
+	}
+}
+
+// message is the envelope of WebSocket messages.
+type message struct {
+	Type  string `json:"type"` // "event" or "reset"
+	Event *Event `json:"event,omitempty"`
+}
+
+func (h *Handler) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	sub, complete, err := h.subscribe(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer sub.Unsubscribe()
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	// Reading is only needed to process control frames and notice closes.
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	if !complete {
+		if err := conn.WriteJSON(message{Type: resetEvent}); err != nil {
+			return
+		}
+	}
+
+	ping := time.NewTicker(DefaultPingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case <-ping.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(DefaultPingInterval)); err != nil {
+				return
+			}
+		case ev, ok := <-sub.Events:
+			if !ok {
+				conn.WriteControl(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "client too slow"),
+					time.Now().Add(time.Second))
+				return
+			}
+			if err := conn.WriteJSON(message{Type: "event", Event: ev}); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package stream
+
+import "sync"
+
+const (
+	// DefaultHistory is the number of recent events kept for replay.
+	DefaultHistory = 10000
+	// DefaultBuffer is the number of events a client may lag behind before
+	// it is disconnected.
+	DefaultBuffer = 256
+)
+
+// Hub fans events out to subscribers and keeps a bounded history of recent
+// events to replay from a cursor.
+type Hub struct {
+	mu      sync.Mutex
+	history []*Event
+	limit   int
+	start   *Cursor // First cursor the hub has seen the events from, nil until known
+	last    *Cursor // Cursor of the last published event, nil before the first
+	evicted *Cursor // Cursor of the last event dropped from the history
+	subs    map[*Subscription]struct{}
+}
+
+// Subscription delivers the events of a set of topics. Events is closed when
+// the subscriber fell too far behind or was unsubscribed; the client should
+// then reconnect with the cursor of the last event it received.
+type Subscription struct {
+	Events <-chan *Event
+
+	events chan *Event
+	topics map[string]bool
+	hub    *Hub
+}
+
+// NewHub creates a hub keeping history events for replay, DefaultHistory if
+// zero.
+func NewHub(history int) *Hub {
+	if history == 0 {
+		history = DefaultHistory
+	}
+	return &Hub{
+		limit: history,
+		subs:  make(map[*Subscription]struct{}),
+	}
+}
+
+// StartAt tells the hub that it gets every event from block on, e.g. the
+// first block of its source. Without it, the hub covers the events from the
+// block of the first published one.
+func (h *Hub) StartAt(block uint64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.start == nil {
+		h.start = &Cursor{Block: block}
+	}
+}
+
+// Publish sends an event to the subscribers of its topics. Events at or
+// before the last published cursor are dropped, which makes republishing
+// after a reconnect harmless. A removed event rewinds the hub, so that the
+// events replacing it after a reorg are published again.
+func (h *Hub) Publish(ev *Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if ev.Removed {
+		if h.last != nil && !h.last.Less(ev.Cursor) {
+			prev := ev.Cursor.prev()
+			h.last = &prev
+		}
+	} else {
+		if h.last != nil && !h.last.Less(ev.Cursor) {
+			return
+		}
+		h.last = &ev.Cursor
+	}
+	if h.start == nil {
+		h.start = &Cursor{Block: ev.Cursor.Block}
+	}
+
+	h.history = append(h.history, ev)
+	if len(h.history) > h.limit {
+		drop := len(h.history) - h.limit
+		h.evicted = &h.history[drop-1].Cursor
+		h.history = h.history[drop:]
+	}
+
+	for sub := range h.subs {
+		if !ev.matches(sub.topics) {
+			continue
+		}
+		select {
+		case sub.events <- ev:
+		default:
+			h.drop(sub)
+		}
+	}
+}
+
+// Subscribe registers a subscriber of topics, all events if topics is
+// empty. When from is not nil, the events after it that are still in the
+// history are delivered first; complete is false if the client may have
+// missed events after from: because they were evicted, because they are
+// before the start of the hub, e.g. after a restart, or because the start
+// is not known yet.
+func (h *Hub) Subscribe(topics []string, from *Cursor) (sub *Subscription, complete bool) {
+	events := make(chan *Event, DefaultBuffer)
+	sub = &Subscription{
+		Events: events,
+		events: events,
+		topics: make(map[string]bool, len(topics)),
+		hub:    h,
+	}
+	for _, topic := range topics {
+		sub.topics[topic] = true
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	complete = true
+	if from != nil {
+		var replay []*Event
+		for _, ev := range h.history {
+			if from.Less(ev.Cursor) && ev.matches(sub.topics) {
+				replay = append(replay, ev)
+			}
+		}
+		if h.evicted != nil && from.Less(*h.evicted) {
+			complete = false
+		}
+		if h.start == nil || from.Less(h.start.prev()) {
+			complete = false
+		}
+		// Replayed events must not be dropped for exceeding the buffer.
+		if len(replay) > cap(events) {
+			events = make(chan *Event, len(replay)+DefaultBuffer)
+			sub.Events, sub.events = events, events
+		}
+		for _, ev := range replay {
+			events <- ev
+		}
+	}
+	h.subs[sub] = struct{}{}
+	return sub, complete
+}
+
+// Unsubscribe stops delivering events and closes the Events channel.
+func (s *Subscription) Unsubscribe() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	if _, ok := s.hub.subs[s]; ok {
+		s.hub.drop(s)
+	}
+}
+
+// drop removes a subscriber. The lock must be held.
+func (h *Hub) drop(sub *Subscription) {
+	delete(h.subs, sub)
+	close(sub.events)
+}
@@ -0,0 +1,83 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/one-click-platform/system-contracts/watch"
+)
+
+// Config tunes the source.
+type Config struct {
+	Auction   common.Address
+	WERC721   common.Address
+	WETH      common.Address
+	FromBlock uint64 // First block to publish events of, the next confirmed block at start if zero
+}
+
+// Source subscribes to the events of the streamed contracts with a single
+// log subscription and publishes them to a hub. The watcher reconnects and
+// backfills the blocks missed meanwhile.
+type Source struct {
+	watcher *watch.Watcher
+	hub     *Hub
+	decoder *decoder
+	config  Config
+}
+
+// NewSource creates a source publishing to hub.
+func NewSource(watcher *watch.Watcher, hub *Hub, config Config) (*Source, error) {
+	d, err := newDecoder(config.Auction, config.WERC721, config.WETH)
+	if err != nil {
+		return nil, err
+	}
+	return &Source{
+		watcher: watcher,
+		hub:     hub,
+		decoder: d,
+		config:  config,
+	}, nil
+}
+
+// Run follows the chain until ctx is cancelled.
+func (s *Source) Run(ctx context.Context) error {
+	query := s.decoder.query()
+	if s.config.FromBlock != 0 {
+		query.FromBlock = new(big.Int).SetUint64(s.config.FromBlock)
+		s.hub.StartAt(s.config.FromBlock)
+	}
+	logs := make(chan types.Log, DefaultBuffer)
+	sub, err := s.watcher.SubscribeFilterLogs(ctx, query, logs)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-sub.Err():
+			if err == nil {
+				err = errors.New("subscription closed")
+			}
+			return err
+		case l := <-logs:
+			s.publish(l)
+		}
+	}
+}
+
+func (s *Source) publish(l types.Log) {
+	ev, err := s.decoder.decode(l)
+	if err != nil {
+		log.Debug("Skipping undecodable log", "address", l.Address, "tx", l.TxHash, "err", err)
+		return
+	}
+	s.hub.Publish(ev)
+}
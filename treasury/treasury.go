@@ -0,0 +1,139 @@
+// Package treasury reports the supply of the WETH contract: the total
+// supply against the supply cap, and how much of its allowance every
+// minter has used.
+package treasury
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/one-click-platform/system-contracts/generated"
+)
+
+// Backend is the chain access needed to read a report.
+type Backend interface {
+	bind.ContractCaller
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Minter is the usage of a minter. Amounts are in wei.
+type Minter struct {
+	Account   common.Address `json:"account"`
+	Allowance *big.Int       `json:"allowance"` // Total the minter may mint
+	Minted    *big.Int       `json:"minted"`
+	Remaining *big.Int       `json:"remaining"` // Zero if the allowance was lowered below Minted
+}
+
+// Report is the supply of a WETH contract at a block. Amounts are in wei.
+type Report struct {
+	Token        common.Address `json:"token"`
+	Block        uint64         `json:"block"`
+	Supply       *big.Int       `json:"supply"`
+	Cap          *big.Int       `json:"cap,omitempty"`          // Nil if the supply is not capped
+	RemainingCap *big.Int       `json:"remainingCap,omitempty"` // Nil if the supply is not capped
+	Minters      []Minter       `json:"minters"`
+}
+
+// Mintable returns how much more can be minted in total: the remaining
+// allowances of the minters, bounded by the remaining cap.
+func (r *Report) Mintable() *big.Int {
+	total := new(big.Int)
+	for _, m := range r.Minters {
+		total.Add(total, m.Remaining)
+	}
+	if r.RemainingCap != nil && r.RemainingCap.Cmp(total) < 0 {
+		return new(big.Int).Set(r.RemainingCap)
+	}
+	return total
+}
+
+// Read reads the report of the WETH contract at token at the latest block.
+// Every value is read at the same block, so that they add up.
+func Read(ctx context.Context, backend Backend, token common.Address) (*Report, error) {
+	weth, err := generated.NewWETHCaller(token, backend)
+	if err != nil {
+		return nil, err
+	}
+	head, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: head.Number}
+
+	r := &Report{Token: token, Block: head.Number.Uint64()}
+	if r.Supply, err = weth.TotalSupply(opts); err != nil {
+		return nil, fmt.Errorf("failed to read supply: %w", err)
+	}
+	supplyCap, err := weth.GetSupplyCap(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read supply cap: %w", err)
+	}
+	if supplyCap.Sign() != 0 {
+		r.Cap = supplyCap
+		r.RemainingCap = remaining(supplyCap, r.Supply)
+	}
+
+	minters, err := weth.GetMinters(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read minters: %w", err)
+	}
+	r.Minters = make([]Minter, len(minters))
+	for i, account := range minters {
+		allowance, err := weth.GetMinterAllowance(opts, account)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read allowance of minter %s: %w", account.Hex(), err)
+		}
+		minted, err := weth.GetMinted(opts, account)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read usage of minter %s: %w", account.Hex(), err)
+		}
+		r.Minters[i] = Minter{
+			Account:   account,
+			Allowance: allowance,
+			Minted:    minted,
+			Remaining: remaining(allowance, minted),
+		}
+	}
+	return r, nil
+}
+
+// Print writes the report as a table.
+func (r *Report) Print(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "token:\t%s\n", r.Token.Hex())
+	fmt.Fprintf(tw, "block:\t%d\n", r.Block)
+	fmt.Fprintf(tw, "supply (wei):\t%s\n", r.Supply)
+	if r.Cap != nil {
+		fmt.Fprintf(tw, "cap (wei):\t%s\n", r.Cap)
+		fmt.Fprintf(tw, "remaining cap (wei):\t%s\n", r.RemainingCap)
+	} else {
+		fmt.Fprintf(tw, "cap (wei):\tnone\n")
+	}
+	fmt.Fprintf(tw, "mintable (wei):\t%s\n", r.Mintable())
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w)
+	tw = tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "MINTER\tALLOWANCE\tMINTED\tREMAINING")
+	for _, m := range r.Minters {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", m.Account.Hex(), m.Allowance, m.Minted, m.Remaining)
+	}
+	return tw.Flush()
+}
+
+// remaining returns limit - used, or zero if used exceeds limit.
+func remaining(limit, used *big.Int) *big.Int {
+	if used.Cmp(limit) >= 0 {
+		return new(big.Int)
+	}
+	return new(big.Int).Sub(limit, used)
+}
@@ -0,0 +1,92 @@
+package txmanager
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// Fees are the fees of a transaction: GasPrice for a legacy one, or
+// GasFeeCap and GasTipCap for an EIP-1559 one.
+type Fees struct {
+	GasPrice  *big.Int
+	GasFeeCap *big.Int
+	GasTipCap *big.Int
+}
+
+// Dynamic reports whether the fees are the ones of an EIP-1559 transaction.
+func (f Fees) Dynamic() bool {
+	return f.GasFeeCap != nil
+}
+
+// FeeEstimator picks the fees of new transactions.
+type FeeEstimator interface {
+	Fees(ctx context.Context) (Fees, error)
+}
+
+// SuggestedFees uses the fees suggested by the node, raised by Percent
+// percent to get included ahead of the median. Once the chain has a base
+// fee, transactions are EIP-1559 ones tipping the suggested tip, with a fee
+// cap of twice the base fee plus the tip, which keeps them includable
+// through six full blocks in a row.
+type SuggestedFees struct {
+	Backend bind.ContractTransactor
+	Percent uint64
+}
+
+// Fees implements FeeEstimator.
+func (f SuggestedFees) Fees(ctx context.Context) (Fees, error) {
+	head, err := f.Backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return Fees{}, err
+	}
+	if head.BaseFee == nil {
+		price, err := f.Backend.SuggestGasPrice(ctx)
+		if err != nil {
+			return Fees{}, err
+		}
+		return Fees{GasPrice: percentOf(price, 100+f.Percent)}, nil
+	}
+
+	tip, err := f.Backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		return Fees{}, err
+	}
+	tip = percentOf(tip, 100+f.Percent)
+	feeCap := new(big.Int).Mul(head.BaseFee, big.NewInt(2))
+	return Fees{GasFeeCap: feeCap.Add(feeCap, tip), GasTipCap: tip}, nil
+}
+
+// capFees lowers the fees to at most max, keeping the tip within the fee
+// cap. A nil max leaves them unbounded.
+func capFees(fees Fees, max *big.Int) Fees {
+	if max == nil {
+		return fees
+	}
+	if fees.GasPrice != nil && fees.GasPrice.Cmp(max) > 0 {
+		fees.GasPrice = new(big.Int).Set(max)
+	}
+	if fees.GasFeeCap != nil && fees.GasFeeCap.Cmp(max) > 0 {
+		fees.GasFeeCap = new(big.Int).Set(max)
+	}
+	if fees.GasTipCap != nil && fees.GasTipCap.Cmp(fees.GasFeeCap) > 0 {
+		fees.GasTipCap = new(big.Int).Set(fees.GasFeeCap)
+	}
+	return fees
+}
+
+// percentOf returns percent percent of amount, rounded up.
+func percentOf(amount *big.Int, percent uint64) *big.Int {
+	result := new(big.Int).Mul(amount, new(big.Int).SetUint64(percent))
+	result.Add(result, big.NewInt(99))
+	return result.Div(result, big.NewInt(100))
+}
+
+// maxOf returns the larger of a and b.
+func maxOf(a, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,91 @@
+package txmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Tx is a journaled transaction. Every replacement keeps the nonce, so at
+// most one of Hashes gets mined.
+type Tx struct {
+	Key    string        `json:"key"` // Idempotency key given to Send
+	Nonce  uint64        `json:"nonce"`
+	Hashes []common.Hash `json:"hashes"`          // Hashes of every broadcast version, latest last
+	Raw    hexutil.Bytes `json:"raw"`             // RLP encoding of the latest version
+	SentAt time.Time     `json:"sentAt"`          // Broadcast time of the latest version
+	Mined  *common.Hash  `json:"mined,omitempty"` // Version included with enough confirmations
+}
+
+// Transaction decodes the latest version.
+func (tx *Tx) Transaction() (*types.Transaction, error) {
+	decoded := new(types.Transaction)
+	if err := decoded.UnmarshalBinary(tx.Raw); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// journal persists the transactions of a manager, so that a restart neither
+// sends them again nor loses track of them.
+type journal struct {
+	path string
+	txs  map[string]*Tx
+}
+
+func openJournal(path string) (*journal, error) {
+	j := &journal{path: path, txs: make(map[string]*Tx)}
+	if path == "" {
+		return j, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return j, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var txs []*Tx
+	if err := json.Unmarshal(data, &txs); err != nil {
+		return nil, fmt.Errorf("failed to decode journal: %w", err)
+	}
+	for _, tx := range txs {
+		j.txs[tx.Key] = tx
+	}
+	return j, nil
+}
+
+// list returns the journaled transactions ordered by nonce.
+func (j *journal) list() []*Tx {
+	txs := make([]*Tx, 0, len(j.txs))
+	for _, tx := range j.txs {
+		txs = append(txs, tx)
+	}
+	sort.Slice(txs, func(a, b int) bool { return txs[a].Nonce < txs[b].Nonce })
+	return txs
+}
+
+// save writes the journal, a no-op for in-memory journals.
+func (j *journal) save() error {
+	if j.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(j.list(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := j.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, j.path)
+}
@@ -0,0 +1,494 @@
+// Package txmanager sends the transactions of a single sender: it allocates
+// nonces locally, replaces stuck transactions with higher fees, waits for
+// confirmations and journals every transaction, so that a restart neither
+// sends a transaction twice nor loses track of it.
+package txmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// DefaultBumpInterval is the time a transaction may stay pending before
+	// it is replaced with higher fees.
+	DefaultBumpInterval = 3 * time.Minute
+	// DefaultBumpPercent is the fee increase of a replacement, the lowest
+	// one the transaction pool of geth accepts.
+	DefaultBumpPercent = 10
+	// DefaultPollInterval is the time between two receipt checks of Wait.
+	DefaultPollInterval = 5 * time.Second
+)
+
+var (
+	ErrUnknownKey = errors.New("no transaction with this key")
+	ErrReplaced   = errors.New("nonce was used by a transaction not sent by the manager")
+	ErrRejected   = errors.New("transaction was rejected by the node")
+)
+
+// Backend is the chain access needed to send and follow transactions.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+}
+
+// Config tunes the manager.
+type Config struct {
+	Confirmations uint64        // Blocks built on top of the including block before a receipt is returned
+	BumpInterval  time.Duration // DefaultBumpInterval if zero
+	BumpPercent   uint64        // DefaultBumpPercent if zero
+	MaxGasPrice   *big.Int      // Gas prices and fee caps are never raised above, unbounded if nil
+	PollInterval  time.Duration // DefaultPollInterval if zero
+	JournalPath   string        // File the journal is persisted to, kept in memory if empty
+	Fees          FeeEstimator  // Fees suggested by the backend if nil
+}
+
+// Manager sends transactions signed by opts.Signer on behalf of opts.From.
+// Every transaction has a key chosen by the caller, e.g. settle:42, which
+// makes sending idempotent: a key is sent only once until it is forgotten.
+type Manager struct {
+	backend Backend
+	opts    *bind.TransactOpts
+	config  Config
+
+	mu      sync.Mutex
+	nonce   *uint64 // Next nonce, nil until read from the node
+	journal *journal
+}
+
+// New creates a manager for the sender of opts and loads its journal.
+func New(backend Backend, opts *bind.TransactOpts, config Config) (*Manager, error) {
+	if config.BumpInterval == 0 {
+		config.BumpInterval = DefaultBumpInterval
+	}
+	if config.BumpPercent == 0 {
+		config.BumpPercent = DefaultBumpPercent
+	}
+	if config.PollInterval == 0 {
+		config.PollInterval = DefaultPollInterval
+	}
+	if config.Fees == nil {
+		config.Fees = SuggestedFees{Backend: backend}
+	}
+
+	j, err := openJournal(config.JournalPath)
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{
+		backend: backend,
+		opts:    opts,
+		config:  config,
+		journal: j,
+	}, nil
+}
+
+// From returns the sender of the transactions.
+func (m *Manager) From() common.Address {
+	return m.opts.From
+}
+
+// Send sends the transaction built by send under key, unless a transaction
+// with this key is already journaled, in which case that one is returned.
+// send gets options with the nonce and fees set and must send a single
+// transaction with them, typically through a generated binding.
+//
+// A broadcast that fails after signing, e.g. on a timeout, may still have
+// reached the node, so the transaction stays journaled: Send returns the
+// error, a retry with the same key returns the journaled transaction, and
+// Check and Resume reconcile it. Only a transaction the node rejected for
+// good is dropped from the journal.
+func (m *Manager) Send(ctx context.Context, key string, send func(opts *bind.TransactOpts) (*types.Transaction, error)) (*Tx, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if tx, ok := m.journal.txs[key]; ok {
+		return tx.copy(), nil
+	}
+
+	nonce, err := m.nextNonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fees, err := m.config.Fees.Fees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fees = capFees(fees, m.config.MaxGasPrice)
+
+	opts := *m.opts
+	opts.Context = ctx
+	opts.Nonce = new(big.Int).SetUint64(nonce)
+	opts.GasPrice = fees.GasPrice
+	opts.GasFeeCap = fees.GasFeeCap
+	opts.GasTipCap = fees.GasTipCap
+	// The transaction is journaled between signing and broadcasting, so
+	// that a crash in between can not lose it.
+	journaled := false
+	opts.Signer = func(from common.Address, unsigned *types.Transaction) (*types.Transaction, error) {
+		signed, err := m.opts.Signer(from, unsigned)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.record(key, signed); err != nil {
+			return nil, err
+		}
+		journaled = true
+		return signed, nil
+	}
+
+	if _, err := send(&opts); err != nil {
+		// The next nonce is read from the node and the journal again, as the
+		// node may have seen the transaction anyway.
+		m.nonce = nil
+		if journaled && isRejected(err) {
+			m.drop(key)
+		} else if journaled {
+			log.Warn("Broadcast failed, keeping the transaction journaled", "key", key, "nonce", nonce, "err", err)
+		}
+		return nil, err
+	}
+	if !journaled {
+		return nil, errors.New("send did not sign a transaction")
+	}
+
+	next := nonce + 1
+	m.nonce = &next
+	return m.journal.txs[key].copy(), nil
+}
+
+// Get returns the journaled transaction of key.
+func (m *Manager) Get(key string) (*Tx, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tx, ok := m.journal.txs[key]
+	if !ok {
+		return nil, false
+	}
+	return tx.copy(), true
+}
+
+// Pending returns the journaled transactions that are not mined yet,
+// ordered by nonce.
+func (m *Manager) Pending() []*Tx {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var pending []*Tx
+	for _, tx := range m.journal.list() {
+		if tx.Mined == nil {
+			pending = append(pending, tx.copy())
+		}
+	}
+	return pending
+}
+
+// Forget removes a transaction from the journal, so that its key can be
+// sent again.
+func (m *Manager) Forget(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.journal.txs[key]; !ok {
+		return nil
+	}
+	delete(m.journal.txs, key)
+	return m.journal.save()
+}
+
+// Check returns the receipt of a transaction once it has the configured
+// confirmations and nil while it is pending. A transaction the node does not
+// know, e.g. after a failed broadcast, is broadcast again, and one pending
+// for longer than the bump interval is replaced with higher fees. A
+// transaction the node rejects for good is dropped with ErrRejected.
+func (m *Manager) Check(ctx context.Context, key string) (*types.Receipt, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tx, ok := m.journal.txs[key]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	hashes := tx.Hashes
+	if tx.Mined != nil {
+		hashes = []common.Hash{*tx.Mined}
+	}
+
+	for i := len(hashes) - 1; i >= 0; i-- {
+		receipt, err := m.backend.TransactionReceipt(ctx, hashes[i])
+		if errors.Is(err, ethereum.NotFound) || (err == nil && receipt == nil) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		head, err := m.backend.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		if head.Number.Cmp(new(big.Int).Add(receipt.BlockNumber, new(big.Int).SetUint64(m.config.Confirmations))) < 0 {
+			return nil, nil
+		}
+		if tx.Mined == nil {
+			tx.Mined = &hashes[i]
+			if err := m.journal.save(); err != nil {
+				return nil, err
+			}
+		}
+		return receipt, nil
+	}
+
+	nonce, err := m.backend.NonceAt(ctx, m.opts.From, nil)
+	if err != nil {
+		return nil, err
+	}
+	if nonce > tx.Nonce {
+		// A version may have been mined after its receipt was looked up.
+		for _, hash := range hashes {
+			if receipt, err := m.backend.TransactionReceipt(ctx, hash); err == nil && receipt != nil {
+				return nil, nil
+			}
+		}
+		return nil, ErrReplaced
+	}
+	if err := m.reconcile(ctx, tx); err != nil {
+		return nil, err
+	}
+	if time.Since(tx.SentAt) >= m.config.BumpInterval {
+		if err := m.bump(ctx, tx); err != nil {
+			log.Warn("Failed to replace stuck transaction", "key", tx.Key, "nonce", tx.Nonce, "err", err)
+		}
+	}
+	return nil, nil
+}
+
+// Wait polls Check until the transaction is confirmed.
+func (m *Manager) Wait(ctx context.Context, key string) (*types.Receipt, error) {
+	ticker := time.NewTicker(m.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := m.Check(ctx, key)
+		if err != nil || receipt != nil {
+			return receipt, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Resume broadcasts the pending journaled transactions again, as the node
+// may have dropped them while the manager was not running.
+func (m *Manager) Resume(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, tx := range m.journal.list() {
+		if tx.Mined != nil {
+			continue
+		}
+		signed, err := tx.Transaction()
+		if err != nil {
+			return fmt.Errorf("failed to decode journaled transaction %s: %w", tx.Key, err)
+		}
+		if err := m.backend.SendTransaction(ctx, signed); err != nil && !isKnown(err) {
+			if isRejected(err) {
+				m.drop(tx.Key)
+				log.Warn("Dropped rejected transaction", "key", tx.Key, "nonce", tx.Nonce, "err", err)
+				continue
+			}
+			log.Warn("Failed to rebroadcast transaction", "key", tx.Key, "nonce", tx.Nonce, "err", err)
+			continue
+		}
+		log.Info("Rebroadcast journaled transaction", "key", tx.Key, "nonce", tx.Nonce, "tx", signed.Hash())
+	}
+	return nil
+}
+
+// nextNonce returns the next nonce, read from the node and the journal when
+// it is not known.
+func (m *Manager) nextNonce(ctx context.Context) (uint64, error) {
+	if m.nonce != nil {
+		return *m.nonce, nil
+	}
+	nonce, err := m.backend.PendingNonceAt(ctx, m.opts.From)
+	if err != nil {
+		return 0, err
+	}
+	for _, tx := range m.journal.txs {
+		if tx.Mined == nil && tx.Nonce >= nonce {
+			nonce = tx.Nonce + 1
+		}
+	}
+	m.nonce = &nonce
+	return nonce, nil
+}
+
+// reconcile broadcasts the latest version of a pending transaction again
+// when the node does not know it, which happens when a broadcast failed
+// without reaching the node.
+func (m *Manager) reconcile(ctx context.Context, tx *Tx) error {
+	_, _, err := m.backend.TransactionByHash(ctx, tx.Hashes[len(tx.Hashes)-1])
+	if err == nil || !errors.Is(err, ethereum.NotFound) {
+		return err
+	}
+	signed, err := tx.Transaction()
+	if err != nil {
+		return err
+	}
+	if err := m.backend.SendTransaction(ctx, signed); err != nil && !isKnown(err) {
+		if isRejected(err) {
+			m.drop(tx.Key)
+			return fmt.Errorf("%w: %v", ErrRejected, err)
+		}
+		log.Warn("Failed to rebroadcast transaction", "key", tx.Key, "nonce", tx.Nonce, "err", err)
+		return nil
+	}
+	log.Info("Rebroadcast unknown transaction", "key", tx.Key, "nonce", tx.Nonce, "tx", signed.Hash())
+	return nil
+}
+
+// drop removes a rejected transaction from the journal. Its nonce is free
+// again, so the next one is read from the node.
+func (m *Manager) drop(key string) {
+	delete(m.journal.txs, key)
+	m.nonce = nil
+	if err := m.journal.save(); err != nil {
+		log.Error("Failed to save transaction journal", "err", err)
+	}
+}
+
+// record journals a signed version of the transaction of key.
+func (m *Manager) record(key string, signed *types.Transaction) error {
+	raw, err := signed.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	tx, ok := m.journal.txs[key]
+	if !ok {
+		tx = &Tx{Key: key, Nonce: signed.Nonce()}
+		m.journal.txs[key] = tx
+	}
+	tx.Hashes = append(tx.Hashes, signed.Hash())
+	tx.Raw = raw
+	tx.SentAt = time.Now()
+	return m.journal.save()
+}
+
+// bump replaces a stuck transaction by the same one with higher fees. Both
+// the fee cap and the tip of an EIP-1559 transaction are raised, as geth
+// only accepts a replacement raising both.
+func (m *Manager) bump(ctx context.Context, tx *Tx) error {
+	old, err := tx.Transaction()
+	if err != nil {
+		return err
+	}
+	suggested, err := m.config.Fees.Fees(ctx)
+	if err != nil {
+		return err
+	}
+	percent := 100 + m.config.BumpPercent
+	ceiling := m.config.MaxGasPrice
+
+	var unsigned *types.Transaction
+	if old.Type() == types.DynamicFeeTxType {
+		fees := Fees{
+			GasFeeCap: percentOf(old.GasFeeCap(), percent),
+			GasTipCap: percentOf(old.GasTipCap(), percent),
+		}
+		if suggested.Dynamic() {
+			fees.GasFeeCap = maxOf(fees.GasFeeCap, suggested.GasFeeCap)
+			fees.GasTipCap = maxOf(fees.GasTipCap, suggested.GasTipCap)
+		}
+		if ceiling != nil && fees.GasFeeCap.Cmp(ceiling) > 0 && old.GasFeeCap().Cmp(ceiling) >= 0 {
+			return errors.New("fee cap is at the ceiling")
+		}
+		fees = capFees(fees, ceiling)
+		unsigned = types.NewTx(&types.DynamicFeeTx{
+			ChainID:    old.ChainId(),
+			Nonce:      old.Nonce(),
+			GasTipCap:  fees.GasTipCap,
+			GasFeeCap:  fees.GasFeeCap,
+			Gas:        old.Gas(),
+			To:         old.To(),
+			Value:      old.Value(),
+			Data:       old.Data(),
+			AccessList: old.AccessList(),
+		})
+	} else {
+		price := percentOf(old.GasPrice(), percent)
+		if suggested.GasPrice != nil {
+			price = maxOf(price, suggested.GasPrice)
+		}
+		if ceiling != nil && price.Cmp(ceiling) > 0 && old.GasPrice().Cmp(ceiling) >= 0 {
+			return errors.New("gas price is at the ceiling")
+		}
+		price = capFees(Fees{GasPrice: price}, ceiling).GasPrice
+		unsigned = types.NewTx(&types.LegacyTx{
+			Nonce:    old.Nonce(),
+			GasPrice: price,
+			Gas:      old.Gas(),
+			To:       old.To(),
+			Value:    old.Value(),
+			Data:     old.Data(),
+		})
+	}
+	signed, err := m.opts.Signer(m.opts.From, unsigned)
+	if err != nil {
+		return err
+	}
+	if err := m.record(tx.Key, signed); err != nil {
+		return err
+	}
+	if err := m.backend.SendTransaction(ctx, signed); err != nil && !isKnown(err) {
+		return err
+	}
+	log.Info("Replaced stuck transaction", "key", tx.Key, "nonce", tx.Nonce, "gasFeeCap", signed.GasFeeCap(), "gasTipCap", signed.GasTipCap(), "tx", signed.Hash())
+	return nil
+}
+
+// isKnown reports whether a broadcast failed only because the node already
+// has the transaction or one of its versions was mined.
+func isKnown(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "already known") ||
+		strings.Contains(msg, "known transaction") ||
+		strings.Contains(msg, "nonce too low")
+}
+
+// isRejected reports whether the node refused a transaction for good, so
+// that it can not be mined as it is. Other errors, such as timeouts, leave
+// open whether the node received it.
+func isRejected(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "nonce too low") ||
+		strings.Contains(msg, "invalid transaction") ||
+		strings.Contains(msg, "invalid sender") ||
+		strings.Contains(msg, "intrinsic gas too low") ||
+		strings.Contains(msg, "exceeds block gas limit") ||
+		strings.Contains(msg, "insufficient funds")
+}
+
+func (tx *Tx) copy() *Tx {
+	c := *tx
+	c.Hashes = append([]common.Hash(nil), tx.Hashes...)
+	return &c
+}
@@ -0,0 +1,298 @@
+// Package upgrade deploys the Auction contract behind a transparent proxy
+// administered by a ProxyAdmin, and upgrades its implementation. Bytecode
+// is read from the solc output of generate.sh, as the generated bindings of
+// the proxy contracts carry none.
+package upgrade
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/one-click-platform/system-contracts/generated"
+	"github.com/one-click-platform/system-contracts/storagelayout"
+	"github.com/one-click-platform/system-contracts/txmanager"
+)
+
+// Deployment records a proxied Auction, together with the storage layout
+// of its implementation that upgrades are checked against.
+type Deployment struct {
+	Proxy          common.Address        `json:"proxy"`
+	Admin          common.Address        `json:"admin"`
+	Implementation common.Address        `json:"implementation"`
+	Layout         *storagelayout.Layout `json:"layout,omitempty"`
+}
+
+// LoadDeployment reads a deployment written by Save.
+func LoadDeployment(path string) (*Deployment, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	d := new(Deployment)
+	if err := json.Unmarshal(data, d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Save writes the deployment to path.
+func (d *Deployment) Save(path string) error {
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// Build is the solc output directory of generate.sh.
+type Build string
+
+// Bytecode returns the creation code of contract.
+func (b Build) Bytecode(contract string) ([]byte, error) {
+	data, err := ioutil.ReadFile(filepath.Join(string(b), contract+".bin"))
+	if err != nil {
+		return nil, err
+	}
+	code := common.FromHex(strings.TrimSpace(string(data)))
+	if len(code) == 0 {
+		return nil, fmt.Errorf("no bytecode of %s in %s", contract, b)
+	}
+	return code, nil
+}
+
+// Layout returns the storage layout of contract.
+func (b Build) Layout(contract string) (*storagelayout.Layout, error) {
+	return storagelayout.Load(filepath.Join(string(b), contract+"_storage.json"), contract)
+}
+
+// Deployer deploys and upgrades through a transaction manager, whose sender
+// owns the ProxyAdmin. Every step is sent under a key derived from its
+// bytecode and arguments, so an interrupted run resumes where it stopped.
+type Deployer struct {
+	backend bind.ContractBackend
+	txs     *txmanager.Manager
+	build   Build
+}
+
+// NewDeployer creates a deployer reading bytecode from build.
+func NewDeployer(backend bind.ContractBackend, txs *txmanager.Manager, build Build) *Deployer {
+	return &Deployer{backend: backend, txs: txs, build: build}
+}
+
+// Deploy deploys an Auction implementation and a proxy to it, initialized
+// with the trusted forwarder and the sender as the auction admin, who
+// manages its guardians. A new ProxyAdmin is deployed if admin is the zero
+// address.
+func (d *Deployer) Deploy(ctx context.Context, admin, forwarder common.Address) (*Deployment, error) {
+	layout, err := d.build.Layout("Auction")
+	if err != nil {
+		return nil, err
+	}
+	implementation, err := d.deployImplementation(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if admin == (common.Address{}) {
+		if admin, err = d.deploy(ctx, "ProxyAdmin", generated.ProxyAdminABI); err != nil {
+			return nil, err
+		}
+	} else if err := d.checkOwner(ctx, admin); err != nil {
+		return nil, err
+	}
+
+	auctionABI, err := abi.JSON(strings.NewReader(generated.AuctionABI))
+	if err != nil {
+		return nil, err
+	}
+	initialize, err := auctionABI.Pack("initialize", forwarder, d.txs.From())
+	if err != nil {
+		return nil, err
+	}
+	proxy, err := d.deploy(ctx, "TransparentUpgradeableProxy", generated.TransparentUpgradeableProxyABI, implementation, admin, initialize)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment := &Deployment{Proxy: proxy, Admin: admin, Implementation: implementation, Layout: layout}
+	if err := d.verify(ctx, deployment); err != nil {
+		return nil, err
+	}
+	auction, err := generated.NewAuctionCaller(proxy, d.backend)
+	if err != nil {
+		return nil, err
+	}
+	trusted, err := auction.GetTrustedForwarder(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+	if trusted != forwarder {
+		return nil, fmt.Errorf("proxy trusts forwarder %s instead of %s", trusted.Hex(), forwarder.Hex())
+	}
+	return deployment, nil
+}
+
+// Upgrade deploys the Auction of the build and points the proxy of
+// deployment at it, calling call on the proxy within the upgrade unless it
+// is empty. The storage layout of the build must be compatible with the
+// deployed one unless force is set. On success the deployment is updated.
+func (d *Deployer) Upgrade(ctx context.Context, deployment *Deployment, call []byte, force bool) ([]storagelayout.Issue, error) {
+	layout, err := d.build.Layout("Auction")
+	if err != nil {
+		return nil, err
+	}
+	var issues []storagelayout.Issue
+	if deployment.Layout == nil {
+		if !force {
+			return nil, errors.New("deployment has no storage layout to check against")
+		}
+	} else {
+		issues = storagelayout.Compare(deployment.Layout, layout)
+		if storagelayout.HasErrors(issues) && !force {
+			return issues, errors.New("storage layout is incompatible with the deployed one")
+		}
+	}
+	if err := d.checkOwner(ctx, deployment.Admin); err != nil {
+		return issues, err
+	}
+
+	implementation, err := d.deployImplementation(ctx)
+	if err != nil {
+		return issues, err
+	}
+	if implementation == deployment.Implementation {
+		return issues, errors.New("implementation is already deployed")
+	}
+	admin, err := generated.NewProxyAdminTransactor(deployment.Admin, d.backend)
+	if err != nil {
+		return issues, err
+	}
+	key := fmt.Sprintf("upgrade:%s:%s:%x", deployment.Proxy.Hex(), implementation.Hex(), crypto.Keccak256(call))
+	if _, err := d.send(ctx, key, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		if len(call) == 0 {
+			return admin.Upgrade(opts, deployment.Proxy, implementation)
+		}
+		return admin.UpgradeAndCall(opts, deployment.Proxy, implementation, call)
+	}); err != nil {
+		return issues, err
+	}
+
+	upgraded := *deployment
+	upgraded.Implementation = implementation
+	upgraded.Layout = layout
+	if err := d.verify(ctx, &upgraded); err != nil {
+		return issues, err
+	}
+	*deployment = upgraded
+	return issues, nil
+}
+
+// Status reads the implementation and admin of the proxy from the
+// ProxyAdmin, as the proxy only answers its admin.
+func Status(ctx context.Context, backend bind.ContractCaller, admin, proxy common.Address) (implementation, proxyAdmin common.Address, err error) {
+	caller, err := generated.NewProxyAdminCaller(admin, backend)
+	if err != nil {
+		return common.Address{}, common.Address{}, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	if implementation, err = caller.GetProxyImplementation(opts, proxy); err != nil {
+		return common.Address{}, common.Address{}, err
+	}
+	if proxyAdmin, err = caller.GetProxyAdmin(opts, proxy); err != nil {
+		return common.Address{}, common.Address{}, err
+	}
+	return implementation, proxyAdmin, nil
+}
+
+// deployImplementation deploys an Auction without forwarder. Its
+// constructor locks initialize, so the implementation can not be taken
+// over; the proxy keeps the forwarder in its own storage.
+func (d *Deployer) deployImplementation(ctx context.Context) (common.Address, error) {
+	return d.deploy(ctx, "Auction", generated.AuctionABI, common.Address{})
+}
+
+// deploy deploys contract with the given constructor arguments and returns
+// its address.
+func (d *Deployer) deploy(ctx context.Context, contract, abiJSON string, params ...interface{}) (common.Address, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return common.Address{}, err
+	}
+	code, err := d.build.Bytecode(contract)
+	if err != nil {
+		return common.Address{}, err
+	}
+	args, err := parsed.Pack("", params...)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	key := fmt.Sprintf("deploy:%s:%x", contract, crypto.Keccak256(code, args))
+	receipt, err := d.send(ctx, key, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		_, tx, _, err := bind.DeployContract(opts, parsed, code, d.backend, params...)
+		return tx, err
+	})
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to deploy %s: %w", contract, err)
+	}
+	log.Info("Deployed contract", "contract", contract, "address", receipt.ContractAddress, "tx", receipt.TxHash)
+	return receipt.ContractAddress, nil
+}
+
+// send sends a transaction and waits until it succeeded.
+func (d *Deployer) send(ctx context.Context, key string, send func(opts *bind.TransactOpts) (*types.Transaction, error)) (*types.Receipt, error) {
+	if _, err := d.txs.Send(ctx, key, send); err != nil {
+		return nil, err
+	}
+	receipt, err := d.txs.Wait(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("transaction %s reverted", receipt.TxHash.Hex())
+	}
+	return receipt, nil
+}
+
+// checkOwner fails unless the sender owns the ProxyAdmin at admin.
+func (d *Deployer) checkOwner(ctx context.Context, admin common.Address) error {
+	caller, err := generated.NewProxyAdminCaller(admin, d.backend)
+	if err != nil {
+		return err
+	}
+	owner, err := caller.Owner(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return err
+	}
+	if owner != d.txs.From() {
+		return fmt.Errorf("proxy admin %s is owned by %s", admin.Hex(), owner.Hex())
+	}
+	return nil
+}
+
+// verify checks that the proxy points at the implementation of deployment.
+func (d *Deployer) verify(ctx context.Context, deployment *Deployment) error {
+	implementation, admin, err := Status(ctx, d.backend, deployment.Admin, deployment.Proxy)
+	if err != nil {
+		return err
+	}
+	if implementation != deployment.Implementation {
+		return fmt.Errorf("proxy points at %s instead of %s", implementation.Hex(), deployment.Implementation.Hex())
+	}
+	if admin != deployment.Admin {
+		return fmt.Errorf("proxy is administered by %s instead of %s", admin.Hex(), deployment.Admin.Hex())
+	}
+	return nil
+}
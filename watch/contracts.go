@@ -0,0 +1,25 @@
+package watch
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/one-click-platform/system-contracts/generated"
+)
+
+// NewAuctionFilterer binds the Auction contract at address to w, so that
+// its Watch methods survive reconnects.
+func NewAuctionFilterer(w *Watcher, address common.Address) (*generated.AuctionFilterer, error) {
+	return generated.NewAuctionFilterer(address, w)
+}
+
+// NewWETHFilterer binds the WETH contract at address to w, so that its
+// Watch methods survive reconnects.
+func NewWETHFilterer(w *Watcher, address common.Address) (*generated.WETHFilterer, error) {
+	return generated.NewWETHFilterer(address, w)
+}
+
+// NewWERC721Filterer binds the WERC721 contract at address to w, so that
+// its Watch methods survive reconnects.
+func NewWERC721Filterer(w *Watcher, address common.Address) (*generated.WERC721Filterer, error) {
+	return generated.NewWERC721Filterer(address, w)
+}
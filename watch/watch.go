@@ -0,0 +1,343 @@
+// Package watch provides event subscriptions that survive node reconnects.
+//
+// A Watcher implements bind.ContractFilterer, so the Watch methods of the
+// generated filterers bound to it keep delivering events when the
+// connection drops: logs are read with eth_getLogs as new heads arrive, the
+// gap after a reconnect is backfilled along with the blocks before it up to
+// the reorg depth, duplicates are dropped by (txHash, logIndex) and events
+// are delivered in chain order once they have the configured number of
+// confirmations.
+package watch
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// DefaultMaxRange is the number of blocks requested per eth_getLogs call.
+	DefaultMaxRange = 2000
+	// DefaultMinBackoff is the first delay before reconnecting to the node.
+	DefaultMinBackoff = time.Second
+	// DefaultMaxBackoff caps the doubling delay between reconnects.
+	DefaultMaxBackoff = time.Minute
+	// DefaultReorgDepth is the number of blocks read again after a
+	// reconnect, as they may have been replaced meanwhile.
+	DefaultReorgDepth = 12
+)
+
+// Backend is a node connection able to filter logs and follow new heads.
+type Backend interface {
+	ethereum.LogFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+	Close()
+}
+
+// Dialer opens a new connection to the node.
+type Dialer func(ctx context.Context) (Backend, error)
+
+// Config tunes the subscriptions of a watcher.
+type Config struct {
+	Confirmations uint64        // Blocks built on top of a log before it is delivered
+	MaxRange      uint64        // Blocks per eth_getLogs call, DefaultMaxRange if zero
+	MinBackoff    time.Duration // DefaultMinBackoff if zero
+	MaxBackoff    time.Duration // DefaultMaxBackoff if zero
+	ReorgDepth    uint64        // Blocks read again after a reconnect, DefaultReorgDepth if zero
+}
+
+// Watcher shares one node connection between its subscriptions and
+// replaces it when it fails.
+type Watcher struct {
+	dial   Dialer
+	config Config
+
+	mu      sync.Mutex
+	backend Backend
+}
+
+// New creates a watcher connecting with dial.
+func New(dial Dialer, config Config) *Watcher {
+	if config.MaxRange == 0 {
+		config.MaxRange = DefaultMaxRange
+	}
+	if config.MinBackoff == 0 {
+		config.MinBackoff = DefaultMinBackoff
+	}
+	if config.MaxBackoff == 0 {
+		config.MaxBackoff = DefaultMaxBackoff
+	}
+	if config.ReorgDepth == 0 {
+		config.ReorgDepth = DefaultReorgDepth
+	}
+	return &Watcher{dial: dial, config: config}
+}
+
+// conn returns the current connection, dialing one if there is none.
+func (w *Watcher) conn(ctx context.Context) (Backend, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.backend == nil {
+		backend, err := w.dial(ctx)
+		if err != nil {
+			return nil, err
+		}
+		w.backend = backend
+	}
+	return w.backend, nil
+}
+
+// reset closes backend after it failed, unless it was already replaced.
+func (w *Watcher) reset(backend Backend) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.backend == backend {
+		w.backend.Close()
+		w.backend = nil
+	}
+}
+
+// Close closes the current connection. Running subscriptions dial a new one.
+func (w *Watcher) Close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.backend != nil {
+		w.backend.Close()
+		w.backend = nil
+	}
+}
+
+// FilterLogs executes a filter query on the current connection.
+func (w *Watcher) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	backend, err := w.conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := backend.FilterLogs(ctx, query)
+	if err != nil && ctx.Err() == nil {
+		w.reset(backend)
+	}
+	return logs, err
+}
+
+// SubscribeFilterLogs delivers the logs matching query to ch, starting at
+// query.FromBlock or at the next confirmed block if it is nil, and ending
+// at query.ToBlock if set. The subscription only fails when ctx is
+// cancelled; connection errors are retried with backoff.
+func (w *Watcher) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	if query.BlockHash != nil {
+		return nil, errors.New("block hash queries can not be followed")
+	}
+	f := &follower{
+		watcher: w,
+		query:   query,
+		sink:    ch,
+		seen:    make(map[logKey]uint64),
+	}
+	if query.FromBlock != nil {
+		f.next, f.started = query.FromBlock.Uint64(), true
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		return f.run(ctx, quit)
+	}), nil
+}
+
+type logKey struct {
+	txHash common.Hash
+	index  uint
+}
+
+// follower delivers the logs of a single subscription.
+type follower struct {
+	watcher *Watcher
+	query   ethereum.FilterQuery
+	sink    chan<- types.Log
+
+	started   bool              // Whether next is known
+	next      uint64            // First block not read yet
+	delivered bool              // Whether lastBlock is set
+	lastBlock uint64            // Block of the last delivered log
+	seen      map[logKey]uint64 // Delivered logs of lastBlock and later, with their block
+}
+
+func (f *follower) run(parent context.Context, quit <-chan struct{}) error {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+	go func() {
+		select {
+		case <-quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	config := f.watcher.config
+	backoff := config.MinBackoff
+	for {
+		start := time.Now()
+		err := f.follow(ctx)
+		select {
+		case <-quit:
+			return nil
+		default:
+		}
+		if parent.Err() != nil {
+			return parent.Err()
+		}
+		if f.query.ToBlock != nil && f.started && f.next > f.query.ToBlock.Uint64() {
+			return nil
+		}
+		// A connection that lasted resets the backoff.
+		if time.Since(start) > config.MaxBackoff {
+			backoff = config.MinBackoff
+		}
+		log.Warn("Log subscription interrupted, reconnecting", "next", f.next, "err", err, "backoff", backoff)
+
+		select {
+		case <-ctx.Done():
+			continue
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > config.MaxBackoff {
+			backoff = config.MaxBackoff
+		}
+	}
+}
+
+// follow reads logs until the connection fails. Heads are subscribed before
+// the first catch up, so that no head is missed in between.
+func (f *follower) follow(ctx context.Context) error {
+	backend, err := f.watcher.conn(ctx)
+	if err != nil {
+		return err
+	}
+	err = f.followWith(ctx, backend)
+	if err != errDone && ctx.Err() == nil {
+		f.watcher.reset(backend)
+	}
+	return err
+}
+
+func (f *follower) followWith(ctx context.Context, backend Backend) error {
+	heads := make(chan *types.Header, 16)
+	sub, err := backend.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	// Backfill the blocks that may have been replaced meanwhile, up to the
+	// reorg depth, but not before the last seen block: the delivered logs
+	// of older blocks were pruned and would be delivered twice. Logs read
+	// again from the last seen block are dropped as duplicates.
+	if f.delivered && f.lastBlock < f.next {
+		from := f.lastBlock
+		if depth := f.watcher.config.ReorgDepth; f.next > depth && f.next-depth > from {
+			from = f.next - depth
+		}
+		f.next = from
+	}
+
+	head, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := f.catchUp(ctx, backend, head.Number.Uint64()); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-sub.Err():
+			if err == nil {
+				err = errors.New("head subscription closed")
+			}
+			return err
+		case head := <-heads:
+			if err := f.catchUp(ctx, backend, head.Number.Uint64()); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// catchUp delivers the logs of the blocks confirmed at head.
+func (f *follower) catchUp(ctx context.Context, backend Backend, head uint64) error {
+	config := f.watcher.config
+	if head < config.Confirmations {
+		return nil
+	}
+	to := head - config.Confirmations
+	if f.query.ToBlock != nil && f.query.ToBlock.Uint64() < to {
+		to = f.query.ToBlock.Uint64()
+	}
+	if !f.started {
+		f.next, f.started = to+1, true
+	}
+
+	for f.next <= to {
+		end := f.next + config.MaxRange - 1
+		if end > to {
+			end = to
+		}
+		query := f.query
+		query.FromBlock = new(big.Int).SetUint64(f.next)
+		query.ToBlock = new(big.Int).SetUint64(end)
+		logs, err := backend.FilterLogs(ctx, query)
+		if err != nil {
+			return err
+		}
+		sort.SliceStable(logs, func(i, j int) bool {
+			if logs[i].BlockNumber != logs[j].BlockNumber {
+				return logs[i].BlockNumber < logs[j].BlockNumber
+			}
+			return logs[i].Index < logs[j].Index
+		})
+
+		for _, l := range logs {
+			key := logKey{txHash: l.TxHash, index: l.Index}
+			if _, ok := f.seen[key]; ok {
+				continue
+			}
+			select {
+			case f.sink <- l:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			f.seen[key] = l.BlockNumber
+			f.lastBlock, f.delivered = l.BlockNumber, true
+		}
+		f.next = end + 1
+		f.prune()
+	}
+	if f.query.ToBlock != nil && f.next > f.query.ToBlock.Uint64() {
+		return errDone
+	}
+	return nil
+}
+
+// errDone stops following a query with an end block once it was read.
+var errDone = errors.New("end block reached")
+
+// prune forgets the delivered logs that can not be read again.
+func (f *follower) prune() {
+	for key, block := range f.seen {
+		if block < f.lastBlock {
+			delete(f.seen, key)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Package wrapper escrows external ERC721 tokens in the WERC721 contract and
+// resolves WERC721 receipts back to the assets they wrap.
+package wrapper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/one-click-platform/system-contracts/generated"
+	"github.com/one-click-platform/system-contracts/txmanager"
+)
+
+// ErrNoWrappedEvent is returned when a receipt does not contain a Wrapped event.
+var ErrNoWrappedEvent = errors.New("no Wrapped event in receipt")
+
+// Wrap transfers an external ERC721 token into the WERC721 contract with
+// safeTransferFrom, which mints a receipt token to the sender of txs in the
+// same transaction. No approval is needed since the owner transfers directly.
+// Wrap waits for the transaction and returns its receipt, from which
+// WrappedTokenID reads the receipt token. The transaction is journaled by txs
+// under a key of the token until it is mined, so a retry after a failure
+// follows it instead of sending it again.
+func Wrap(ctx context.Context, backend bind.ContractBackend, txs *txmanager.Manager, werc721, tokenAddress common.Address, tokenId *big.Int) (*types.Receipt, error) {
+	// The WERC721 binding is used for the external token since only the
+	// standard IERC721 safeTransferFrom is called.
+	token, err := generated.NewWERC721Transactor(tokenAddress, backend)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("wrap:%s:%s", tokenAddress.Hex(), tokenId)
+	if _, err := txs.Send(ctx, key, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return token.SafeTransferFrom(opts, opts.From, werc721, tokenId)
+	}); err != nil {
+		return nil, err
+	}
+
+	// The key is forgotten once the wrap is settled, as the token can be
+	// wrapped again after it is unwrapped.
+	receipt, err := txs.Wait(ctx, key)
+	switch {
+	case errors.Is(err, txmanager.ErrReplaced), errors.Is(err, txmanager.ErrRejected):
+		if err := txs.Forget(key); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("wrap of token %s of %s was not mined: %w", tokenId, tokenAddress.Hex(), err)
+	case err != nil:
+		return nil, err
+	}
+	if err := txs.Forget(key); err != nil {
+		return receipt, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("wrap of token %s of %s reverted in %s", tokenId, tokenAddress.Hex(), receipt.TxHash.Hex())
+	}
+	return receipt, nil
+}
+
+// WrappedTokenID returns the ID of the receipt token minted by a wrap
+// transaction of the WERC721 contract at werc721.
+func WrappedTokenID(filterer *generated.WERC721Filterer, werc721 common.Address, receipt *types.Receipt) (*big.Int, error) {
+	parsed, err := abi.JSON(strings.NewReader(generated.WERC721ABI))
+	if err != nil {
+		return nil, err
+	}
+	wrappedID := parsed.Events["Wrapped"].ID
+
+	for _, log := range receipt.Logs {
+		if log.Address != werc721 || len(log.Topics) == 0 || log.Topics[0] != wrappedID {
+			continue
+		}
+		event, err := filterer.ParseWrapped(*log)
+		if err != nil {
+			return nil, err
+		}
+		return event.WrappedTokenId, nil
+	}
+	return nil, ErrNoWrappedEvent
+}
+
+// Resolve returns the external token wrapped by a WERC721 token. The boolean
+// is false if the token was minted from data rather than by wrapping.
+func Resolve(caller *generated.WERC721Caller, opts *bind.CallOpts, wrappedTokenId *big.Int) (generated.WERC721WrappedToken, bool, error) {
+	wrapped, err := caller.GetWrappedToken(opts, wrappedTokenId)
+	if err != nil {
+		return generated.WERC721WrappedToken{}, false, err
+	}
+	return wrapped, wrapped.TokenAddress != (common.Address{}), nil
+}
+
+// Lookup returns the WERC721 token currently wrapping the given external
+// token. The boolean is false if the token is not wrapped.
+func Lookup(caller *generated.WERC721Caller, opts *bind.CallOpts, tokenAddress common.Address, tokenId *big.Int) (*big.Int, bool, error) {
+	wrappedTokenId, err := caller.GetWrappedTokenId(opts, tokenAddress, tokenId)
+	if err != nil {
+		return nil, false, err
+	}
+	return wrappedTokenId, wrappedTokenId.Sign() != 0, nil
+}